@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"pr-service/internal/app/services"
+)
+
+const backfillBatchSize = 50
+
+func runBackfillWorker(ctx context.Context, pullRequestService services.PullRequestService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := pullRequestService.BackfillReviewers(ctx, backfillBatchSize)
+			if err != nil {
+				log.Printf("Reviewer backfill failed: %v", err)
+				continue
+			}
+
+			if len(report.Backfilled) > 0 {
+				log.Printf("Reviewer backfill assigned reviewers to %d of %d under-assigned pull requests", len(report.Backfilled), report.Checked)
+			}
+		}
+	}
+}
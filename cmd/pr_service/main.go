@@ -1,45 +1,146 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
+	"net/http"
+	"time"
 
 	"pr-service/config"
 	"pr-service/internal/api/handlers"
 	"pr-service/internal/api/routes"
+	"pr-service/internal/app"
+	"pr-service/internal/app/assignment"
 	"pr-service/internal/app/services"
 	"pr-service/internal/infrastructure/db"
+	"pr-service/internal/infrastructure/memory"
+	"pr-service/internal/infrastructure/notifiers"
 	"pr-service/internal/infrastructure/postgres/repositories"
 	"pr-service/internal/infrastructure/providers"
+	"pr-service/internal/infrastructure/sinks"
+	"pr-service/internal/infrastructure/webhooks"
+)
+
+const (
+	storagePostgres = "postgres"
+	storageMemory   = "memory"
+
+	sinkWebhook = "webhook"
+	sinkLog     = "log"
+
+	webhookTimeout = 10 * time.Second
 )
 
 func main() {
+	storage := flag.String("storage", storagePostgres, "storage backend: postgres or memory")
+	flag.Parse()
+
 	cfg := config.Load()
 
-	database, err := db.Init(cfg)
-	if err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+	var (
+		txManager             app.TxManager
+		userRepository        app.UserRepository
+		teamRepository        app.TeamRepository
+		pullRequestRepository app.PullRequestRepository
+		reminderRepository    app.ReviewReminderRepository
+		webhookRepository     app.WebhookRepository
+		outboxRepository      app.OutboxRepository
+		auditRepository       app.AuditRepository
+		reminderElector       app.LeaderElector
+		reassignElector       app.LeaderElector
+		outboxElector         app.LeaderElector
+	)
+
+	switch *storage {
+	case storagePostgres:
+		database, err := db.Init(cfg)
+		if err != nil {
+			log.Fatalf("Failed to initialize database: %v", err)
+		}
+		defer database.Close()
+
+		txManager = db.NewTxManager(database)
+		userRepository = repositories.NewUserRepository(database)
+		teamRepository = repositories.NewTeamRepository(database)
+		pullRequestRepository = repositories.NewPullRequestRepository(database)
+		reminderRepository = repositories.NewReviewReminderRepository(database)
+		webhookRepository = repositories.NewWebhookRepository(database)
+		outboxRepository = repositories.NewOutboxRepository(database)
+		auditRepository = repositories.NewAuditRepository(database)
+		reminderElector = db.NewLeaderElector(database, reminderLockID)
+		reassignElector = db.NewLeaderElector(database, reassignLockID)
+		outboxElector = db.NewLeaderElector(database, outboxLockID)
+	case storageMemory:
+		store := memory.NewStore()
+
+		txManager = memory.NewTxManager(store)
+		userRepository = memory.NewUserRepository(store)
+		teamRepository = memory.NewTeamRepository(store)
+		pullRequestRepository = memory.NewPullRequestRepository(store)
+		reminderRepository = memory.NewReviewReminderRepository(store)
+		webhookRepository = memory.NewWebhookRepository(store)
+		outboxRepository = memory.NewOutboxRepository(store)
+		auditRepository = memory.NewAuditRepository(store)
+		reminderElector = memory.NewLeaderElector()
+		reassignElector = memory.NewLeaderElector()
+		outboxElector = memory.NewLeaderElector()
+
+		log.Printf("Using in-memory storage, data will be lost on exit")
+	default:
+		log.Fatalf("Unknown storage %q, expected %q or %q", *storage, storagePostgres, storageMemory)
 	}
-	defer database.Close()
 
-	txManager := db.NewTxManager(database)
 	timeProvider := providers.NewCurrentTime()
 	randomProvider := providers.NewRealRandom()
+	assignmentStrategy := assignment.NewRegistry(teamRepository, pullRequestRepository, randomProvider)
 
-	userRepository := repositories.NewUserRepository(database)
-	teamRepository := repositories.NewTeamRepository(database)
-	pullRequestRepository := repositories.NewPullRequestRepository(database)
+	var sink app.EventSink
+	switch cfg.OutboxSink {
+	case sinkWebhook:
+		sink = webhooks.NewDispatcher(webhookRepository, &http.Client{Timeout: webhookTimeout}, timeProvider, cfg.WebhookMaxAttempts, cfg.WebhookBackoff)
+	case sinkLog:
+		sink = sinks.NewLog()
+	default:
+		log.Fatalf("Unknown outbox sink %q, expected %q or %q", cfg.OutboxSink, sinkWebhook, sinkLog)
+	}
 
-	userService := services.NewUserService(userRepository, pullRequestRepository)
-	teamService := services.NewTeamService(userRepository, teamRepository, txManager)
-	pullRequestService := services.NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, randomProvider)
+	userService := services.NewUserService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignmentStrategy, outboxRepository, auditRepository)
+	teamService := services.NewTeamService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignmentStrategy, outboxRepository, auditRepository)
+	pullRequestService := services.NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignmentStrategy, outboxRepository, auditRepository)
 	statsService := services.NewStatsService(userRepository, teamRepository, pullRequestRepository)
+	webhookService := services.NewWebhookService(webhookRepository, timeProvider)
+	reminderService := services.NewReminderService(userRepository, teamRepository, pullRequestRepository, reminderRepository, notifiers.NewLog(), txManager, timeProvider)
+	outboxService := services.NewOutboxService(outboxRepository, sink, timeProvider)
+	auditService := services.NewAuditService(auditRepository)
 
 	userHandler := handlers.NewUserHandler(userService)
 	teamHandler := handlers.NewTeamHandler(teamService)
 	pullRequestHandler := handlers.NewPullRequestHandler(pullRequestService)
 	statsHandler := handlers.NewStatsHandler(statsService)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	auditHandler := handlers.NewAuditHandler(auditService)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if cfg.BackfillInterval > 0 {
+		go runBackfillWorker(ctx, pullRequestService, cfg.BackfillInterval)
+	}
+
+	if cfg.ReminderInterval > 0 {
+		go runReminderWorker(ctx, reminderService, reminderElector, cfg.ReminderInterval)
+	}
+
+	if cfg.ReassignInterval > 0 {
+		go runReassignWorker(ctx, pullRequestService, reassignElector, cfg.ReassignInterval)
+	}
+
+	if cfg.OutboxInterval > 0 {
+		go runOutboxWorker(ctx, outboxService, outboxElector, cfg.OutboxInterval)
+	}
 
-	router := routes.Setup(userHandler, teamHandler, pullRequestHandler, statsHandler)
+	router := routes.Setup(userHandler, teamHandler, pullRequestHandler, statsHandler, webhookHandler, auditHandler, cfg.AdminToken)
 
 	if err := router.Run(":8080"); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/app/services"
+)
+
+const (
+	outboxLockID    = 240_001
+	outboxBatchSize = 100
+)
+
+func runOutboxWorker(ctx context.Context, outboxService services.OutboxService, leaderElector app.LeaderElector, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var report services.RelayReport
+
+			leader, err := leaderElector.RunIfLeader(ctx, func(ctx context.Context) error {
+				var err error
+				report, err = outboxService.Relay(ctx, outboxBatchSize)
+				return err
+			})
+			if err != nil {
+				log.Printf("Outbox relay failed: %v", err)
+				continue
+			}
+			if !leader {
+				continue
+			}
+
+			if report.Delivered > 0 {
+				log.Printf("Outbox relay delivered %d of %d pending events", report.Delivered, report.Checked)
+			}
+		}
+	}
+}
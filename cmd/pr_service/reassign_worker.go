@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/app/services"
+)
+
+const reassignLockID = 220_001
+
+func runReassignWorker(ctx context.Context, pullRequestService services.PullRequestService, leaderElector app.LeaderElector, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var report services.ReassignmentReport
+
+			leader, err := leaderElector.RunIfLeader(ctx, func(ctx context.Context) error {
+				var err error
+				report, err = pullRequestService.ReassignOverdueReviewers(ctx)
+				return err
+			})
+			if err != nil {
+				log.Printf("Overdue review reassignment failed: %v", err)
+				continue
+			}
+			if !leader {
+				continue
+			}
+
+			if len(report.Reassigned) > 0 || len(report.WithoutCandidate) > 0 {
+				log.Printf("Reassigned %d overdue reviews, %d had no candidate", len(report.Reassigned), len(report.WithoutCandidate))
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/app/services"
+)
+
+const reminderLockID = 210_001
+
+func runReminderWorker(ctx context.Context, reminderService services.ReminderService, leaderElector app.LeaderElector, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var report services.ReminderReport
+
+			leader, err := leaderElector.RunIfLeader(ctx, func(ctx context.Context) error {
+				var err error
+				report, err = reminderService.SendReminders(ctx)
+				return err
+			})
+			if err != nil {
+				log.Printf("Review reminders failed: %v", err)
+				continue
+			}
+			if !leader {
+				continue
+			}
+
+			if len(report.Sent) > 0 {
+				log.Printf("Sent %d review reminders for %d stale pull requests", len(report.Sent), report.Checked)
+			}
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -15,6 +16,16 @@ type Config struct {
 	DBPassword string
 	DBName     string
 	AppPort    string
+	AdminToken string
+
+	BackfillInterval time.Duration
+	ReminderInterval time.Duration
+	ReassignInterval time.Duration
+	OutboxInterval   time.Duration
+
+	OutboxSink         string
+	WebhookMaxAttempts int
+	WebhookBackoff     time.Duration
 }
 
 func Load() *Config {
@@ -29,6 +40,16 @@ func Load() *Config {
 		DBPassword: getEnv("DB_PASSWORD", ""),
 		DBName:     getEnv("DB_NAME", "app"),
 		AppPort:    getEnv("APP_PORT", "8080"),
+		AdminToken: getEnv("ADMIN_TOKEN", ""),
+
+		BackfillInterval: time.Duration(getEnvAsInt("BACKFILL_INTERVAL_SECONDS", 60)) * time.Second,
+		ReminderInterval: time.Duration(getEnvAsInt("REMINDER_INTERVAL_SECONDS", 300)) * time.Second,
+		ReassignInterval: time.Duration(getEnvAsInt("REASSIGN_INTERVAL_SECONDS", 300)) * time.Second,
+		OutboxInterval:   time.Duration(getEnvAsInt("OUTBOX_INTERVAL_SECONDS", 5)) * time.Second,
+
+		OutboxSink:         getEnv("OUTBOX_SINK", "webhook"),
+		WebhookMaxAttempts: getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 5),
+		WebhookBackoff:     time.Duration(getEnvAsInt("WEBHOOK_BACKOFF_SECONDS", 1)) * time.Second,
 	}
 }
 
@@ -44,6 +65,16 @@ func LoadTest() *Config {
 		DBPassword: getEnv("TEST_DB_PASSWORD", "test_password"),
 		DBName:     getEnv("TEST_DB_NAME", "pr_service_test_db"),
 		AppPort:    getEnv("TEST_APP_PORT", "8081"),
+		AdminToken: getEnv("TEST_ADMIN_TOKEN", ""),
+
+		BackfillInterval: time.Duration(getEnvAsInt("TEST_BACKFILL_INTERVAL_SECONDS", 60)) * time.Second,
+		ReminderInterval: time.Duration(getEnvAsInt("TEST_REMINDER_INTERVAL_SECONDS", 300)) * time.Second,
+		ReassignInterval: time.Duration(getEnvAsInt("TEST_REASSIGN_INTERVAL_SECONDS", 300)) * time.Second,
+		OutboxInterval:   time.Duration(getEnvAsInt("TEST_OUTBOX_INTERVAL_SECONDS", 5)) * time.Second,
+
+		OutboxSink:         getEnv("TEST_OUTBOX_SINK", "webhook"),
+		WebhookMaxAttempts: getEnvAsInt("TEST_WEBHOOK_MAX_ATTEMPTS", 5),
+		WebhookBackoff:     time.Duration(getEnvAsInt("TEST_WEBHOOK_BACKOFF_SECONDS", 1)) * time.Second,
 	}
 }
 
@@ -5,12 +5,36 @@ const (
 	DuplicateUserIDs   = "DUPLICATE_USER_IDS"
 	MissingUserID      = "MISSING_USER_ID"
 	MissingTeamName    = "MISSING_TEAM_NAME"
+	MissingPRID        = "MISSING_PR_ID"
+	MissingWebhookID   = "MISSING_WEBHOOK_ID"
+	InvalidIfMatch     = "INVALID_IF_MATCH"
+	InvalidStrategy    = "INVALID_ASSIGNMENT_STRATEGY"
+	InvalidReviewers   = "INVALID_REVIEWERS_COUNT"
+	InvalidDecision    = "INVALID_REVIEW_DECISION"
+	InvalidMergePolicy = "INVALID_MERGE_POLICY"
+	Forbidden          = "FORBIDDEN"
 	PRExists           = "PR_EXISTS"
 	TeamExists         = "TEAM_EXISTS"
 	PRMerged           = "PR_MERGED"
 	NoCandidate        = "NO_CANDIDATE"
 	NotAssigned        = "NOT_ASSIGNED"
 	AuthorNotActive    = "AUTHOR_NOT_ACTIVE"
+	ConcurrentModified = "CONCURRENT_MODIFICATION"
+	MergeBlocked       = "MERGE_BLOCKED"
+	PRNotOpen          = "PR_NOT_OPEN"
+	InvalidTransition  = "INVALID_STATUS_TRANSITION"
+	NotTeamMember      = "NOT_TEAM_MEMBER"
+	MemberExists       = "MEMBER_EXISTS"
+	TeamArchived       = "TEAM_ARCHIVED"
+	TeamInUse          = "TEAM_IN_USE"
+	InvalidTeamParent  = "INVALID_TEAM_PARENT"
+	InvalidQuota       = "INVALID_REVIEWER_QUOTA"
+	InvalidWindow      = "INVALID_AVAILABILITY_WINDOW"
+	InvalidCapacity    = "INVALID_MAX_OPEN_REVIEWS"
+	InvalidReviewSLA   = "INVALID_REVIEW_SLA"
+	InvalidWebhook     = "INVALID_WEBHOOK"
+	InvalidAuditQuery  = "INVALID_AUDIT_QUERY"
+	NotEligible        = "REVIEWER_NOT_ELIGIBLE"
 	NotFound           = "NOT_FOUND"
 	InternalError      = "INTERNAL_ERROR"
 )
@@ -5,12 +5,38 @@ const (
 	DuplicateUserIDsMessage   = "team contains duplicate user_ids"
 	MissingUserIDMessage      = "user ID is required"
 	MissingTeamNameMessage    = "team name is required"
+	MissingPRIDMessage        = "pull request ID is required"
+	MissingWebhookIDMessage   = "webhook ID must be a positive integer"
+	InvalidIfMatchMessage     = "If-Match header must contain a quoted version"
+	InvalidStrategyMessage    = "assignment_strategy must be one of RANDOM, LEAST_LOADED, ROUND_ROBIN, WEIGHTED_RANDOM"
+	InvalidReviewersMessage   = "reviewers_count must be between 1 and 10"
+	InvalidDecisionMessage    = "decision must be one of APPROVED, CHANGES_REQUESTED, COMMENTED"
+	InvalidMergePolicyMessage = "min_approvals must be between 0 and 10, lead must be a team member when lead approval is required"
+	ForbiddenMessage          = "force merge requires admin token"
+	WebhooksForbiddenMessage  = "managing webhooks requires admin token"
+	AuditForbiddenMessage     = "reading audit log requires admin token"
 	PRExistsMessage           = "PR id already exists"
 	TeamExistsMessage         = "team_name already exists"
 	PRMergedMessage           = "cannot reassign on merged PR"
 	NoCandidateMessage        = "no active replacement candidate in team"
 	NotAssignedMessage        = "reviewer is not assigned to this PR"
 	AuthorNotActiveMessage    = "user can not create PR with false active status"
+	ConcurrentModifiedMessage = "PR was modified concurrently, reload and retry"
+	MergeBlockedMessage       = "merge policy conditions are not met"
+	PRNotOpenMessage          = "PR is not open"
+	InvalidTransitionMessage  = "PR status does not allow this transition"
+	NotTeamMemberMessage      = "user is not a member of the team"
+	MemberExistsMessage       = "user already belongs to a team"
+	TeamArchivedMessage       = "team is archived"
+	TeamInUseMessage          = "team still has members or open PRs"
+	InvalidTeamParentMessage  = "parent team must differ from the team and must not be its descendant"
+	InvalidQuotaMessage       = "borrowed_reviewers must name distinct other teams with count of at least 1 and can not be used with draft"
+	InvalidWindowMessage      = "ends_at must be after starts_at"
+	InvalidCapacityMessage    = "max_open_reviews must not be negative"
+	InvalidReviewSLAMessage   = "review_sla hours must not be negative and escalate_after_hours must exceed remind_after_hours"
+	InvalidWebhookMessage     = "url must be an absolute http(s) URL, secret is required and event_types must be known events"
+	InvalidAuditQueryMessage  = "since must be RFC3339, entity_type must be one of TEAM, USER, PULL_REQUEST, limit must be between 1 and 200 and cursor must be a positive integer"
+	NotEligibleMessage        = "new reviewer must be an active member of an allowed team, not the author and not already assigned"
 	NotFoundMessage           = "resource not found"
 	InternalErrorMessage      = "internal server error"
 )
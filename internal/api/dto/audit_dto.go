@@ -0,0 +1,18 @@
+package dto
+
+type AuditEntry struct {
+	AuditID    int64          `json:"audit_id"`
+	Actor      string         `json:"actor"`
+	Action     string         `json:"action"`
+	EntityType string         `json:"entity_type"`
+	EntityID   string         `json:"entity_id"`
+	Before     map[string]any `json:"before"`
+	After      map[string]any `json:"after"`
+	RequestID  string         `json:"request_id"`
+	CreatedAt  string         `json:"created_at"`
+}
+
+type AuditResponse struct {
+	Entries    []AuditEntry `json:"entries"`
+	NextCursor *int64       `json:"next_cursor"`
+}
@@ -1,28 +1,86 @@
 package dto
 
+type ReviewerQuota struct {
+	TeamName string `json:"team_name" binding:"required"`
+	Count    int    `json:"count"`
+}
+
 type CreatePullRequest struct {
-	PullRequestID   string `json:"pull_request_id" binding:"required"`
-	PullRequestName string `json:"pull_request_name" binding:"required"`
-	AuthorID        string `json:"author_id" binding:"required"`
+	PullRequestID     string          `json:"pull_request_id" binding:"required"`
+	PullRequestName   string          `json:"pull_request_name" binding:"required"`
+	AuthorID          string          `json:"author_id" binding:"required"`
+	ReviewersCount    *int            `json:"reviewers_count"`
+	Draft             bool            `json:"draft"`
+	BorrowedReviewers []ReviewerQuota `json:"borrowed_reviewers" binding:"dive"`
 }
 
 type MergePullRequest struct {
 	PullRequestID string `json:"pull_request_id" binding:"required"`
+	Force         bool   `json:"force"`
+}
+
+type PullRequestTransitionRequest struct {
+	PullRequestID string `json:"pull_request_id" binding:"required"`
+}
+
+type UnassignReviewerRequest struct {
+	PullRequestID string `json:"pull_request_id" binding:"required"`
+	ReviewerID    string `json:"reviewer_id" binding:"required"`
 }
 
 type ReassignReviewerRequest struct {
 	PullRequestID string `json:"pull_request_id" binding:"required"`
 	OldReviewerID string `json:"old_reviewer_id" binding:"required"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+type SubmitReviewRequest struct {
+	PullRequestID string `json:"pull_request_id" binding:"required"`
+	ReviewerID    string `json:"reviewer_id" binding:"required"`
+	Decision      string `json:"decision" binding:"required"`
+}
+
+type ReviewResponse struct {
+	ReviewerID string  `json:"reviewer_id"`
+	Decision   string  `json:"decision"`
+	DecidedAt  *string `json:"decided_at,omitempty"`
+}
+
+type ReviewerAssignmentResponse struct {
+	ReviewerID     string `json:"reviewer_id"`
+	Level          int    `json:"level"`
+	SourceTeamName string `json:"source_team_name,omitempty"`
+}
+
+type ReviewerChangeResponse struct {
+	OldReviewerID  string `json:"old_reviewer_id"`
+	NewReviewerID  string `json:"new_reviewer_id,omitempty"`
+	Level          int    `json:"level"`
+	SourceTeamName string `json:"source_team_name,omitempty"`
+	AssignedAt     string `json:"assigned_at"`
+	Reason         string `json:"reason"`
+	ChangedAt      string `json:"changed_at"`
+}
+
+type ReviewerHistoryResponse struct {
+	PullRequestID string                   `json:"pull_request_id"`
+	History       []ReviewerChangeResponse `json:"history"`
 }
 
 type PullRequestResponse struct {
-	PullRequestID     string   `json:"pull_request_id"`
-	PullRequestName   string   `json:"pull_request_name"`
-	AuthorID          string   `json:"author_id"`
-	Status            string   `json:"status"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
-	CreatedAt         string   `json:"created_at"`
-	MergedAt          *string  `json:"merged_at,omitempty"`
+	PullRequestID       string                       `json:"pull_request_id"`
+	PullRequestName     string                       `json:"pull_request_name"`
+	AuthorID            string                       `json:"author_id"`
+	Status              string                       `json:"status"`
+	AssignedReviewers   []string                     `json:"assigned_reviewers"`
+	ReviewerAssignments []ReviewerAssignmentResponse `json:"reviewer_assignments"`
+	ReviewersCount      int                          `json:"reviewers_count"`
+	Reviews             []ReviewResponse             `json:"reviews"`
+	ReviewDecision      string                       `json:"review_decision"`
+	CreatedAt           string                       `json:"created_at"`
+	MergedAt            *string                      `json:"merged_at,omitempty"`
+	ClosedAt            *string                      `json:"closed_at,omitempty"`
+	ForceMerged         bool                         `json:"force_merged"`
 }
 
 type PullRequestReassignResponse struct {
@@ -12,6 +12,7 @@ type TeamStats struct {
 	MemberCount       int    `json:"member_count"`
 	ActiveMembers     int    `json:"active_members"`
 	PullRequestsCount int    `json:"prs_count"`
+	Archived          bool   `json:"archived,omitempty"`
 }
 
 type ReviewAssignment struct {
@@ -19,12 +20,18 @@ type ReviewAssignment struct {
 	PullRequestName string `json:"pr_name"`
 	AuthorID        string `json:"author_id"`
 	Status          string `json:"status"`
+	ReviewersCount  int    `json:"reviewers_count"`
+	AssignedCount   int    `json:"assigned_count"`
+	FullyAssigned   bool   `json:"fully_assigned"`
 }
 
 type StatsResponse struct {
 	TotalPullRequests  int                `json:"total_prs"`
 	OpenPullRequests   int                `json:"open_prs"`
 	MergedPullRequests int                `json:"merged_prs"`
+	DraftPullRequests  int                `json:"draft_prs"`
+	ClosedPullRequests int                `json:"closed_prs"`
+	UnderAssignedPRs   int                `json:"under_assigned_prs"`
 	UsersStats         []UserStats        `json:"users_stats"`
 	TeamsStats         []TeamStats        `json:"teams_stats"`
 	ReviewAssignments  []ReviewAssignment `json:"review_assignments"`
@@ -6,12 +6,123 @@ type TeamMember struct {
 	IsActive bool   `json:"is_active"`
 }
 
+type MergePolicy struct {
+	MinApprovals        int  `json:"min_approvals"`
+	RequireLeadApproval bool `json:"require_lead_approval"`
+}
+
+type ReviewSLA struct {
+	RemindAfterHours   int `json:"remind_after_hours"`
+	EscalateAfterHours int `json:"escalate_after_hours"`
+	ReassignAfterHours int `json:"reassign_after_hours"`
+}
+
 type CreateTeamRequest struct {
+	TeamName           string       `json:"team_name" binding:"required"`
+	Members            []TeamMember `json:"members" binding:"required,min=1"`
+	AssignmentStrategy string       `json:"assignment_strategy"`
+	ReviewersCount     int          `json:"reviewers_count"`
+	LeadID             string       `json:"lead_id"`
+	MergePolicy        MergePolicy  `json:"merge_policy"`
+	ParentTeamName     string       `json:"parent_team_name"`
+	MaxOpenReviews     int          `json:"max_open_reviews"`
+	ReviewSLA          ReviewSLA    `json:"review_sla"`
+}
+
+type SetAssignmentStrategyRequest struct {
+	TeamName           string `json:"team_name" binding:"required"`
+	AssignmentStrategy string `json:"assignment_strategy" binding:"required"`
+}
+
+type SetTeamMaxOpenReviewsRequest struct {
+	TeamName       string `json:"team_name" binding:"required"`
+	MaxOpenReviews int    `json:"max_open_reviews"`
+}
+
+type SetReviewSLARequest struct {
+	TeamName  string    `json:"team_name" binding:"required"`
+	ReviewSLA ReviewSLA `json:"review_sla"`
+}
+
+type SetMergePolicyRequest struct {
+	TeamName    string      `json:"team_name" binding:"required"`
+	LeadID      string      `json:"lead_id"`
+	MergePolicy MergePolicy `json:"merge_policy"`
+}
+
+type DeactivateTeamRequest struct {
+	TeamName         string   `json:"team_name" binding:"required"`
+	UserIDs          []string `json:"user_ids"`
+	FallbackTeamName string   `json:"fallback_team_name"`
+}
+
+type DeactivateTeamResponse struct {
+	TeamName         string             `json:"team_name"`
+	DeactivatedUsers []TeamMember       `json:"deactivated_users"`
+	Reassignment     ReassignmentReport `json:"reassignment"`
+}
+
+type AddTeamMembersRequest struct {
 	TeamName string       `json:"team_name" binding:"required"`
 	Members  []TeamMember `json:"members" binding:"required,min=1"`
 }
 
+type RemoveTeamMembersRequest struct {
+	TeamName            string   `json:"team_name" binding:"required"`
+	UserIDs             []string `json:"user_ids" binding:"required,min=1"`
+	ReassignOpenReviews bool     `json:"reassign_open_reviews"`
+}
+
+type MoveTeamMembersRequest struct {
+	TeamName            string   `json:"team_name" binding:"required"`
+	TargetTeamName      string   `json:"target_team_name" binding:"required"`
+	UserIDs             []string `json:"user_ids" binding:"required,min=1"`
+	ReassignOpenReviews bool     `json:"reassign_open_reviews"`
+}
+
+type TeamRequest struct {
+	TeamName string `json:"team_name" binding:"required"`
+}
+
+type RenameTeamRequest struct {
+	TeamName    string `json:"team_name" binding:"required"`
+	NewTeamName string `json:"new_team_name" binding:"required"`
+}
+
+type SetTeamParentRequest struct {
+	TeamName       string `json:"team_name" binding:"required"`
+	ParentTeamName string `json:"parent_team_name"`
+}
+
+type MembershipChange struct {
+	UserID       string `json:"user_id"`
+	Action       string `json:"action"`
+	FromTeamName string `json:"from_team_name,omitempty"`
+	ToTeamName   string `json:"to_team_name,omitempty"`
+	ChangedAt    string `json:"changed_at"`
+}
+
+type TeamMembershipResponse struct {
+	TeamName     string              `json:"team_name"`
+	Changes      []MembershipChange  `json:"changes"`
+	Reassignment *ReassignmentReport `json:"reassignment,omitempty"`
+}
+
+type MembershipHistoryResponse struct {
+	TeamName string             `json:"team_name"`
+	History  []MembershipChange `json:"history"`
+}
+
 type TeamResponse struct {
-	TeamName string       `json:"team_name"`
-	Members  []TeamMember `json:"members"`
+	TeamID             string       `json:"team_id,omitempty"`
+	TeamName           string       `json:"team_name"`
+	ParentTeamName     string       `json:"parent_team_name,omitempty"`
+	AssignmentStrategy string       `json:"assignment_strategy"`
+	ReviewersCount     int          `json:"reviewers_count"`
+	LeadID             string       `json:"lead_id,omitempty"`
+	MergePolicy        MergePolicy  `json:"merge_policy"`
+	MaxOpenReviews     int          `json:"max_open_reviews"`
+	ReviewSLA          ReviewSLA    `json:"review_sla"`
+	ArchivedAt         string       `json:"archived_at,omitempty"`
+	Members            []TeamMember `json:"members"`
 }
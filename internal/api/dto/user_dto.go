@@ -1,18 +1,71 @@
 package dto
 
+import "time"
+
 type UserStatusRequest struct {
-	UserID   string `json:"user_id" binding:"required"`
-	IsActive bool   `json:"is_active"`
+	UserID              string `json:"user_id" binding:"required"`
+	IsActive            bool   `json:"is_active"`
+	ReassignOpenReviews bool   `json:"reassign_open_reviews"`
 }
 
 type UserStatusResponse struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	UserID       string              `json:"user_id"`
+	Username     string              `json:"username"`
+	TeamName     string              `json:"team_name"`
+	IsActive     bool                `json:"is_active"`
+	Load         ReviewLoad          `json:"load"`
+	Reassignment *ReassignmentReport `json:"reassignment,omitempty"`
+}
+
+type SetMaxOpenReviewsRequest struct {
+	UserID         string `json:"user_id" binding:"required"`
+	MaxOpenReviews int    `json:"max_open_reviews"`
+}
+
+type ReviewLoad struct {
+	OpenReviews    int `json:"open_reviews"`
+	MaxOpenReviews int `json:"max_open_reviews"`
+}
+
+type ReassignmentReport struct {
+	Reassigned       []ReviewReassignment `json:"reassigned"`
+	WithoutCandidate []string             `json:"without_candidate"`
+}
+
+type ReviewReassignment struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
 }
 
 type UserReviewsResponse struct {
 	UserID       string             `json:"user_id"`
+	Load         ReviewLoad         `json:"load"`
 	PullRequests []PullRequestShort `json:"pull_requests"`
 }
+
+type AddAvailabilityRequest struct {
+	UserID   string    `json:"user_id" binding:"required"`
+	StartsAt time.Time `json:"starts_at" binding:"required"`
+	EndsAt   time.Time `json:"ends_at" binding:"required"`
+	Reason   string    `json:"reason"`
+}
+
+type DeleteAvailabilityRequest struct {
+	UserID   string `json:"user_id" binding:"required"`
+	WindowID int64  `json:"window_id" binding:"required"`
+}
+
+type AvailabilityWindow struct {
+	WindowID int64  `json:"window_id"`
+	UserID   string `json:"user_id"`
+	StartsAt string `json:"starts_at"`
+	EndsAt   string `json:"ends_at"`
+	Reason   string `json:"reason"`
+}
+
+type UserAvailabilityResponse struct {
+	UserID       string               `json:"user_id"`
+	AvailableNow bool                 `json:"available_now"`
+	Windows      []AvailabilityWindow `json:"windows"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" binding:"required"`
+	Secret     string   `json:"secret" binding:"required"`
+	EventTypes []string `json:"event_types"`
+}
+
+type DeleteWebhookRequest struct {
+	WebhookID int64 `json:"webhook_id" binding:"required"`
+}
+
+type WebhookResponse struct {
+	WebhookID  int64    `json:"webhook_id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	CreatedAt  string   `json:"created_at"`
+}
+
+type WebhooksResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+type WebhookDeadLetter struct {
+	DeadLetterID int64          `json:"dead_letter_id"`
+	EventType    string         `json:"event_type"`
+	OccurredAt   string         `json:"occurred_at"`
+	Payload      map[string]any `json:"payload"`
+	Attempts     int            `json:"attempts"`
+	LastError    string         `json:"last_error"`
+	FailedAt     string         `json:"failed_at"`
+}
+
+type WebhookDeadLettersResponse struct {
+	WebhookID   int64               `json:"webhook_id"`
+	DeadLetters []WebhookDeadLetter `json:"dead_letters"`
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"pr-service/internal/api/apierrors"
+	"pr-service/internal/api/dto"
+	"pr-service/internal/api/middleware"
+)
+
+func requireAdmin(c *gin.Context, message string) bool {
+	if middleware.IsAdmin(c) {
+		return true
+	}
+
+	c.JSON(http.StatusForbidden, dto.ErrorResponse{
+		Error: dto.Error{
+			Code:    apierrors.Forbidden,
+			Message: message,
+		},
+	})
+
+	return false
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"pr-service/internal/api/apierrors"
+	"pr-service/internal/api/mappers/dto_mappers"
+	"pr-service/internal/api/mappers/error_mappers"
+	"pr-service/internal/app/services"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+)
+
+type AuditHandler struct {
+	auditService services.AuditService
+}
+
+func NewAuditHandler(auditService services.AuditService) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+	}
+}
+
+func (h *AuditHandler) GetAuditLog(c *gin.Context) {
+	if !requireAdmin(c, apierrors.AuditForbiddenMessage) {
+		return
+	}
+
+	query, err := auditQueryFromRequest(c)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	page, err := h.auditService.GetEntries(c, query)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToAuditResponseDTO(page))
+}
+
+func auditQueryFromRequest(c *gin.Context) (entities.AuditQuery, error) {
+	query := entities.AuditQuery{
+		EntityType: entities.AuditEntityType(c.Query("entity_type")),
+		EntityID:   c.Query("entity"),
+	}
+
+	if since := c.Query("since"); since != "" {
+		parsed, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return entities.AuditQuery{}, domain.ErrInvalidAuditQuery
+		}
+		query.Since = parsed
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed <= 0 {
+			return entities.AuditQuery{}, domain.ErrInvalidAuditQuery
+		}
+		query.Limit = parsed
+	}
+
+	if cursor := c.Query("cursor"); cursor != "" {
+		parsed, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil || parsed <= 0 {
+			return entities.AuditQuery{}, domain.ErrInvalidAuditQuery
+		}
+		query.AfterID = parsed
+	}
+
+	return query, nil
+}
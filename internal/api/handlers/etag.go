@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"pr-service/internal/domain/entities"
+)
+
+var errInvalidIfMatch = errors.New("invalid If-Match header")
+
+func setETag(c *gin.Context, pullRequest entities.PullRequest) {
+	c.Header("ETag", strconv.Quote(strconv.Itoa(pullRequest.Version)))
+}
+
+func parseIfMatch(c *gin.Context) (*int, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	header = strings.TrimPrefix(header, "W/")
+
+	unquoted, err := strconv.Unquote(header)
+	if err != nil {
+		return nil, errInvalidIfMatch
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil {
+		return nil, errInvalidIfMatch
+	}
+
+	return &version, nil
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -9,7 +10,9 @@ import (
 	"pr-service/internal/api/dto"
 	"pr-service/internal/api/mappers/dto_mappers"
 	"pr-service/internal/api/mappers/error_mappers"
+	"pr-service/internal/api/middleware"
 	"pr-service/internal/app/services"
+	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
 )
 
@@ -37,16 +40,67 @@ func (h *PullRequestHandler) CreatePullRequest(c *gin.Context) {
 	}
 
 	pullRequestID, pullRequestName, authorID := dto_mappers.FromCreatePullRequestDTO(request)
-	pullRequest, err := h.pullRequestService.Create(c, pullRequestID, pullRequestName, authorID)
+	pullRequest, err := h.pullRequestService.Create(c, pullRequestID, pullRequestName, authorID, services.CreateOptions{
+		ReviewersLimit:    request.ReviewersCount,
+		Draft:             request.Draft,
+		BorrowedReviewers: dto_mappers.FromReviewerQuotasDTO(request.BorrowedReviewers),
+	})
 	if err != nil {
 		statusCode, errorResponse := error_mappers.ToHTTPError(err)
 		c.JSON(statusCode, errorResponse)
 		return
 	}
 
+	setETag(c, *pullRequest)
 	c.JSON(http.StatusCreated, dto_mappers.ToPullRequestResponseDTO(*pullRequest))
 }
 
+func (h *PullRequestHandler) GetPullRequest(c *gin.Context) {
+	pullRequestID := c.DefaultQuery("pull_request_id", "")
+	if pullRequestID == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.MissingPRID,
+				Message: apierrors.MissingPRIDMessage,
+			},
+		})
+		return
+	}
+
+	pullRequest, err := h.pullRequestService.GetByID(c, value_objects.PullRequestID(pullRequestID))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	setETag(c, *pullRequest)
+	c.JSON(http.StatusOK, dto_mappers.ToPullRequestResponseDTO(*pullRequest))
+}
+
+func (h *PullRequestHandler) GetReviewerHistory(c *gin.Context) {
+	pullRequestID := c.DefaultQuery("pull_request_id", "")
+	if pullRequestID == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.MissingPRID,
+				Message: apierrors.MissingPRIDMessage,
+			},
+		})
+		return
+	}
+
+	parsedPullRequestID := value_objects.PullRequestID(pullRequestID)
+	history, err := h.pullRequestService.GetReviewerHistory(c, parsedPullRequestID)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToReviewerHistoryResponseDTO(parsedPullRequestID, history))
+}
+
 func (h *PullRequestHandler) MergePullRequest(c *gin.Context) {
 	var request dto.MergePullRequest
 
@@ -60,14 +114,39 @@ func (h *PullRequestHandler) MergePullRequest(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := parseIfMatch(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidIfMatch,
+				Message: apierrors.InvalidIfMatchMessage,
+			},
+		})
+		return
+	}
+
+	if request.Force && !middleware.IsAdmin(c) {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.Forbidden,
+				Message: apierrors.ForbiddenMessage,
+			},
+		})
+		return
+	}
+
 	pullRequestID := value_objects.PullRequestID(request.PullRequestID)
-	pullRequest, err := h.pullRequestService.Merge(c, pullRequestID)
+	pullRequest, err := h.pullRequestService.Merge(c, pullRequestID, services.MergeOptions{
+		ExpectedVersion: expectedVersion,
+		Force:           request.Force,
+	})
 	if err != nil {
 		statusCode, errorResponse := error_mappers.ToHTTPError(err)
 		c.JSON(statusCode, errorResponse)
 		return
 	}
 
+	setETag(c, *pullRequest)
 	c.JSON(http.StatusOK, dto_mappers.ToPullRequestResponseDTO(*pullRequest))
 }
 
@@ -84,13 +163,150 @@ func (h *PullRequestHandler) ReassignReviewer(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := parseIfMatch(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidIfMatch,
+				Message: apierrors.InvalidIfMatchMessage,
+			},
+		})
+		return
+	}
+
 	pullRequestID, oldReviewerID := dto_mappers.FromReassignReviewerRequestDTO(request)
-	pullRequest, newReviewerID, err := h.pullRequestService.ReassignReviewer(c, pullRequestID, oldReviewerID)
+	pullRequest, newReviewerID, err := h.pullRequestService.ReassignReviewer(c, pullRequestID, oldReviewerID, dto_mappers.FromReassignOptionsDTO(request, expectedVersion))
 	if err != nil {
 		statusCode, errorResponse := error_mappers.ToHTTPError(err)
 		c.JSON(statusCode, errorResponse)
 		return
 	}
 
+	setETag(c, *pullRequest)
 	c.JSON(http.StatusOK, dto_mappers.ToPullRequestReassignResponseDTO(*pullRequest, newReviewerID))
 }
+
+func (h *PullRequestHandler) SubmitReview(c *gin.Context) {
+	var request dto.SubmitReviewRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidIfMatch,
+				Message: apierrors.InvalidIfMatchMessage,
+			},
+		})
+		return
+	}
+
+	pullRequestID, reviewerID, decision := dto_mappers.FromSubmitReviewRequestDTO(request)
+	pullRequest, err := h.pullRequestService.SubmitReview(c, pullRequestID, reviewerID, decision, services.ReviewOptions{ExpectedVersion: expectedVersion})
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	setETag(c, *pullRequest)
+	c.JSON(http.StatusOK, dto_mappers.ToPullRequestResponseDTO(*pullRequest))
+}
+
+func (h *PullRequestHandler) MarkReady(c *gin.Context) {
+	h.transition(c, h.pullRequestService.MarkReady)
+}
+
+func (h *PullRequestHandler) ClosePullRequest(c *gin.Context) {
+	h.transition(c, h.pullRequestService.Close)
+}
+
+func (h *PullRequestHandler) ReopenPullRequest(c *gin.Context) {
+	h.transition(c, h.pullRequestService.Reopen)
+}
+
+func (h *PullRequestHandler) AssignReviewers(c *gin.Context) {
+	h.transition(c, h.pullRequestService.AssignReviewers)
+}
+
+func (h *PullRequestHandler) UnassignReviewer(c *gin.Context) {
+	var request dto.UnassignReviewerRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidIfMatch,
+				Message: apierrors.InvalidIfMatchMessage,
+			},
+		})
+		return
+	}
+
+	pullRequestID := value_objects.PullRequestID(request.PullRequestID)
+	reviewerID := value_objects.UserID(request.ReviewerID)
+	pullRequest, err := h.pullRequestService.UnassignReviewer(c, pullRequestID, reviewerID, services.TransitionOptions{ExpectedVersion: expectedVersion})
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	setETag(c, *pullRequest)
+	c.JSON(http.StatusOK, dto_mappers.ToPullRequestResponseDTO(*pullRequest))
+}
+
+func (h *PullRequestHandler) transition(c *gin.Context, apply func(ctx context.Context, pullRequestID value_objects.PullRequestID, options services.TransitionOptions) (*entities.PullRequest, error)) {
+	var request dto.PullRequestTransitionRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidIfMatch,
+				Message: apierrors.InvalidIfMatchMessage,
+			},
+		})
+		return
+	}
+
+	pullRequestID := value_objects.PullRequestID(request.PullRequestID)
+	pullRequest, err := apply(c, pullRequestID, services.TransitionOptions{ExpectedVersion: expectedVersion})
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	setETag(c, *pullRequest)
+	c.JSON(http.StatusOK, dto_mappers.ToPullRequestResponseDTO(*pullRequest))
+}
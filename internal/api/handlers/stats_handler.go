@@ -21,7 +21,8 @@ func NewStatsHandler(statsService services.StatsService) *StatsHandler {
 }
 
 func (h *StatsHandler) GetStats(c *gin.Context) {
-	stats, err := h.statsService.GetStats(c.Request.Context())
+	options := services.StatsOptions{IncludeArchived: c.Query("include_archived") == "true"}
+	stats, err := h.statsService.GetStats(c.Request.Context(), options)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
 			Error: dto.Error{
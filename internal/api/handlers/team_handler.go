@@ -10,6 +10,7 @@ import (
 	"pr-service/internal/api/mappers/dto_mappers"
 	"pr-service/internal/api/mappers/error_mappers"
 	"pr-service/internal/app/services"
+	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
 )
 
@@ -46,8 +47,8 @@ func (h *TeamHandler) CreateTeam(c *gin.Context) {
 		return
 	}
 
-	teamName, members := dto_mappers.FromCreateTeamRequestDTO(request)
-	team, members, err := h.teamService.Create(c, teamName, members)
+	team, members := dto_mappers.FromCreateTeamRequestDTO(request)
+	team, members, err := h.teamService.Create(c, team, members)
 	if err != nil {
 		statusCode, errorResponse := error_mappers.ToHTTPError(err)
 		c.JSON(statusCode, errorResponse)
@@ -80,6 +81,372 @@ func (h *TeamHandler) GetTeam(c *gin.Context) {
 	c.JSON(http.StatusOK, dto_mappers.ToTeamResponseDTO(team, members))
 }
 
+func (h *TeamHandler) SetAssignmentStrategy(c *gin.Context) {
+	var request dto.SetAssignmentStrategyRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	teamName := value_objects.TeamName(request.TeamName)
+	team, err := h.teamService.SetAssignmentStrategy(c, teamName, entities.AssignmentStrategy(request.AssignmentStrategy))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	_, members, err := h.teamService.GetByName(c, teamName)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamResponseDTO(team, members))
+}
+
+func (h *TeamHandler) SetMaxOpenReviews(c *gin.Context) {
+	var request dto.SetTeamMaxOpenReviewsRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	teamName := value_objects.TeamName(request.TeamName)
+	team, err := h.teamService.SetMaxOpenReviews(c, teamName, request.MaxOpenReviews)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	_, members, err := h.teamService.GetByName(c, teamName)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamResponseDTO(team, members))
+}
+
+func (h *TeamHandler) SetReviewSLA(c *gin.Context) {
+	var request dto.SetReviewSLARequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	teamName := value_objects.TeamName(request.TeamName)
+	team, err := h.teamService.SetReviewSLA(c, teamName, dto_mappers.FromReviewSLADTO(request.ReviewSLA))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	_, members, err := h.teamService.GetByName(c, teamName)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamResponseDTO(team, members))
+}
+
+func (h *TeamHandler) SetMergePolicy(c *gin.Context) {
+	var request dto.SetMergePolicyRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	teamName := value_objects.TeamName(request.TeamName)
+	team, err := h.teamService.SetMergePolicy(c, teamName, value_objects.UserID(request.LeadID), dto_mappers.FromMergePolicyDTO(request.MergePolicy))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	_, members, err := h.teamService.GetByName(c, teamName)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamResponseDTO(team, members))
+}
+
+func (h *TeamHandler) Deactivate(c *gin.Context) {
+	var request dto.DeactivateTeamRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	teamName := value_objects.TeamName(request.TeamName)
+	users, report, err := h.teamService.Deactivate(c, teamName, dto_mappers.FromDeactivateTeamRequestDTO(request))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToDeactivateTeamResponseDTO(teamName, users, report))
+}
+
+func (h *TeamHandler) AddMembers(c *gin.Context) {
+	var request dto.AddTeamMembersRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	if hasDuplicateUserIDs(request.Members) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.DuplicateUserIDs,
+				Message: apierrors.DuplicateUserIDsMessage,
+			},
+		})
+		return
+	}
+
+	teamName := value_objects.TeamName(request.TeamName)
+	changes, err := h.teamService.AddMembers(c, teamName, dto_mappers.FromTeamMembersDTO(teamName, request.Members))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamMembershipResponseDTO(teamName, changes, nil))
+}
+
+func (h *TeamHandler) RemoveMembers(c *gin.Context) {
+	var request dto.RemoveTeamMembersRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	teamName := value_objects.TeamName(request.TeamName)
+	options := services.MembershipOptions{ReassignOpenReviews: request.ReassignOpenReviews}
+	changes, report, err := h.teamService.RemoveMembers(c, teamName, dto_mappers.FromUserIDsDTO(request.UserIDs), options)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamMembershipResponseDTO(teamName, changes, reassignmentReport(options, report)))
+}
+
+func (h *TeamHandler) MoveMembers(c *gin.Context) {
+	var request dto.MoveTeamMembersRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	teamName := value_objects.TeamName(request.TeamName)
+	targetTeamName := value_objects.TeamName(request.TargetTeamName)
+	options := services.MembershipOptions{ReassignOpenReviews: request.ReassignOpenReviews}
+	changes, report, err := h.teamService.MoveMembers(c, teamName, targetTeamName, dto_mappers.FromUserIDsDTO(request.UserIDs), options)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamMembershipResponseDTO(teamName, changes, reassignmentReport(options, report)))
+}
+
+func (h *TeamHandler) GetMembershipHistory(c *gin.Context) {
+	teamName := c.DefaultQuery("team_name", "")
+	if teamName == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.MissingTeamName,
+				Message: apierrors.MissingTeamNameMessage,
+			},
+		})
+		return
+	}
+
+	parsedTeamName := value_objects.TeamName(teamName)
+	history, err := h.teamService.GetMembershipHistory(c, parsedTeamName)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MembershipHistoryResponse{
+		TeamName: teamName,
+		History:  dto_mappers.ToMembershipChangesDTO(history),
+	})
+}
+
+func (h *TeamHandler) Archive(c *gin.Context) {
+	var request dto.TeamRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	team, members, err := h.teamService.Archive(c, value_objects.TeamName(request.TeamName))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamResponseDTO(team, members))
+}
+
+func (h *TeamHandler) Delete(c *gin.Context) {
+	var request dto.TeamRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	if err := h.teamService.Delete(c, value_objects.TeamName(request.TeamName)); err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TeamHandler) Rename(c *gin.Context) {
+	var request dto.RenameTeamRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	team, members, err := h.teamService.Rename(c, value_objects.TeamName(request.TeamName), value_objects.TeamName(request.NewTeamName))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamResponseDTO(team, members))
+}
+
+func (h *TeamHandler) SetParent(c *gin.Context) {
+	var request dto.SetTeamParentRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	teamName := value_objects.TeamName(request.TeamName)
+	team, err := h.teamService.SetParent(c, teamName, value_objects.TeamName(request.ParentTeamName))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	_, members, err := h.teamService.GetByName(c, teamName)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToTeamResponseDTO(team, members))
+}
+
+func reassignmentReport(options services.MembershipOptions, report services.ReassignmentReport) *services.ReassignmentReport {
+	if !options.ReassignOpenReviews {
+		return nil
+	}
+
+	return &report
+}
+
 func hasDuplicateUserIDs(members []dto.TeamMember) bool {
 	seen := make(map[string]bool)
 
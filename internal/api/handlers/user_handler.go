@@ -37,14 +37,22 @@ func (h *UserHandler) SetActiveStatus(c *gin.Context) {
 	}
 
 	userID := value_objects.UserID(request.UserID)
-	updatedUser, err := h.userService.SetActiveStatus(c, userID, request.IsActive)
+	options := services.SetActiveOptions{ReassignOpenReviews: request.ReassignOpenReviews}
+	statusChange, err := h.userService.SetActiveStatus(c, userID, request.IsActive, options)
 	if err != nil {
 		statusCode, errorResponse := error_mappers.ToHTTPError(err)
 		c.JSON(statusCode, errorResponse)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto_mappers.ToUserStatusResponseDTO(updatedUser))
+	response := dto_mappers.ToUserStatusResponseDTO(statusChange.User)
+	response.Load = dto_mappers.ToReviewLoadDTO(statusChange.Load)
+	if options.ReassignOpenReviews && !request.IsActive {
+		reassignment := dto_mappers.ToReassignmentReportDTO(statusChange.Reassignment)
+		response.Reassignment = &reassignment
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 func (h *UserHandler) GetUserReviews(c *gin.Context) {
@@ -60,12 +68,122 @@ func (h *UserHandler) GetUserReviews(c *gin.Context) {
 	}
 
 	parsedUserID := value_objects.UserID(userID)
-	pullRequests, err := h.userService.GetUserReviews(c, parsedUserID)
+	filter := services.ReviewsFilter{PendingOnly: c.Query("pending") == "true"}
+	pullRequests, err := h.userService.GetUserReviews(c, parsedUserID, filter)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	load, err := h.userService.GetReviewLoad(c, parsedUserID)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToUserReviewsResponseDTO(parsedUserID, pullRequests, load))
+}
+
+func (h *UserHandler) SetMaxOpenReviews(c *gin.Context) {
+	var request dto.SetMaxOpenReviewsRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	userID := value_objects.UserID(request.UserID)
+	updatedUser, err := h.userService.SetMaxOpenReviews(c, userID, request.MaxOpenReviews)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	load, err := h.userService.GetReviewLoad(c, userID)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	response := dto_mappers.ToUserStatusResponseDTO(updatedUser)
+	response.Load = dto_mappers.ToReviewLoadDTO(load)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *UserHandler) AddAvailabilityWindow(c *gin.Context) {
+	var request dto.AddAvailabilityRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	window, err := h.userService.AddAvailabilityWindow(c, dto_mappers.FromAddAvailabilityRequestDTO(request))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto_mappers.ToAvailabilityWindowDTO(window))
+}
+
+func (h *UserHandler) GetAvailability(c *gin.Context) {
+	userID := c.DefaultQuery("user_id", "")
+	if userID == "" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.MissingUserID,
+				Message: apierrors.MissingUserIDMessage,
+			},
+		})
+		return
+	}
+
+	parsedUserID := value_objects.UserID(userID)
+	availability, err := h.userService.GetAvailability(c, parsedUserID)
 	if err != nil {
 		statusCode, errorResponse := error_mappers.ToHTTPError(err)
 		c.JSON(statusCode, errorResponse)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto_mappers.ToUserReviewsResponseDTO(parsedUserID, pullRequests))
+	c.JSON(http.StatusOK, dto_mappers.ToUserAvailabilityResponseDTO(parsedUserID, availability))
+}
+
+func (h *UserHandler) DeleteAvailabilityWindow(c *gin.Context) {
+	var request dto.DeleteAvailabilityRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	if err := h.userService.DeleteAvailabilityWindow(c, value_objects.UserID(request.UserID), request.WindowID); err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
 }
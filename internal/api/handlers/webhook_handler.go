@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"pr-service/internal/api/apierrors"
+	"pr-service/internal/api/dto"
+	"pr-service/internal/api/mappers/dto_mappers"
+	"pr-service/internal/api/mappers/error_mappers"
+	"pr-service/internal/app/services"
+)
+
+type WebhookHandler struct {
+	webhookService services.WebhookService
+}
+
+func NewWebhookHandler(webhookService services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	if !requireAdmin(c, apierrors.WebhooksForbiddenMessage) {
+		return
+	}
+
+	var request dto.CreateWebhookRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	webhook, err := h.webhookService.Create(c, dto_mappers.FromCreateWebhookRequestDTO(request))
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto_mappers.ToWebhookResponseDTO(webhook))
+}
+
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	if !requireAdmin(c, apierrors.WebhooksForbiddenMessage) {
+		return
+	}
+
+	webhooks, err := h.webhookService.GetAll(c)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToWebhooksResponseDTO(webhooks))
+}
+
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	if !requireAdmin(c, apierrors.WebhooksForbiddenMessage) {
+		return
+	}
+
+	var request dto.DeleteWebhookRequest
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidRequestBody,
+				Message: apierrors.InvalidRequestBodyMessage,
+			},
+		})
+		return
+	}
+
+	if err := h.webhookService.Delete(c, request.WebhookID); err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *WebhookHandler) GetDeadLetters(c *gin.Context) {
+	if !requireAdmin(c, apierrors.WebhooksForbiddenMessage) {
+		return
+	}
+
+	webhookID, err := strconv.ParseInt(c.DefaultQuery("webhook_id", ""), 10, 64)
+	if err != nil || webhookID <= 0 {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.MissingWebhookID,
+				Message: apierrors.MissingWebhookIDMessage,
+			},
+		})
+		return
+	}
+
+	deadLetters, err := h.webhookService.GetDeadLetters(c, webhookID)
+	if err != nil {
+		statusCode, errorResponse := error_mappers.ToHTTPError(err)
+		c.JSON(statusCode, errorResponse)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto_mappers.ToWebhookDeadLettersResponseDTO(webhookID, deadLetters))
+}
@@ -0,0 +1,29 @@
+package dto_mappers
+
+import (
+	"pr-service/internal/api/dto"
+	"pr-service/internal/app/services"
+)
+
+func ToAuditResponseDTO(page services.AuditPage) dto.AuditResponse {
+	response := dto.AuditResponse{
+		Entries:    make([]dto.AuditEntry, len(page.Entries)),
+		NextCursor: page.NextCursor,
+	}
+
+	for i, entry := range page.Entries {
+		response.Entries[i] = dto.AuditEntry{
+			AuditID:    entry.ID,
+			Actor:      entry.Actor,
+			Action:     string(entry.Action),
+			EntityType: string(entry.EntityType),
+			EntityID:   entry.EntityID,
+			Before:     entry.Before,
+			After:      entry.After,
+			RequestID:  entry.RequestID,
+			CreatedAt:  entry.CreatedAt.UTC().Format(dateFormat),
+		}
+	}
+
+	return response
+}
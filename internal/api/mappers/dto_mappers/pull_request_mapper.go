@@ -2,6 +2,7 @@ package dto_mappers
 
 import (
 	"pr-service/internal/api/dto"
+	"pr-service/internal/app/services"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
 )
@@ -16,6 +17,27 @@ func FromCreatePullRequestDTO(dto dto.CreatePullRequest) (value_objects.PullRequ
 	return pullRequestID, pullRequestName, authorID
 }
 
+func FromReviewerQuotasDTO(quotas []dto.ReviewerQuota) []entities.ReviewerQuota {
+	var result []entities.ReviewerQuota
+
+	for _, quota := range quotas {
+		result = append(result, entities.ReviewerQuota{
+			TeamName: value_objects.TeamName(quota.TeamName),
+			Count:    quota.Count,
+		})
+	}
+
+	return result
+}
+
+func FromSubmitReviewRequestDTO(request dto.SubmitReviewRequest) (value_objects.PullRequestID, value_objects.UserID, entities.ReviewDecision) {
+	pullRequestID := value_objects.PullRequestID(request.PullRequestID)
+	reviewerID := value_objects.UserID(request.ReviewerID)
+	decision := entities.ReviewDecision(request.Decision)
+
+	return pullRequestID, reviewerID, decision
+}
+
 func FromReassignReviewerRequestDTO(request dto.ReassignReviewerRequest) (value_objects.PullRequestID, value_objects.UserID) {
 	pullRequestID := value_objects.PullRequestID(request.PullRequestID)
 	oldReviewerID := value_objects.UserID(request.OldReviewerID)
@@ -23,6 +45,17 @@ func FromReassignReviewerRequestDTO(request dto.ReassignReviewerRequest) (value_
 	return pullRequestID, oldReviewerID
 }
 
+func FromReassignOptionsDTO(request dto.ReassignReviewerRequest, expectedVersion *int) services.ReassignOptions {
+	options := services.ReassignOptions{ExpectedVersion: expectedVersion}
+
+	if request.NewReviewerID != "" {
+		newReviewerID := value_objects.UserID(request.NewReviewerID)
+		options.NewReviewerID = &newReviewerID
+	}
+
+	return options
+}
+
 func ToPullRequestReassignResponseDTO(pullRequest entities.PullRequest, newReviewerID value_objects.UserID) dto.PullRequestReassignResponse {
 	return dto.PullRequestReassignResponse{
 		PullRequest: ToPullRequestResponseDTO(pullRequest),
@@ -37,14 +70,26 @@ func ToPullRequestResponseDTO(pullRequest entities.PullRequest) dto.PullRequestR
 		mergedAt = &mergedAtStr
 	}
 
+	var closedAt *string
+	if pullRequest.ClosedAt != nil {
+		closedAtStr := pullRequest.ClosedAt.Format(dateFormat)
+		closedAt = &closedAtStr
+	}
+
 	return dto.PullRequestResponse{
-		PullRequestID:     string(pullRequest.ID),
-		PullRequestName:   pullRequest.Name,
-		AuthorID:          string(pullRequest.AuthorID),
-		Status:            string(pullRequest.Status),
-		AssignedReviewers: toStringSlice(pullRequest.Reviewers()),
-		CreatedAt:         pullRequest.CreatedAt.Format(dateFormat),
-		MergedAt:          mergedAt,
+		PullRequestID:       string(pullRequest.ID),
+		PullRequestName:     pullRequest.Name,
+		AuthorID:            string(pullRequest.AuthorID),
+		Status:              string(pullRequest.Status),
+		AssignedReviewers:   toStringSlice(pullRequest.Reviewers()),
+		ReviewerAssignments: toReviewerAssignmentResponseDTOs(pullRequest.Assignments()),
+		ReviewersCount:      pullRequest.MaxReviewers(),
+		Reviews:             toReviewResponseDTOs(pullRequest.Reviews()),
+		ReviewDecision:      string(pullRequest.ReviewDecision()),
+		CreatedAt:           pullRequest.CreatedAt.Format(dateFormat),
+		MergedAt:            mergedAt,
+		ClosedAt:            closedAt,
+		ForceMerged:         pullRequest.ForceMerged,
 	}
 }
 
@@ -57,6 +102,61 @@ func ToPullRequestShortDTO(pullRequest entities.PullRequest) dto.PullRequestShor
 	}
 }
 
+func toReviewResponseDTOs(reviews []entities.Review) []dto.ReviewResponse {
+	reviewDTOs := make([]dto.ReviewResponse, 0, len(reviews))
+
+	for _, review := range reviews {
+		var decidedAt *string
+		if review.DecidedAt != nil {
+			decidedAtStr := review.DecidedAt.Format(dateFormat)
+			decidedAt = &decidedAtStr
+		}
+
+		reviewDTOs = append(reviewDTOs, dto.ReviewResponse{
+			ReviewerID: string(review.ReviewerID),
+			Decision:   string(review.Decision),
+			DecidedAt:  decidedAt,
+		})
+	}
+
+	return reviewDTOs
+}
+
+func toReviewerAssignmentResponseDTOs(assignments []entities.ReviewerAssignment) []dto.ReviewerAssignmentResponse {
+	assignmentDTOs := make([]dto.ReviewerAssignmentResponse, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		assignmentDTOs = append(assignmentDTOs, dto.ReviewerAssignmentResponse{
+			ReviewerID:     string(assignment.ReviewerID),
+			Level:          assignment.Level,
+			SourceTeamName: string(assignment.SourceTeam),
+		})
+	}
+
+	return assignmentDTOs
+}
+
+func ToReviewerHistoryResponseDTO(pullRequestID value_objects.PullRequestID, changes []entities.ReviewerChange) dto.ReviewerHistoryResponse {
+	changeDTOs := make([]dto.ReviewerChangeResponse, len(changes))
+
+	for i, change := range changes {
+		changeDTOs[i] = dto.ReviewerChangeResponse{
+			OldReviewerID:  string(change.OldReviewerID),
+			NewReviewerID:  string(change.NewReviewerID),
+			Level:          change.Level,
+			SourceTeamName: string(change.SourceTeam),
+			AssignedAt:     change.AssignedAt.Format(dateFormat),
+			Reason:         string(change.Reason),
+			ChangedAt:      change.ChangedAt.Format(dateFormat),
+		}
+	}
+
+	return dto.ReviewerHistoryResponse{
+		PullRequestID: string(pullRequestID),
+		History:       changeDTOs,
+	}
+}
+
 func toStringSlice(userIDs []value_objects.UserID) []string {
 	var result []string
 
@@ -1,16 +1,67 @@
 package dto_mappers
 
 import (
+	"time"
+
 	"pr-service/internal/api/dto"
+	"pr-service/internal/app/services"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
 )
 
-func FromCreateTeamRequestDTO(dto dto.CreateTeamRequest) (value_objects.TeamName, []entities.User) {
+func FromCreateTeamRequestDTO(dto dto.CreateTeamRequest) (entities.Team, []entities.User) {
 	teamName := value_objects.TeamName(dto.TeamName)
-	users := make([]entities.User, len(dto.Members))
+	team := entities.Team{
+		Name:               teamName,
+		Parent:             value_objects.TeamName(dto.ParentTeamName),
+		AssignmentStrategy: entities.AssignmentStrategy(dto.AssignmentStrategy),
+		ReviewersLimit:     dto.ReviewersCount,
+		LeadID:             value_objects.UserID(dto.LeadID),
+		MergePolicy:        FromMergePolicyDTO(dto.MergePolicy),
+		MaxOpenReviews:     dto.MaxOpenReviews,
+		ReviewSLA:          FromReviewSLADTO(dto.ReviewSLA),
+	}
+
+	return team, FromTeamMembersDTO(teamName, dto.Members)
+}
+
+func FromDeactivateTeamRequestDTO(request dto.DeactivateTeamRequest) services.DeactivateOptions {
+	options := services.DeactivateOptions{}
+
+	for _, userID := range request.UserIDs {
+		options.UserIDs = append(options.UserIDs, value_objects.UserID(userID))
+	}
+
+	if request.FallbackTeamName != "" {
+		fallbackTeamName := value_objects.TeamName(request.FallbackTeamName)
+		options.FallbackTeamName = &fallbackTeamName
+	}
+
+	return options
+}
+
+func ToDeactivateTeamResponseDTO(teamName value_objects.TeamName, users []entities.User, report services.ReassignmentReport) dto.DeactivateTeamResponse {
+	memberDTOs := make([]dto.TeamMember, len(users))
 
-	for i, member := range dto.Members {
+	for i, user := range users {
+		memberDTOs[i] = dto.TeamMember{
+			UserID:   string(user.ID),
+			Username: user.Username,
+			IsActive: user.IsActive,
+		}
+	}
+
+	return dto.DeactivateTeamResponse{
+		TeamName:         string(teamName),
+		DeactivatedUsers: memberDTOs,
+		Reassignment:     ToReassignmentReportDTO(report),
+	}
+}
+
+func FromTeamMembersDTO(teamName value_objects.TeamName, members []dto.TeamMember) []entities.User {
+	users := make([]entities.User, len(members))
+
+	for i, member := range members {
 		users[i] = entities.User{
 			ID:       value_objects.UserID(member.UserID),
 			Username: member.Username,
@@ -19,7 +70,47 @@ func FromCreateTeamRequestDTO(dto dto.CreateTeamRequest) (value_objects.TeamName
 		}
 	}
 
-	return teamName, users
+	return users
+}
+
+func FromUserIDsDTO(userIDs []string) []value_objects.UserID {
+	result := make([]value_objects.UserID, len(userIDs))
+
+	for i, userID := range userIDs {
+		result[i] = value_objects.UserID(userID)
+	}
+
+	return result
+}
+
+func ToMembershipChangesDTO(changes []entities.MembershipChange) []dto.MembershipChange {
+	changeDTOs := make([]dto.MembershipChange, len(changes))
+
+	for i, change := range changes {
+		changeDTOs[i] = dto.MembershipChange{
+			UserID:       string(change.UserID),
+			Action:       string(change.Action),
+			FromTeamName: string(change.FromTeam),
+			ToTeamName:   string(change.ToTeam),
+			ChangedAt:    change.ChangedAt.Format(dateFormat),
+		}
+	}
+
+	return changeDTOs
+}
+
+func ToTeamMembershipResponseDTO(teamName value_objects.TeamName, changes []entities.MembershipChange, report *services.ReassignmentReport) dto.TeamMembershipResponse {
+	response := dto.TeamMembershipResponse{
+		TeamName: string(teamName),
+		Changes:  ToMembershipChangesDTO(changes),
+	}
+
+	if report != nil {
+		reassignment := ToReassignmentReportDTO(*report)
+		response.Reassignment = &reassignment
+	}
+
+	return response
 }
 
 func ToTeamResponseDTO(team entities.Team, members []entities.User) dto.TeamResponse {
@@ -33,8 +124,48 @@ func ToTeamResponseDTO(team entities.Team, members []entities.User) dto.TeamResp
 		})
 	}
 
-	return dto.TeamResponse{
-		TeamName: string(team.Name),
-		Members:  memberDTOs,
+	response := dto.TeamResponse{
+		TeamID:             string(team.ID),
+		TeamName:           string(team.Name),
+		ParentTeamName:     string(team.Parent),
+		AssignmentStrategy: string(team.AssignmentStrategy),
+		ReviewersCount:     team.DefaultReviewersLimit(),
+		LeadID:             string(team.LeadID),
+		MergePolicy: dto.MergePolicy{
+			MinApprovals:        team.MergePolicy.MinApprovals,
+			RequireLeadApproval: team.MergePolicy.RequireLeadApproval,
+		},
+		MaxOpenReviews: team.MaxOpenReviews,
+		ReviewSLA:      ToReviewSLADTO(team.ReviewSLA),
+		Members:        memberDTOs,
+	}
+
+	if team.ArchivedAt != nil {
+		response.ArchivedAt = team.ArchivedAt.Format(dateFormat)
+	}
+
+	return response
+}
+
+func FromMergePolicyDTO(policy dto.MergePolicy) entities.MergePolicy {
+	return entities.MergePolicy{
+		MinApprovals:        policy.MinApprovals,
+		RequireLeadApproval: policy.RequireLeadApproval,
+	}
+}
+
+func FromReviewSLADTO(sla dto.ReviewSLA) entities.ReviewSLA {
+	return entities.ReviewSLA{
+		RemindAfter:   time.Duration(sla.RemindAfterHours) * time.Hour,
+		EscalateAfter: time.Duration(sla.EscalateAfterHours) * time.Hour,
+		ReassignAfter: time.Duration(sla.ReassignAfterHours) * time.Hour,
+	}
+}
+
+func ToReviewSLADTO(sla entities.ReviewSLA) dto.ReviewSLA {
+	return dto.ReviewSLA{
+		RemindAfterHours:   int(sla.RemindAfter / time.Hour),
+		EscalateAfterHours: int(sla.EscalateAfter / time.Hour),
+		ReassignAfterHours: int(sla.ReassignAfter / time.Hour),
 	}
 }
@@ -2,6 +2,7 @@ package dto_mappers
 
 import (
 	"pr-service/internal/api/dto"
+	"pr-service/internal/app/services"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
 )
@@ -15,7 +16,35 @@ func ToUserStatusResponseDTO(user entities.User) dto.UserStatusResponse {
 	}
 }
 
-func ToUserReviewsResponseDTO(userID value_objects.UserID, pullRequests []entities.PullRequest) dto.UserReviewsResponse {
+func ToReviewLoadDTO(load services.ReviewLoad) dto.ReviewLoad {
+	return dto.ReviewLoad{
+		OpenReviews:    load.OpenReviews,
+		MaxOpenReviews: load.MaxOpenReviews,
+	}
+}
+
+func ToReassignmentReportDTO(report services.ReassignmentReport) dto.ReassignmentReport {
+	reassigned := make([]dto.ReviewReassignment, len(report.Reassigned))
+	for i, reassignment := range report.Reassigned {
+		reassigned[i] = dto.ReviewReassignment{
+			PullRequestID: string(reassignment.PullRequestID),
+			OldReviewerID: string(reassignment.OldReviewerID),
+			NewReviewerID: string(reassignment.NewReviewerID),
+		}
+	}
+
+	withoutCandidate := make([]string, len(report.WithoutCandidate))
+	for i, pullRequestID := range report.WithoutCandidate {
+		withoutCandidate[i] = string(pullRequestID)
+	}
+
+	return dto.ReassignmentReport{
+		Reassigned:       reassigned,
+		WithoutCandidate: withoutCandidate,
+	}
+}
+
+func ToUserReviewsResponseDTO(userID value_objects.UserID, pullRequests []entities.PullRequest, load services.ReviewLoad) dto.UserReviewsResponse {
 	pullRequestShortDTOs := make([]dto.PullRequestShort, len(pullRequests))
 
 	for i, pullRequest := range pullRequests {
@@ -24,6 +53,39 @@ func ToUserReviewsResponseDTO(userID value_objects.UserID, pullRequests []entiti
 
 	return dto.UserReviewsResponse{
 		UserID:       string(userID),
+		Load:         ToReviewLoadDTO(load),
 		PullRequests: pullRequestShortDTOs,
 	}
 }
+
+func FromAddAvailabilityRequestDTO(request dto.AddAvailabilityRequest) entities.AvailabilityWindow {
+	return entities.AvailabilityWindow{
+		UserID:   value_objects.UserID(request.UserID),
+		StartsAt: request.StartsAt.UTC(),
+		EndsAt:   request.EndsAt.UTC(),
+		Reason:   request.Reason,
+	}
+}
+
+func ToAvailabilityWindowDTO(window entities.AvailabilityWindow) dto.AvailabilityWindow {
+	return dto.AvailabilityWindow{
+		WindowID: window.ID,
+		UserID:   string(window.UserID),
+		StartsAt: window.StartsAt.UTC().Format(dateFormat),
+		EndsAt:   window.EndsAt.UTC().Format(dateFormat),
+		Reason:   window.Reason,
+	}
+}
+
+func ToUserAvailabilityResponseDTO(userID value_objects.UserID, availability services.Availability) dto.UserAvailabilityResponse {
+	windows := make([]dto.AvailabilityWindow, len(availability.Windows))
+	for i, window := range availability.Windows {
+		windows[i] = ToAvailabilityWindowDTO(window)
+	}
+
+	return dto.UserAvailabilityResponse{
+		UserID:       string(userID),
+		AvailableNow: availability.AvailableNow,
+		Windows:      windows,
+	}
+}
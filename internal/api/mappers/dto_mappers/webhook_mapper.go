@@ -0,0 +1,63 @@
+package dto_mappers
+
+import (
+	"pr-service/internal/api/dto"
+	"pr-service/internal/domain/entities"
+)
+
+func FromCreateWebhookRequestDTO(request dto.CreateWebhookRequest) entities.Webhook {
+	eventTypes := make([]entities.EventType, len(request.EventTypes))
+	for i, eventType := range request.EventTypes {
+		eventTypes[i] = entities.EventType(eventType)
+	}
+
+	return entities.Webhook{
+		URL:        request.URL,
+		Secret:     request.Secret,
+		EventTypes: eventTypes,
+	}
+}
+
+func ToWebhookResponseDTO(webhook entities.Webhook) dto.WebhookResponse {
+	eventTypes := make([]string, len(webhook.EventTypes))
+	for i, eventType := range webhook.EventTypes {
+		eventTypes[i] = string(eventType)
+	}
+
+	return dto.WebhookResponse{
+		WebhookID:  webhook.ID,
+		URL:        webhook.URL,
+		EventTypes: eventTypes,
+		CreatedAt:  webhook.CreatedAt.UTC().Format(dateFormat),
+	}
+}
+
+func ToWebhooksResponseDTO(webhooks []entities.Webhook) dto.WebhooksResponse {
+	response := dto.WebhooksResponse{Webhooks: make([]dto.WebhookResponse, len(webhooks))}
+	for i, webhook := range webhooks {
+		response.Webhooks[i] = ToWebhookResponseDTO(webhook)
+	}
+
+	return response
+}
+
+func ToWebhookDeadLettersResponseDTO(webhookID int64, deadLetters []entities.WebhookDeadLetter) dto.WebhookDeadLettersResponse {
+	response := dto.WebhookDeadLettersResponse{
+		WebhookID:   webhookID,
+		DeadLetters: make([]dto.WebhookDeadLetter, len(deadLetters)),
+	}
+
+	for i, deadLetter := range deadLetters {
+		response.DeadLetters[i] = dto.WebhookDeadLetter{
+			DeadLetterID: deadLetter.ID,
+			EventType:    string(deadLetter.Event.Type),
+			OccurredAt:   deadLetter.Event.OccurredAt.UTC().Format(dateFormat),
+			Payload:      deadLetter.Event.Payload,
+			Attempts:     deadLetter.Attempts,
+			LastError:    deadLetter.LastError,
+			FailedAt:     deadLetter.FailedAt.UTC().Format(dateFormat),
+		}
+	}
+
+	return response
+}
@@ -59,9 +59,178 @@ func ToHTTPError(domainErr error) (int, dto.ErrorResponse) {
 			},
 		}
 
+	case errors.Is(domainErr, domain.ErrConcurrentModification):
+		return http.StatusConflict, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.ConcurrentModified,
+				Message: apierrors.ConcurrentModifiedMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrMergeBlocked):
+		var details []string
+		var mergeBlockedErr *domain.MergeBlockedError
+		if errors.As(domainErr, &mergeBlockedErr) {
+			details = mergeBlockedErr.Conditions
+		}
+
+		return http.StatusConflict, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.MergeBlocked,
+				Message: apierrors.MergeBlockedMessage,
+				Details: details,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrPRNotOpen):
+		return http.StatusConflict, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.PRNotOpen,
+				Message: apierrors.PRNotOpenMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidTransition):
+		return http.StatusConflict, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidTransition,
+				Message: apierrors.InvalidTransitionMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrReviewerNotEligible):
+		return http.StatusConflict, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.NotEligible,
+				Message: apierrors.NotEligibleMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrNotTeamMember):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.NotTeamMember,
+				Message: apierrors.NotTeamMemberMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrMemberExists):
+		return http.StatusConflict, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.MemberExists,
+				Message: apierrors.MemberExistsMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrTeamArchived):
+		return http.StatusConflict, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.TeamArchived,
+				Message: apierrors.TeamArchivedMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrTeamInUse):
+		return http.StatusConflict, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.TeamInUse,
+				Message: apierrors.TeamInUseMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidTeamParent):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidTeamParent,
+				Message: apierrors.InvalidTeamParentMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidReviewerQuota):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidQuota,
+				Message: apierrors.InvalidQuotaMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidAvailabilityWindow):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidWindow,
+				Message: apierrors.InvalidWindowMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidMaxOpenReviews):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidCapacity,
+				Message: apierrors.InvalidCapacityMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidReviewSLA):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidReviewSLA,
+				Message: apierrors.InvalidReviewSLAMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidWebhook):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidWebhook,
+				Message: apierrors.InvalidWebhookMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidAuditQuery):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidAuditQuery,
+				Message: apierrors.InvalidAuditQueryMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidStrategy):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidStrategy,
+				Message: apierrors.InvalidStrategyMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidReviewersCount):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidReviewers,
+				Message: apierrors.InvalidReviewersMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidDecision):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidDecision,
+				Message: apierrors.InvalidDecisionMessage,
+			},
+		}
+
+	case errors.Is(domainErr, domain.ErrInvalidMergePolicy):
+		return http.StatusBadRequest, dto.ErrorResponse{
+			Error: dto.Error{
+				Code:    apierrors.InvalidMergePolicy,
+				Message: apierrors.InvalidMergePolicyMessage,
+			},
+		}
+
 	case errors.Is(domainErr, domain.ErrUserNotFound),
 		errors.Is(domainErr, domain.ErrTeamNotFound),
-		errors.Is(domainErr, domain.ErrPRNotFound):
+		errors.Is(domainErr, domain.ErrPRNotFound),
+		errors.Is(domainErr, domain.ErrAvailabilityWindowNotFound),
+		errors.Is(domainErr, domain.ErrWebhookNotFound):
 		return http.StatusNotFound, dto.ErrorResponse{
 			Error: dto.Error{
 				Code:    apierrors.NotFound,
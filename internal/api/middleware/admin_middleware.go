@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	AdminTokenHeader = "X-Admin-Token"
+
+	isAdminKey = "is_admin"
+)
+
+func AdminMiddleware(adminToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(AdminTokenHeader)
+		if adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1 {
+			c.Set(isAdminKey, true)
+		}
+
+		c.Next()
+	}
+}
+
+func IsAdmin(c *gin.Context) bool {
+	return c.GetBool(isAdminKey)
+}
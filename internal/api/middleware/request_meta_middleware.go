@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+
+	"pr-service/internal/app"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+	ActorHeader     = "X-Actor"
+
+	adminActor     = "admin"
+	anonymousActor = "anonymous"
+)
+
+func RequestMetaMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		actor := anonymousActor
+		if IsAdmin(c) {
+			actor = adminActor
+			if claimedActor := c.GetHeader(ActorHeader); claimedActor != "" {
+				actor = claimedActor
+			}
+		}
+
+		meta := app.RequestMeta{Actor: actor, RequestID: requestID}
+		c.Request = c.Request.WithContext(app.WithRequestMeta(c.Request.Context(), meta))
+
+		c.Next()
+	}
+}
+
+func newRequestID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+
+	return hex.EncodeToString(buf)
+}
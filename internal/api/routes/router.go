@@ -7,8 +7,9 @@ import (
 	"pr-service/internal/api/middleware"
 )
 
-func Setup(userHandler *handlers.UserHandler, teamHandler *handlers.TeamHandler, pullRequestHandler *handlers.PullRequestHandler, statsHandler *handlers.StatsHandler) *gin.Engine {
+func Setup(userHandler *handlers.UserHandler, teamHandler *handlers.TeamHandler, pullRequestHandler *handlers.PullRequestHandler, statsHandler *handlers.StatsHandler, webhookHandler *handlers.WebhookHandler, auditHandler *handlers.AuditHandler, adminToken string) *gin.Engine {
 	router := gin.Default()
+	router.ContextWithFallback = true
 
 	err := router.SetTrustedProxies(nil)
 	if err != nil {
@@ -16,18 +17,52 @@ func Setup(userHandler *handlers.UserHandler, teamHandler *handlers.TeamHandler,
 	}
 
 	router.Use(middleware.LoggerMiddleware())
+	router.Use(middleware.AdminMiddleware(adminToken))
+	router.Use(middleware.RequestMetaMiddleware())
 
 	router.POST("/users/setIsActive", userHandler.SetActiveStatus)
 	router.GET("/users/getReview", userHandler.GetUserReviews)
+	router.POST("/users/setMaxOpenReviews", userHandler.SetMaxOpenReviews)
+	router.POST("/users/availability/add", userHandler.AddAvailabilityWindow)
+	router.GET("/users/availability", userHandler.GetAvailability)
+	router.POST("/users/availability/delete", userHandler.DeleteAvailabilityWindow)
 
 	router.POST("/team/add", teamHandler.CreateTeam)
 	router.GET("/team/get", teamHandler.GetTeam)
+	router.POST("/team/setAssignmentStrategy", teamHandler.SetAssignmentStrategy)
+	router.POST("/team/setMergePolicy", teamHandler.SetMergePolicy)
+	router.POST("/team/setMaxOpenReviews", teamHandler.SetMaxOpenReviews)
+	router.POST("/team/setReviewSLA", teamHandler.SetReviewSLA)
+	router.POST("/team/deactivate", teamHandler.Deactivate)
+	router.POST("/team/members/add", teamHandler.AddMembers)
+	router.POST("/team/members/remove", teamHandler.RemoveMembers)
+	router.POST("/team/members/move", teamHandler.MoveMembers)
+	router.GET("/team/members/history", teamHandler.GetMembershipHistory)
+	router.POST("/team/archive", teamHandler.Archive)
+	router.POST("/team/delete", teamHandler.Delete)
+	router.POST("/team/rename", teamHandler.Rename)
+	router.POST("/team/setParent", teamHandler.SetParent)
 
 	router.POST("/pullRequest/create", pullRequestHandler.CreatePullRequest)
+	router.GET("/pullRequest/get", pullRequestHandler.GetPullRequest)
+	router.GET("/pullRequest/reviewers/history", pullRequestHandler.GetReviewerHistory)
 	router.POST("/pullRequest/merge", pullRequestHandler.MergePullRequest)
 	router.POST("/pullRequest/reassign", pullRequestHandler.ReassignReviewer)
+	router.POST("/pullRequest/assign", pullRequestHandler.AssignReviewers)
+	router.POST("/pullRequest/unassign", pullRequestHandler.UnassignReviewer)
+	router.POST("/pullRequest/review", pullRequestHandler.SubmitReview)
+	router.POST("/pullRequest/ready", pullRequestHandler.MarkReady)
+	router.POST("/pullRequest/close", pullRequestHandler.ClosePullRequest)
+	router.POST("/pullRequest/reopen", pullRequestHandler.ReopenPullRequest)
 
 	router.GET("/stats", statsHandler.GetStats)
 
+	router.POST("/webhooks", webhookHandler.CreateWebhook)
+	router.GET("/webhooks", webhookHandler.GetWebhooks)
+	router.POST("/webhooks/delete", webhookHandler.DeleteWebhook)
+	router.GET("/webhooks/deadLetters", webhookHandler.GetDeadLetters)
+
+	router.GET("/audit", auditHandler.GetAuditLog)
+
 	return router
 }
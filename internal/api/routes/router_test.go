@@ -0,0 +1,1178 @@
+package routes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/api/dto"
+	"pr-service/internal/api/handlers"
+	"pr-service/internal/app/assignment"
+	"pr-service/internal/app/services"
+	"pr-service/internal/infrastructure/memory"
+	"pr-service/internal/infrastructure/providers"
+)
+
+const testAdminToken = "admin-secret"
+
+func newTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	store := memory.NewStore()
+	txManager := memory.NewTxManager(store)
+	userRepository := memory.NewUserRepository(store)
+	teamRepository := memory.NewTeamRepository(store)
+	pullRequestRepository := memory.NewPullRequestRepository(store)
+	webhookRepository := memory.NewWebhookRepository(store)
+	outboxRepository := memory.NewOutboxRepository(store)
+	auditRepository := memory.NewAuditRepository(store)
+
+	timeProvider := providers.NewCurrentTime()
+	assignmentStrategy := assignment.NewRegistry(teamRepository, pullRequestRepository, providers.NewRealRandom())
+
+	userService := services.NewUserService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignmentStrategy, outboxRepository, auditRepository)
+	teamService := services.NewTeamService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignmentStrategy, outboxRepository, auditRepository)
+	pullRequestService := services.NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignmentStrategy, outboxRepository, auditRepository)
+	statsService := services.NewStatsService(userRepository, teamRepository, pullRequestRepository)
+	webhookService := services.NewWebhookService(webhookRepository, timeProvider)
+	auditService := services.NewAuditService(auditRepository)
+
+	router := Setup(
+		handlers.NewUserHandler(userService),
+		handlers.NewTeamHandler(teamService),
+		handlers.NewPullRequestHandler(pullRequestService),
+		handlers.NewStatsHandler(statsService),
+		handlers.NewWebhookHandler(webhookService),
+		handlers.NewAuditHandler(auditService),
+		testAdminToken,
+	)
+	require.NotNil(t, router)
+
+	return router
+}
+
+func doRequest(t *testing.T, router *gin.Engine, method, path string, body any, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var payload bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+
+	request := httptest.NewRequest(method, path, &payload)
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	return recorder
+}
+
+func decode[T any](t *testing.T, recorder *httptest.ResponseRecorder) T {
+	t.Helper()
+
+	var result T
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &result))
+
+	return result
+}
+
+func createBackendTeam(t *testing.T, router *gin.Engine) {
+	t.Helper()
+
+	response := doRequest(t, router, http.MethodPost, "/team/add", dto.CreateTeamRequest{
+		TeamName: "backend",
+		Members: []dto.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: true},
+			{UserID: "u3", Username: "Carol", IsActive: true},
+			{UserID: "u4", Username: "Dave", IsActive: true},
+		},
+	}, nil)
+	require.Equal(t, http.StatusCreated, response.Code)
+}
+
+func TestRouter_PullRequestLifecycle(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	created := decode[dto.PullRequestResponse](t, createResponse)
+	assert.Equal(t, "OPEN", created.Status)
+	assert.Len(t, created.AssignedReviewers, 2)
+	assert.NotContains(t, created.AssignedReviewers, "u1")
+	assert.Equal(t, `"1"`, createResponse.Header().Get("ETag"))
+
+	reassignResponse := doRequest(t, router, http.MethodPost, "/pullRequest/reassign", dto.ReassignReviewerRequest{
+		PullRequestID: "pr-1",
+		OldReviewerID: created.AssignedReviewers[0],
+	}, nil)
+	require.Equal(t, http.StatusOK, reassignResponse.Code)
+
+	reassigned := decode[dto.PullRequestReassignResponse](t, reassignResponse)
+	assert.NotContains(t, reassigned.PullRequest.AssignedReviewers, created.AssignedReviewers[0])
+	assert.Contains(t, reassigned.PullRequest.AssignedReviewers, reassigned.ReplacedBy)
+
+	reviewsResponse := doRequest(t, router, http.MethodGet, "/users/getReview?user_id="+reassigned.ReplacedBy, nil, nil)
+	require.Equal(t, http.StatusOK, reviewsResponse.Code)
+
+	reviews := decode[dto.UserReviewsResponse](t, reviewsResponse)
+	require.Len(t, reviews.PullRequests, 1)
+	assert.Equal(t, "pr-1", reviews.PullRequests[0].PullRequestID)
+
+	mergeResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1"}, nil)
+	require.Equal(t, http.StatusOK, mergeResponse.Code)
+
+	merged := decode[dto.PullRequestResponse](t, mergeResponse)
+	assert.Equal(t, "MERGED", merged.Status)
+	assert.NotNil(t, merged.MergedAt)
+
+	statsResponse := doRequest(t, router, http.MethodGet, "/stats", nil, nil)
+	require.Equal(t, http.StatusOK, statsResponse.Code)
+
+	stats := decode[dto.StatsResponse](t, statsResponse)
+	assert.Equal(t, 1, stats.TotalPullRequests)
+	assert.Equal(t, 1, stats.MergedPullRequests)
+}
+
+func TestRouter_MergeWithStaleIfMatch(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+	created := decode[dto.PullRequestResponse](t, createResponse)
+
+	reassignResponse := doRequest(t, router, http.MethodPost, "/pullRequest/reassign", dto.ReassignReviewerRequest{
+		PullRequestID: "pr-1",
+		OldReviewerID: created.AssignedReviewers[0],
+	}, map[string]string{"If-Match": createResponse.Header().Get("ETag")})
+	require.Equal(t, http.StatusOK, reassignResponse.Code)
+	assert.Equal(t, `"2"`, reassignResponse.Header().Get("ETag"))
+
+	staleMergeResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1"},
+		map[string]string{"If-Match": createResponse.Header().Get("ETag")})
+	assert.Equal(t, http.StatusConflict, staleMergeResponse.Code)
+
+	invalidMergeResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1"},
+		map[string]string{"If-Match": "not-a-version"})
+	assert.Equal(t, http.StatusBadRequest, invalidMergeResponse.Code)
+
+	getResponse := doRequest(t, router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil, nil)
+	require.Equal(t, http.StatusOK, getResponse.Code)
+	assert.Equal(t, "OPEN", decode[dto.PullRequestResponse](t, getResponse).Status)
+
+	mergeResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1"},
+		map[string]string{"If-Match": getResponse.Header().Get("ETag")})
+	assert.Equal(t, http.StatusOK, mergeResponse.Code)
+}
+
+func TestRouter_ReviewersCount(t *testing.T) {
+	router := newTestRouter(t)
+
+	teamResponse := doRequest(t, router, http.MethodPost, "/team/add", dto.CreateTeamRequest{
+		TeamName: "security",
+		Members: []dto.TeamMember{
+			{UserID: "s1", Username: "Alice", IsActive: true},
+			{UserID: "s2", Username: "Bob", IsActive: true},
+			{UserID: "s3", Username: "Carol", IsActive: true},
+			{UserID: "s4", Username: "Dave", IsActive: true},
+			{UserID: "s5", Username: "Eve", IsActive: true},
+		},
+		ReviewersCount: 3,
+	}, nil)
+	require.Equal(t, http.StatusCreated, teamResponse.Code)
+	assert.Equal(t, 3, decode[dto.TeamResponse](t, teamResponse).ReviewersCount)
+
+	defaultResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Rotate keys",
+		AuthorID:        "s1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, defaultResponse.Code)
+
+	defaultPullRequest := decode[dto.PullRequestResponse](t, defaultResponse)
+	assert.Equal(t, 3, defaultPullRequest.ReviewersCount)
+	assert.Len(t, defaultPullRequest.AssignedReviewers, 3)
+
+	reviewersCount := 1
+	overrideResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-2",
+		PullRequestName: "Fix typo",
+		AuthorID:        "s1",
+		ReviewersCount:  &reviewersCount,
+	}, nil)
+	require.Equal(t, http.StatusCreated, overrideResponse.Code)
+	assert.Len(t, decode[dto.PullRequestResponse](t, overrideResponse).AssignedReviewers, 1)
+
+	reviewersCount = 0
+	invalidResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-3",
+		PullRequestName: "Fix typo",
+		AuthorID:        "s1",
+		ReviewersCount:  &reviewersCount,
+	}, nil)
+	assert.Equal(t, http.StatusBadRequest, invalidResponse.Code)
+
+	statsResponse := doRequest(t, router, http.MethodGet, "/stats", nil, nil)
+	require.Equal(t, http.StatusOK, statsResponse.Code)
+	assert.Equal(t, 0, decode[dto.StatsResponse](t, statsResponse).UnderAssignedPRs)
+}
+
+func TestRouter_SubmitReview(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+	created := decode[dto.PullRequestResponse](t, createResponse)
+	require.Len(t, created.Reviews, 2)
+	assert.Equal(t, "PENDING", created.ReviewDecision)
+
+	reviewerID := created.AssignedReviewers[0]
+
+	reviewResponse := doRequest(t, router, http.MethodPost, "/pullRequest/review", dto.SubmitReviewRequest{
+		PullRequestID: "pr-1",
+		ReviewerID:    reviewerID,
+		Decision:      "APPROVED",
+	}, nil)
+	require.Equal(t, http.StatusOK, reviewResponse.Code)
+
+	reviewed := decode[dto.PullRequestResponse](t, reviewResponse)
+	assert.Equal(t, reviewerID, reviewed.Reviews[0].ReviewerID)
+	assert.Equal(t, "APPROVED", reviewed.Reviews[0].Decision)
+	assert.NotNil(t, reviewed.Reviews[0].DecidedAt)
+	assert.Equal(t, "PENDING", reviewed.Reviews[1].Decision)
+
+	pendingResponse := doRequest(t, router, http.MethodGet, "/users/getReview?pending=true&user_id="+reviewerID, nil, nil)
+	require.Equal(t, http.StatusOK, pendingResponse.Code)
+	assert.Empty(t, decode[dto.UserReviewsResponse](t, pendingResponse).PullRequests)
+
+	allResponse := doRequest(t, router, http.MethodGet, "/users/getReview?user_id="+reviewerID, nil, nil)
+	require.Equal(t, http.StatusOK, allResponse.Code)
+	assert.Len(t, decode[dto.UserReviewsResponse](t, allResponse).PullRequests, 1)
+
+	invalidResponse := doRequest(t, router, http.MethodPost, "/pullRequest/review", dto.SubmitReviewRequest{
+		PullRequestID: "pr-1",
+		ReviewerID:    reviewerID,
+		Decision:      "LGTM",
+	}, nil)
+	assert.Equal(t, http.StatusBadRequest, invalidResponse.Code)
+
+	notAssignedResponse := doRequest(t, router, http.MethodPost, "/pullRequest/review", dto.SubmitReviewRequest{
+		PullRequestID: "pr-1",
+		ReviewerID:    "u1",
+		Decision:      "APPROVED",
+	}, nil)
+	assert.Equal(t, http.StatusConflict, notAssignedResponse.Code)
+}
+
+func TestRouter_MergePolicy(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	policyResponse := doRequest(t, router, http.MethodPost, "/team/setMergePolicy", dto.SetMergePolicyRequest{
+		TeamName:    "backend",
+		MergePolicy: dto.MergePolicy{MinApprovals: 1},
+	}, nil)
+	require.Equal(t, http.StatusOK, policyResponse.Code)
+	assert.Equal(t, 1, decode[dto.TeamResponse](t, policyResponse).MergePolicy.MinApprovals)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+	created := decode[dto.PullRequestResponse](t, createResponse)
+
+	blockedResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1"}, nil)
+	require.Equal(t, http.StatusConflict, blockedResponse.Code)
+
+	blocked := decode[dto.ErrorResponse](t, blockedResponse)
+	assert.Equal(t, "MERGE_BLOCKED", blocked.Error.Code)
+	assert.Equal(t, []string{"MIN_APPROVALS"}, blocked.Error.Details)
+
+	forbiddenResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1", Force: true}, nil)
+	assert.Equal(t, http.StatusForbidden, forbiddenResponse.Code)
+
+	reviewResponse := doRequest(t, router, http.MethodPost, "/pullRequest/review", dto.SubmitReviewRequest{
+		PullRequestID: "pr-1",
+		ReviewerID:    created.AssignedReviewers[0],
+		Decision:      "APPROVED",
+	}, nil)
+	require.Equal(t, http.StatusOK, reviewResponse.Code)
+
+	mergeResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1"}, nil)
+	require.Equal(t, http.StatusOK, mergeResponse.Code)
+	assert.False(t, decode[dto.PullRequestResponse](t, mergeResponse).ForceMerged)
+}
+
+func TestRouter_ForceMerge(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	policyResponse := doRequest(t, router, http.MethodPost, "/team/setMergePolicy", dto.SetMergePolicyRequest{
+		TeamName:    "backend",
+		MergePolicy: dto.MergePolicy{MinApprovals: 2},
+	}, nil)
+	require.Equal(t, http.StatusOK, policyResponse.Code)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Hotfix",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	wrongTokenResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1", Force: true},
+		map[string]string{"X-Admin-Token": "guess"})
+	assert.Equal(t, http.StatusForbidden, wrongTokenResponse.Code)
+
+	mergeResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1", Force: true},
+		map[string]string{"X-Admin-Token": testAdminToken})
+	require.Equal(t, http.StatusOK, mergeResponse.Code)
+
+	merged := decode[dto.PullRequestResponse](t, mergeResponse)
+	assert.Equal(t, "MERGED", merged.Status)
+	assert.True(t, merged.ForceMerged)
+}
+
+func TestRouter_DraftLifecycle(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+		Draft:           true,
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	created := decode[dto.PullRequestResponse](t, createResponse)
+	assert.Equal(t, "DRAFT", created.Status)
+	assert.Empty(t, created.AssignedReviewers)
+
+	mergeDraftResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1"}, nil)
+	require.Equal(t, http.StatusConflict, mergeDraftResponse.Code)
+	assert.Equal(t, "PR_NOT_OPEN", decode[dto.ErrorResponse](t, mergeDraftResponse).Error.Code)
+
+	readyResponse := doRequest(t, router, http.MethodPost, "/pullRequest/ready", dto.PullRequestTransitionRequest{PullRequestID: "pr-1"}, nil)
+	require.Equal(t, http.StatusOK, readyResponse.Code)
+
+	ready := decode[dto.PullRequestResponse](t, readyResponse)
+	assert.Equal(t, "OPEN", ready.Status)
+	assert.Len(t, ready.AssignedReviewers, 2)
+	assert.NotContains(t, ready.AssignedReviewers, "u1")
+
+	readyAgainResponse := doRequest(t, router, http.MethodPost, "/pullRequest/ready", dto.PullRequestTransitionRequest{PullRequestID: "pr-1"}, nil)
+	require.Equal(t, http.StatusConflict, readyAgainResponse.Code)
+	assert.Equal(t, "INVALID_STATUS_TRANSITION", decode[dto.ErrorResponse](t, readyAgainResponse).Error.Code)
+
+	closeResponse := doRequest(t, router, http.MethodPost, "/pullRequest/close", dto.PullRequestTransitionRequest{PullRequestID: "pr-1"}, nil)
+	require.Equal(t, http.StatusOK, closeResponse.Code)
+
+	closed := decode[dto.PullRequestResponse](t, closeResponse)
+	assert.Equal(t, "CLOSED", closed.Status)
+	assert.NotNil(t, closed.ClosedAt)
+
+	statsResponse := doRequest(t, router, http.MethodGet, "/stats", nil, nil)
+	require.Equal(t, http.StatusOK, statsResponse.Code)
+	assert.Equal(t, 1, decode[dto.StatsResponse](t, statsResponse).ClosedPullRequests)
+
+	reopenResponse := doRequest(t, router, http.MethodPost, "/pullRequest/reopen", dto.PullRequestTransitionRequest{PullRequestID: "pr-1"}, nil)
+	require.Equal(t, http.StatusOK, reopenResponse.Code)
+
+	reopened := decode[dto.PullRequestResponse](t, reopenResponse)
+	assert.Equal(t, "OPEN", reopened.Status)
+	assert.Nil(t, reopened.ClosedAt)
+	assert.ElementsMatch(t, ready.AssignedReviewers, reopened.AssignedReviewers)
+}
+
+func TestRouter_DeactivateWithReassignment(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	created := decode[dto.PullRequestResponse](t, createResponse)
+	require.Len(t, created.AssignedReviewers, 2)
+	deactivatedID := created.AssignedReviewers[0]
+
+	deactivateResponse := doRequest(t, router, http.MethodPost, "/users/setIsActive", dto.UserStatusRequest{
+		UserID:              deactivatedID,
+		IsActive:            false,
+		ReassignOpenReviews: true,
+	}, nil)
+	require.Equal(t, http.StatusOK, deactivateResponse.Code)
+
+	deactivated := decode[dto.UserStatusResponse](t, deactivateResponse)
+	assert.False(t, deactivated.IsActive)
+	require.NotNil(t, deactivated.Reassignment)
+	require.Len(t, deactivated.Reassignment.Reassigned, 1)
+	assert.Empty(t, deactivated.Reassignment.WithoutCandidate)
+
+	reassignment := deactivated.Reassignment.Reassigned[0]
+	assert.Equal(t, "pr-1", reassignment.PullRequestID)
+	assert.Equal(t, deactivatedID, reassignment.OldReviewerID)
+
+	getResponse := doRequest(t, router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil, nil)
+	require.Equal(t, http.StatusOK, getResponse.Code)
+
+	pullRequest := decode[dto.PullRequestResponse](t, getResponse)
+	assert.NotContains(t, pullRequest.AssignedReviewers, deactivatedID)
+	assert.Contains(t, pullRequest.AssignedReviewers, reassignment.NewReviewerID)
+
+	otherReviewerID := created.AssignedReviewers[1]
+	secondResponse := doRequest(t, router, http.MethodPost, "/users/setIsActive", dto.UserStatusRequest{
+		UserID:              otherReviewerID,
+		IsActive:            false,
+		ReassignOpenReviews: true,
+	}, nil)
+	require.Equal(t, http.StatusOK, secondResponse.Code)
+
+	second := decode[dto.UserStatusResponse](t, secondResponse)
+	require.NotNil(t, second.Reassignment)
+	assert.Empty(t, second.Reassignment.Reassigned)
+	assert.Equal(t, []string{"pr-1"}, second.Reassignment.WithoutCandidate)
+}
+
+func TestRouter_DeactivateTeam(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	platformResponse := doRequest(t, router, http.MethodPost, "/team/add", dto.CreateTeamRequest{
+		TeamName: "platform",
+		Members: []dto.TeamMember{
+			{UserID: "p1", Username: "Paul", IsActive: true},
+		},
+	}, nil)
+	require.Equal(t, http.StatusCreated, platformResponse.Code)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	strangerResponse := doRequest(t, router, http.MethodPost, "/team/deactivate", dto.DeactivateTeamRequest{
+		TeamName: "backend",
+		UserIDs:  []string{"u2", "p1"},
+	}, nil)
+	require.Equal(t, http.StatusBadRequest, strangerResponse.Code)
+	assert.Equal(t, "NOT_TEAM_MEMBER", decode[dto.ErrorResponse](t, strangerResponse).Error.Code)
+
+	deactivateResponse := doRequest(t, router, http.MethodPost, "/team/deactivate", dto.DeactivateTeamRequest{
+		TeamName:         "backend",
+		UserIDs:          []string{"u2", "u3", "u4"},
+		FallbackTeamName: "platform",
+	}, nil)
+	require.Equal(t, http.StatusOK, deactivateResponse.Code)
+
+	deactivated := decode[dto.DeactivateTeamResponse](t, deactivateResponse)
+	require.Len(t, deactivated.DeactivatedUsers, 3)
+	for _, member := range deactivated.DeactivatedUsers {
+		assert.False(t, member.IsActive)
+	}
+	require.Len(t, deactivated.Reassignment.Reassigned, 1)
+	assert.Equal(t, []string{"pr-1"}, deactivated.Reassignment.WithoutCandidate)
+	assert.Equal(t, "p1", deactivated.Reassignment.Reassigned[0].NewReviewerID)
+
+	teamResponse := doRequest(t, router, http.MethodGet, "/team/get?team_name=backend", nil, nil)
+	require.Equal(t, http.StatusOK, teamResponse.Code)
+
+	team := decode[dto.TeamResponse](t, teamResponse)
+	for _, member := range team.Members {
+		assert.Equal(t, member.UserID == "u1", member.IsActive)
+	}
+}
+
+func TestRouter_TeamMembership(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	platformResponse := doRequest(t, router, http.MethodPost, "/team/add", dto.CreateTeamRequest{
+		TeamName: "platform",
+		Members: []dto.TeamMember{
+			{UserID: "p1", Username: "Paul", IsActive: true},
+		},
+	}, nil)
+	require.Equal(t, http.StatusCreated, platformResponse.Code)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	created := decode[dto.PullRequestResponse](t, createResponse)
+	movedID := created.AssignedReviewers[0]
+
+	addResponse := doRequest(t, router, http.MethodPost, "/team/members/add", dto.AddTeamMembersRequest{
+		TeamName: "backend",
+		Members:  []dto.TeamMember{{UserID: "u5", Username: "Eve", IsActive: true}},
+	}, nil)
+	require.Equal(t, http.StatusOK, addResponse.Code)
+
+	added := decode[dto.TeamMembershipResponse](t, addResponse)
+	require.Len(t, added.Changes, 1)
+	assert.Equal(t, "ADDED", added.Changes[0].Action)
+	assert.NotEmpty(t, added.Changes[0].ChangedAt)
+
+	duplicateResponse := doRequest(t, router, http.MethodPost, "/team/members/add", dto.AddTeamMembersRequest{
+		TeamName: "backend",
+		Members:  []dto.TeamMember{{UserID: "p1", Username: "Paul", IsActive: true}},
+	}, nil)
+	require.Equal(t, http.StatusConflict, duplicateResponse.Code)
+	assert.Equal(t, "MEMBER_EXISTS", decode[dto.ErrorResponse](t, duplicateResponse).Error.Code)
+
+	moveResponse := doRequest(t, router, http.MethodPost, "/team/members/move", dto.MoveTeamMembersRequest{
+		TeamName:            "backend",
+		TargetTeamName:      "platform",
+		UserIDs:             []string{movedID},
+		ReassignOpenReviews: true,
+	}, nil)
+	require.Equal(t, http.StatusOK, moveResponse.Code)
+
+	moved := decode[dto.TeamMembershipResponse](t, moveResponse)
+	require.Len(t, moved.Changes, 1)
+	assert.Equal(t, "MOVED", moved.Changes[0].Action)
+	assert.Equal(t, "platform", moved.Changes[0].ToTeamName)
+	require.NotNil(t, moved.Reassignment)
+	require.Len(t, moved.Reassignment.Reassigned, 1)
+	assert.NotEqual(t, "p1", moved.Reassignment.Reassigned[0].NewReviewerID)
+
+	removeResponse := doRequest(t, router, http.MethodPost, "/team/members/remove", dto.RemoveTeamMembersRequest{
+		TeamName: "backend",
+		UserIDs:  []string{"u5", movedID},
+	}, nil)
+	require.Equal(t, http.StatusBadRequest, removeResponse.Code)
+	assert.Equal(t, "NOT_TEAM_MEMBER", decode[dto.ErrorResponse](t, removeResponse).Error.Code)
+
+	removeResponse = doRequest(t, router, http.MethodPost, "/team/members/remove", dto.RemoveTeamMembersRequest{
+		TeamName: "backend",
+		UserIDs:  []string{"u5"},
+	}, nil)
+	require.Equal(t, http.StatusOK, removeResponse.Code)
+
+	teamResponse := doRequest(t, router, http.MethodGet, "/team/get?team_name=platform", nil, nil)
+	require.Equal(t, http.StatusOK, teamResponse.Code)
+	assert.Len(t, decode[dto.TeamResponse](t, teamResponse).Members, 2)
+
+	historyResponse := doRequest(t, router, http.MethodGet, "/team/members/history?team_name=backend", nil, nil)
+	require.Equal(t, http.StatusOK, historyResponse.Code)
+
+	history := decode[dto.MembershipHistoryResponse](t, historyResponse)
+	require.Len(t, history.History, 3)
+	assert.Equal(t, "ADDED", history.History[0].Action)
+	assert.Equal(t, "MOVED", history.History[1].Action)
+	assert.Equal(t, "REMOVED", history.History[2].Action)
+	assert.Equal(t, "u5", history.History[2].UserID)
+}
+
+func TestRouter_ArchiveAndDeleteTeam(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	platformResponse := doRequest(t, router, http.MethodPost, "/team/add", dto.CreateTeamRequest{
+		TeamName: "platform",
+		Members: []dto.TeamMember{
+			{UserID: "p1", Username: "Paul", IsActive: true},
+		},
+	}, nil)
+	require.Equal(t, http.StatusCreated, platformResponse.Code)
+
+	archiveResponse := doRequest(t, router, http.MethodPost, "/team/archive", dto.TeamRequest{TeamName: "platform"}, nil)
+	require.Equal(t, http.StatusOK, archiveResponse.Code)
+	assert.NotEmpty(t, decode[dto.TeamResponse](t, archiveResponse).ArchivedAt)
+
+	statsResponse := doRequest(t, router, http.MethodGet, "/stats", nil, nil)
+	require.Equal(t, http.StatusOK, statsResponse.Code)
+	stats := decode[dto.StatsResponse](t, statsResponse)
+	require.Len(t, stats.TeamsStats, 1)
+	assert.Equal(t, "backend", stats.TeamsStats[0].TeamName)
+
+	statsResponse = doRequest(t, router, http.MethodGet, "/stats?include_archived=true", nil, nil)
+	require.Equal(t, http.StatusOK, statsResponse.Code)
+	assert.Len(t, decode[dto.StatsResponse](t, statsResponse).TeamsStats, 2)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "p1",
+	}, nil)
+	require.Equal(t, http.StatusConflict, createResponse.Code)
+	assert.Equal(t, "TEAM_ARCHIVED", decode[dto.ErrorResponse](t, createResponse).Error.Code)
+
+	deleteResponse := doRequest(t, router, http.MethodPost, "/team/delete", dto.TeamRequest{TeamName: "platform"}, nil)
+	require.Equal(t, http.StatusConflict, deleteResponse.Code)
+	assert.Equal(t, "TEAM_IN_USE", decode[dto.ErrorResponse](t, deleteResponse).Error.Code)
+
+	removeResponse := doRequest(t, router, http.MethodPost, "/team/members/remove", dto.RemoveTeamMembersRequest{
+		TeamName: "platform",
+		UserIDs:  []string{"p1"},
+	}, nil)
+	require.Equal(t, http.StatusOK, removeResponse.Code)
+
+	deleteResponse = doRequest(t, router, http.MethodPost, "/team/delete", dto.TeamRequest{TeamName: "platform"}, nil)
+	require.Equal(t, http.StatusNoContent, deleteResponse.Code)
+
+	teamResponse := doRequest(t, router, http.MethodGet, "/team/get?team_name=platform", nil, nil)
+	assert.Equal(t, http.StatusNotFound, teamResponse.Code)
+}
+
+func TestRouter_RenameTeam(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	addResponse := doRequest(t, router, http.MethodPost, "/team/members/add", dto.AddTeamMembersRequest{
+		TeamName: "backend",
+		Members:  []dto.TeamMember{{UserID: "u5", Username: "Eve", IsActive: true}},
+	}, nil)
+	require.Equal(t, http.StatusOK, addResponse.Code)
+
+	before := decode[dto.TeamResponse](t, doRequest(t, router, http.MethodGet, "/team/get?team_name=backend", nil, nil))
+
+	renameResponse := doRequest(t, router, http.MethodPost, "/team/rename", dto.RenameTeamRequest{
+		TeamName:    "backend",
+		NewTeamName: "core",
+	}, nil)
+	require.Equal(t, http.StatusOK, renameResponse.Code)
+
+	renamed := decode[dto.TeamResponse](t, renameResponse)
+	assert.Equal(t, "core", renamed.TeamName)
+	assert.Equal(t, before.TeamID, renamed.TeamID)
+	assert.Len(t, renamed.Members, 5)
+
+	oldResponse := doRequest(t, router, http.MethodGet, "/team/get?team_name=backend", nil, nil)
+	assert.Equal(t, http.StatusNotFound, oldResponse.Code)
+
+	statsResponse := doRequest(t, router, http.MethodGet, "/stats", nil, nil)
+	require.Equal(t, http.StatusOK, statsResponse.Code)
+	stats := decode[dto.StatsResponse](t, statsResponse)
+	require.Len(t, stats.TeamsStats, 1)
+	assert.Equal(t, "core", stats.TeamsStats[0].TeamName)
+	assert.Equal(t, 1, stats.TeamsStats[0].PullRequestsCount)
+
+	historyResponse := doRequest(t, router, http.MethodGet, "/team/members/history?team_name=core", nil, nil)
+	require.Equal(t, http.StatusOK, historyResponse.Code)
+	history := decode[dto.MembershipHistoryResponse](t, historyResponse)
+	require.Len(t, history.History, 1)
+	assert.Equal(t, "core", history.History[0].ToTeamName)
+
+	frontendResponse := doRequest(t, router, http.MethodPost, "/team/add", dto.CreateTeamRequest{
+		TeamName: "frontend",
+		Members:  []dto.TeamMember{{UserID: "f1", Username: "Frank", IsActive: true}},
+	}, nil)
+	require.Equal(t, http.StatusCreated, frontendResponse.Code)
+
+	conflictResponse := doRequest(t, router, http.MethodPost, "/team/rename", dto.RenameTeamRequest{
+		TeamName:    "core",
+		NewTeamName: "frontend",
+	}, nil)
+	assert.Equal(t, "TEAM_EXISTS", decode[dto.ErrorResponse](t, conflictResponse).Error.Code)
+}
+
+func TestRouter_ParentTeamFallback(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	paymentsResponse := doRequest(t, router, http.MethodPost, "/team/add", dto.CreateTeamRequest{
+		TeamName:       "payments",
+		ParentTeamName: "backend",
+		Members: []dto.TeamMember{
+			{UserID: "p1", Username: "Paul", IsActive: true},
+			{UserID: "p2", Username: "Pam", IsActive: true},
+		},
+	}, nil)
+	require.Equal(t, http.StatusCreated, paymentsResponse.Code)
+	assert.Equal(t, "backend", decode[dto.TeamResponse](t, paymentsResponse).ParentTeamName)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add refunds",
+		AuthorID:        "p1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	created := decode[dto.PullRequestResponse](t, createResponse)
+	require.Len(t, created.ReviewerAssignments, 2)
+	assert.Equal(t, dto.ReviewerAssignmentResponse{ReviewerID: "p2", Level: 0}, created.ReviewerAssignments[0])
+	assert.Equal(t, 1, created.ReviewerAssignments[1].Level)
+
+	reassignResponse := doRequest(t, router, http.MethodPost, "/pullRequest/reassign", dto.ReassignReviewerRequest{
+		PullRequestID: "pr-1",
+		OldReviewerID: "p2",
+	}, nil)
+	require.Equal(t, http.StatusOK, reassignResponse.Code)
+
+	reassigned := decode[dto.PullRequestReassignResponse](t, reassignResponse)
+	for _, assignment := range reassigned.PullRequest.ReviewerAssignments {
+		assert.Equal(t, 1, assignment.Level)
+	}
+
+	cycleResponse := doRequest(t, router, http.MethodPost, "/team/setParent", dto.SetTeamParentRequest{
+		TeamName:       "backend",
+		ParentTeamName: "payments",
+	}, nil)
+	assert.Equal(t, http.StatusBadRequest, cycleResponse.Code)
+	assert.Equal(t, "INVALID_TEAM_PARENT", decode[dto.ErrorResponse](t, cycleResponse).Error.Code)
+
+	clearResponse := doRequest(t, router, http.MethodPost, "/team/setParent", dto.SetTeamParentRequest{TeamName: "payments"}, nil)
+	require.Equal(t, http.StatusOK, clearResponse.Code)
+	assert.Empty(t, decode[dto.TeamResponse](t, clearResponse).ParentTeamName)
+}
+
+func TestRouter_BorrowedReviewers(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	platformResponse := doRequest(t, router, http.MethodPost, "/team/add", dto.CreateTeamRequest{
+		TeamName: "platform",
+		Members: []dto.TeamMember{
+			{UserID: "pl1", Username: "Pete", IsActive: true},
+			{UserID: "pl2", Username: "Pia", IsActive: true},
+		},
+	}, nil)
+	require.Equal(t, http.StatusCreated, platformResponse.Code)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:     "pr-1",
+		PullRequestName:   "Bump shared config",
+		AuthorID:          "u1",
+		BorrowedReviewers: []dto.ReviewerQuota{{TeamName: "platform", Count: 1}},
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	created := decode[dto.PullRequestResponse](t, createResponse)
+	assert.Equal(t, 3, created.ReviewersCount)
+	require.Len(t, created.ReviewerAssignments, 3)
+	borrowed := created.ReviewerAssignments[0]
+	assert.Equal(t, "platform", borrowed.SourceTeamName)
+	assert.Empty(t, created.ReviewerAssignments[1].SourceTeamName)
+
+	reassignResponse := doRequest(t, router, http.MethodPost, "/pullRequest/reassign", dto.ReassignReviewerRequest{
+		PullRequestID: "pr-1",
+		OldReviewerID: borrowed.ReviewerID,
+	}, nil)
+	require.Equal(t, http.StatusOK, reassignResponse.Code)
+
+	reassigned := decode[dto.PullRequestReassignResponse](t, reassignResponse)
+	assert.Contains(t, []string{"pl1", "pl2"}, reassigned.ReplacedBy)
+	assert.NotEqual(t, borrowed.ReviewerID, reassigned.ReplacedBy)
+
+	renameResponse := doRequest(t, router, http.MethodPost, "/team/rename", dto.RenameTeamRequest{TeamName: "platform", NewTeamName: "infra"}, nil)
+	require.Equal(t, http.StatusOK, renameResponse.Code)
+
+	fetched := decode[dto.PullRequestResponse](t, doRequest(t, router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil, nil))
+	for _, assignment := range fetched.ReviewerAssignments {
+		if assignment.ReviewerID == reassigned.ReplacedBy {
+			assert.Equal(t, "infra", assignment.SourceTeamName)
+		}
+	}
+
+	ownTeamResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:     "pr-2",
+		PullRequestName:   "Borrow from own team",
+		AuthorID:          "u1",
+		BorrowedReviewers: []dto.ReviewerQuota{{TeamName: "backend", Count: 1}},
+	}, nil)
+	assert.Equal(t, http.StatusBadRequest, ownTeamResponse.Code)
+	assert.Equal(t, "INVALID_REVIEWER_QUOTA", decode[dto.ErrorResponse](t, ownTeamResponse).Error.Code)
+
+	shortResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:     "pr-3",
+		PullRequestName:   "Borrow too many",
+		AuthorID:          "u1",
+		BorrowedReviewers: []dto.ReviewerQuota{{TeamName: "infra", Count: 3}},
+	}, nil)
+	assert.Equal(t, "NO_CANDIDATE", decode[dto.ErrorResponse](t, shortResponse).Error.Code)
+}
+
+func TestRouter_AvailabilityWindows(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	now := time.Now().UTC()
+	addResponse := doRequest(t, router, http.MethodPost, "/users/availability/add", dto.AddAvailabilityRequest{
+		UserID:   "u2",
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(24 * time.Hour),
+		Reason:   "vacation",
+	}, nil)
+	require.Equal(t, http.StatusCreated, addResponse.Code)
+	window := decode[dto.AvailabilityWindow](t, addResponse)
+	assert.Equal(t, "vacation", window.Reason)
+
+	availability := decode[dto.UserAvailabilityResponse](t, doRequest(t, router, http.MethodGet, "/users/availability?user_id=u2", nil, nil))
+	assert.False(t, availability.AvailableNow)
+	require.Len(t, availability.Windows, 1)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+	created := decode[dto.PullRequestResponse](t, createResponse)
+	assert.ElementsMatch(t, []string{"u3", "u4"}, created.AssignedReviewers)
+
+	invalidResponse := doRequest(t, router, http.MethodPost, "/users/availability/add", dto.AddAvailabilityRequest{
+		UserID:   "u3",
+		StartsAt: now,
+		EndsAt:   now.Add(-time.Hour),
+	}, nil)
+	assert.Equal(t, http.StatusBadRequest, invalidResponse.Code)
+	assert.Equal(t, "INVALID_AVAILABILITY_WINDOW", decode[dto.ErrorResponse](t, invalidResponse).Error.Code)
+
+	deleteResponse := doRequest(t, router, http.MethodPost, "/users/availability/delete", dto.DeleteAvailabilityRequest{UserID: "u2", WindowID: window.WindowID}, nil)
+	assert.Equal(t, http.StatusNoContent, deleteResponse.Code)
+
+	availability = decode[dto.UserAvailabilityResponse](t, doRequest(t, router, http.MethodGet, "/users/availability?user_id=u2", nil, nil))
+	assert.True(t, availability.AvailableNow)
+	assert.Empty(t, availability.Windows)
+
+	missingResponse := doRequest(t, router, http.MethodPost, "/users/availability/delete", dto.DeleteAvailabilityRequest{UserID: "u2", WindowID: window.WindowID}, nil)
+	assert.Equal(t, http.StatusNotFound, missingResponse.Code)
+}
+
+func TestRouter_ReviewCapacity(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	teamResponse := doRequest(t, router, http.MethodPost, "/team/setMaxOpenReviews", dto.SetTeamMaxOpenReviewsRequest{TeamName: "backend", MaxOpenReviews: 1}, nil)
+	require.Equal(t, http.StatusOK, teamResponse.Code)
+	assert.Equal(t, 1, decode[dto.TeamResponse](t, teamResponse).MaxOpenReviews)
+
+	first := decode[dto.PullRequestResponse](t, doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil))
+	require.Len(t, first.AssignedReviewers, 2)
+
+	second := decode[dto.PullRequestResponse](t, doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-2",
+		PullRequestName: "Add filters",
+		AuthorID:        "u1",
+	}, nil))
+	require.Len(t, second.AssignedReviewers, 1)
+	assert.NotContains(t, first.AssignedReviewers, second.AssignedReviewers[0])
+
+	reviews := decode[dto.UserReviewsResponse](t, doRequest(t, router, http.MethodGet, "/users/getReview?user_id="+first.AssignedReviewers[0], nil, nil))
+	assert.Equal(t, dto.ReviewLoad{OpenReviews: 1, MaxOpenReviews: 1}, reviews.Load)
+
+	userResponse := doRequest(t, router, http.MethodPost, "/users/setMaxOpenReviews", dto.SetMaxOpenReviewsRequest{UserID: first.AssignedReviewers[0], MaxOpenReviews: 3}, nil)
+	require.Equal(t, http.StatusOK, userResponse.Code)
+	assert.Equal(t, dto.ReviewLoad{OpenReviews: 1, MaxOpenReviews: 3}, decode[dto.UserStatusResponse](t, userResponse).Load)
+
+	third := decode[dto.PullRequestResponse](t, doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-3",
+		PullRequestName: "Add sorting",
+		AuthorID:        "u1",
+	}, nil))
+	assert.Equal(t, []string{first.AssignedReviewers[0]}, third.AssignedReviewers)
+
+	invalidResponse := doRequest(t, router, http.MethodPost, "/users/setMaxOpenReviews", dto.SetMaxOpenReviewsRequest{UserID: "u2", MaxOpenReviews: -1}, nil)
+	assert.Equal(t, http.StatusBadRequest, invalidResponse.Code)
+	assert.Equal(t, "INVALID_MAX_OPEN_REVIEWS", decode[dto.ErrorResponse](t, invalidResponse).Error.Code)
+}
+
+func TestRouter_ReviewSLA(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	sla := dto.ReviewSLA{RemindAfterHours: 24, EscalateAfterHours: 72, ReassignAfterHours: 48}
+
+	response := doRequest(t, router, http.MethodPost, "/team/setReviewSLA", dto.SetReviewSLARequest{TeamName: "backend", ReviewSLA: sla}, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, sla, decode[dto.TeamResponse](t, response).ReviewSLA)
+
+	team := decode[dto.TeamResponse](t, doRequest(t, router, http.MethodGet, "/team/get?team_name=backend", nil, nil))
+	assert.Equal(t, sla, team.ReviewSLA)
+
+	invalidResponse := doRequest(t, router, http.MethodPost, "/team/setReviewSLA", dto.SetReviewSLARequest{
+		TeamName:  "backend",
+		ReviewSLA: dto.ReviewSLA{RemindAfterHours: 72, EscalateAfterHours: 24},
+	}, nil)
+	assert.Equal(t, http.StatusBadRequest, invalidResponse.Code)
+	assert.Equal(t, "INVALID_REVIEW_SLA", decode[dto.ErrorResponse](t, invalidResponse).Error.Code)
+
+	missingResponse := doRequest(t, router, http.MethodPost, "/team/setReviewSLA", dto.SetReviewSLARequest{TeamName: "frontend", ReviewSLA: sla}, nil)
+	assert.Equal(t, http.StatusNotFound, missingResponse.Code)
+}
+
+func TestRouter_ReviewerHistory(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+
+	response := doRequest(t, router, http.MethodGet, "/pullRequest/reviewers/history?pull_request_id=pr-1", nil, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	history := decode[dto.ReviewerHistoryResponse](t, response)
+	assert.Equal(t, "pr-1", history.PullRequestID)
+	assert.Empty(t, history.History)
+
+	missingIDResponse := doRequest(t, router, http.MethodGet, "/pullRequest/reviewers/history", nil, nil)
+	assert.Equal(t, http.StatusBadRequest, missingIDResponse.Code)
+
+	unknownResponse := doRequest(t, router, http.MethodGet, "/pullRequest/reviewers/history?pull_request_id=ghost", nil, nil)
+	assert.Equal(t, http.StatusNotFound, unknownResponse.Code)
+}
+
+func TestRouter_ReassignToChosenReviewer(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+
+	reviewersCount := 1
+	created := decode[dto.PullRequestResponse](t, doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+		ReviewersCount:  &reviewersCount,
+	}, nil))
+	require.Len(t, created.AssignedReviewers, 1)
+	oldReviewerID := created.AssignedReviewers[0]
+
+	var newReviewerID string
+	for _, userID := range []string{"u2", "u3", "u4"} {
+		if userID != oldReviewerID {
+			newReviewerID = userID
+			break
+		}
+	}
+
+	reassignResponse := doRequest(t, router, http.MethodPost, "/pullRequest/reassign", dto.ReassignReviewerRequest{
+		PullRequestID: "pr-1",
+		OldReviewerID: oldReviewerID,
+		NewReviewerID: newReviewerID,
+	}, nil)
+	require.Equal(t, http.StatusOK, reassignResponse.Code)
+	assert.Equal(t, newReviewerID, decode[dto.PullRequestReassignResponse](t, reassignResponse).ReplacedBy)
+
+	authorResponse := doRequest(t, router, http.MethodPost, "/pullRequest/reassign", dto.ReassignReviewerRequest{
+		PullRequestID: "pr-1",
+		OldReviewerID: newReviewerID,
+		NewReviewerID: "u1",
+	}, nil)
+	assert.Equal(t, http.StatusConflict, authorResponse.Code)
+	assert.Equal(t, "REVIEWER_NOT_ELIGIBLE", decode[dto.ErrorResponse](t, authorResponse).Error.Code)
+
+	unknownResponse := doRequest(t, router, http.MethodPost, "/pullRequest/reassign", dto.ReassignReviewerRequest{
+		PullRequestID: "pr-1",
+		OldReviewerID: newReviewerID,
+		NewReviewerID: "ghost",
+	}, nil)
+	assert.Equal(t, http.StatusNotFound, unknownResponse.Code)
+}
+
+func TestRouter_AssignAndUnassignReviewers(t *testing.T) {
+	router := newTestRouter(t)
+
+	teamResponse := doRequest(t, router, http.MethodPost, "/team/add", dto.CreateTeamRequest{
+		TeamName: "backend",
+		Members: []dto.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: true},
+			{UserID: "u3", Username: "Carol", IsActive: false},
+		},
+	}, nil)
+	require.Equal(t, http.StatusCreated, teamResponse.Code)
+
+	created := decode[dto.PullRequestResponse](t, doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, nil))
+	assert.Equal(t, []string{"u2"}, created.AssignedReviewers)
+
+	noCandidateResponse := doRequest(t, router, http.MethodPost, "/pullRequest/assign", dto.PullRequestTransitionRequest{PullRequestID: "pr-1"}, nil)
+	assert.Equal(t, http.StatusConflict, noCandidateResponse.Code)
+	assert.Equal(t, "NO_CANDIDATE", decode[dto.ErrorResponse](t, noCandidateResponse).Error.Code)
+
+	activateResponse := doRequest(t, router, http.MethodPost, "/users/setIsActive", dto.UserStatusRequest{UserID: "u3", IsActive: true}, nil)
+	require.Equal(t, http.StatusOK, activateResponse.Code)
+
+	assignResponse := doRequest(t, router, http.MethodPost, "/pullRequest/assign", dto.PullRequestTransitionRequest{PullRequestID: "pr-1"}, nil)
+	require.Equal(t, http.StatusOK, assignResponse.Code)
+	assert.Equal(t, []string{"u2", "u3"}, decode[dto.PullRequestResponse](t, assignResponse).AssignedReviewers)
+
+	unassignResponse := doRequest(t, router, http.MethodPost, "/pullRequest/unassign", dto.UnassignReviewerRequest{PullRequestID: "pr-1", ReviewerID: "u2"}, nil)
+	require.Equal(t, http.StatusOK, unassignResponse.Code)
+	assert.Equal(t, []string{"u3"}, decode[dto.PullRequestResponse](t, unassignResponse).AssignedReviewers)
+
+	fetched := decode[dto.PullRequestResponse](t, doRequest(t, router, http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil, nil))
+	assert.Equal(t, []string{"u3"}, fetched.AssignedReviewers)
+
+	notAssignedResponse := doRequest(t, router, http.MethodPost, "/pullRequest/unassign", dto.UnassignReviewerRequest{PullRequestID: "pr-1", ReviewerID: "u2"}, nil)
+	assert.Equal(t, http.StatusConflict, notAssignedResponse.Code)
+	assert.Equal(t, "NOT_ASSIGNED", decode[dto.ErrorResponse](t, notAssignedResponse).Error.Code)
+}
+
+func TestRouter_Webhooks(t *testing.T) {
+	router := newTestRouter(t)
+	adminHeaders := map[string]string{"X-Admin-Token": testAdminToken}
+
+	request := dto.CreateWebhookRequest{
+		URL:        "https://chat.example.com/hooks",
+		Secret:     "secret",
+		EventTypes: []string{"PullRequestCreated", "PullRequestMerged"},
+	}
+
+	forbiddenResponse := doRequest(t, router, http.MethodPost, "/webhooks", request, nil)
+	assert.Equal(t, http.StatusForbidden, forbiddenResponse.Code)
+	assert.Equal(t, "FORBIDDEN", decode[dto.ErrorResponse](t, forbiddenResponse).Error.Code)
+
+	invalidResponse := doRequest(t, router, http.MethodPost, "/webhooks", dto.CreateWebhookRequest{
+		URL:        "https://chat.example.com/hooks",
+		Secret:     "secret",
+		EventTypes: []string{"PullRequestOpened"},
+	}, adminHeaders)
+	assert.Equal(t, http.StatusBadRequest, invalidResponse.Code)
+	assert.Equal(t, "INVALID_WEBHOOK", decode[dto.ErrorResponse](t, invalidResponse).Error.Code)
+
+	createResponse := doRequest(t, router, http.MethodPost, "/webhooks", request, adminHeaders)
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+	created := decode[dto.WebhookResponse](t, createResponse)
+	assert.Equal(t, request.URL, created.URL)
+	assert.Equal(t, request.EventTypes, created.EventTypes)
+	assert.NotContains(t, createResponse.Body.String(), "secret")
+
+	webhooks := decode[dto.WebhooksResponse](t, doRequest(t, router, http.MethodGet, "/webhooks", nil, adminHeaders))
+	require.Len(t, webhooks.Webhooks, 1)
+	assert.Equal(t, created.WebhookID, webhooks.Webhooks[0].WebhookID)
+
+	deadLettersPath := fmt.Sprintf("/webhooks/deadLetters?webhook_id=%d", created.WebhookID)
+	deadLetters := decode[dto.WebhookDeadLettersResponse](t, doRequest(t, router, http.MethodGet, deadLettersPath, nil, adminHeaders))
+	assert.Empty(t, deadLetters.DeadLetters)
+
+	missingIDResponse := doRequest(t, router, http.MethodGet, "/webhooks/deadLetters?webhook_id=abc", nil, adminHeaders)
+	assert.Equal(t, http.StatusBadRequest, missingIDResponse.Code)
+	assert.Equal(t, "MISSING_WEBHOOK_ID", decode[dto.ErrorResponse](t, missingIDResponse).Error.Code)
+
+	deleteResponse := doRequest(t, router, http.MethodPost, "/webhooks/delete", dto.DeleteWebhookRequest{WebhookID: created.WebhookID}, adminHeaders)
+	assert.Equal(t, http.StatusNoContent, deleteResponse.Code)
+
+	missingResponse := doRequest(t, router, http.MethodPost, "/webhooks/delete", dto.DeleteWebhookRequest{WebhookID: created.WebhookID}, adminHeaders)
+	assert.Equal(t, http.StatusNotFound, missingResponse.Code)
+}
+
+func TestRouter_AuditLog(t *testing.T) {
+	router := newTestRouter(t)
+	createBackendTeam(t, router)
+	adminHeaders := map[string]string{"X-Admin-Token": testAdminToken}
+
+	createResponse := doRequest(t, router, http.MethodPost, "/pullRequest/create", dto.CreatePullRequest{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorID:        "u1",
+	}, map[string]string{"X-Actor": "u1", "X-Request-ID": "req-create"})
+	require.Equal(t, http.StatusCreated, createResponse.Code)
+	assert.Equal(t, "req-create", createResponse.Header().Get("X-Request-ID"))
+	created := decode[dto.PullRequestResponse](t, createResponse)
+
+	reassignResponse := doRequest(t, router, http.MethodPost, "/pullRequest/reassign", dto.ReassignReviewerRequest{
+		PullRequestID: "pr-1",
+		OldReviewerID: created.AssignedReviewers[0],
+	}, map[string]string{"X-Admin-Token": testAdminToken, "X-Actor": "u1"})
+	require.Equal(t, http.StatusOK, reassignResponse.Code)
+	assert.NotEmpty(t, reassignResponse.Header().Get("X-Request-ID"))
+
+	mergeResponse := doRequest(t, router, http.MethodPost, "/pullRequest/merge", dto.MergePullRequest{PullRequestID: "pr-1", Force: true}, adminHeaders)
+	require.Equal(t, http.StatusOK, mergeResponse.Code)
+
+	forbiddenResponse := doRequest(t, router, http.MethodGet, "/audit", nil, nil)
+	assert.Equal(t, http.StatusForbidden, forbiddenResponse.Code)
+
+	firstPage := decode[dto.AuditResponse](t, doRequest(t, router, http.MethodGet, "/audit?entity=pr-1&limit=2", nil, adminHeaders))
+	require.Len(t, firstPage.Entries, 2)
+	require.NotNil(t, firstPage.NextCursor)
+
+	assert.Equal(t, "PULL_REQUEST_CREATED", firstPage.Entries[0].Action)
+	assert.Equal(t, "anonymous", firstPage.Entries[0].Actor)
+	assert.Equal(t, "req-create", firstPage.Entries[0].RequestID)
+	assert.Nil(t, firstPage.Entries[0].Before)
+
+	assert.Equal(t, "REVIEWER_REASSIGNED", firstPage.Entries[1].Action)
+	assert.Equal(t, "u1", firstPage.Entries[1].Actor)
+	assert.Contains(t, firstPage.Entries[1].Before["reviewer_ids"], created.AssignedReviewers[0])
+	assert.NotContains(t, firstPage.Entries[1].After["reviewer_ids"], created.AssignedReviewers[0])
+
+	secondPath := fmt.Sprintf("/audit?entity=pr-1&limit=2&cursor=%d", *firstPage.NextCursor)
+	secondPage := decode[dto.AuditResponse](t, doRequest(t, router, http.MethodGet, secondPath, nil, adminHeaders))
+	require.Len(t, secondPage.Entries, 1)
+	assert.Nil(t, secondPage.NextCursor)
+	assert.Equal(t, "PULL_REQUEST_MERGED", secondPage.Entries[0].Action)
+	assert.Equal(t, "admin", secondPage.Entries[0].Actor)
+	assert.Equal(t, "OPEN", secondPage.Entries[0].Before["status"])
+	assert.Equal(t, "MERGED", secondPage.Entries[0].After["status"])
+
+	teamEntries := decode[dto.AuditResponse](t, doRequest(t, router, http.MethodGet, "/audit?entity_type=TEAM", nil, adminHeaders))
+	require.Len(t, teamEntries.Entries, 1)
+	assert.Equal(t, "TEAM_CREATED", teamEntries.Entries[0].Action)
+
+	deactivateResponse := doRequest(t, router, http.MethodPost, "/users/setIsActive", dto.UserStatusRequest{UserID: "u4", IsActive: false}, nil)
+	require.Equal(t, http.StatusOK, deactivateResponse.Code)
+
+	userEntries := decode[dto.AuditResponse](t, doRequest(t, router, http.MethodGet, "/audit?entity=u4&entity_type=USER", nil, adminHeaders))
+	require.Len(t, userEntries.Entries, 1)
+	assert.Equal(t, "USER_DEACTIVATED", userEntries.Entries[0].Action)
+	assert.Equal(t, true, userEntries.Entries[0].Before["is_active"])
+	assert.Equal(t, false, userEntries.Entries[0].After["is_active"])
+
+	since := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	futureEntries := decode[dto.AuditResponse](t, doRequest(t, router, http.MethodGet, "/audit?since="+since, nil, adminHeaders))
+	assert.Empty(t, futureEntries.Entries)
+
+	invalidResponse := doRequest(t, router, http.MethodGet, "/audit?since=yesterday", nil, adminHeaders)
+	assert.Equal(t, http.StatusBadRequest, invalidResponse.Code)
+	assert.Equal(t, "INVALID_AUDIT_QUERY", decode[dto.ErrorResponse](t, invalidResponse).Error.Code)
+}
@@ -0,0 +1,46 @@
+package assignment
+
+import (
+	"context"
+	"sort"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type leastLoadedStrategy struct {
+	pullRequestRepository app.PullRequestRepository
+	random                app.RandomProvider
+}
+
+func NewLeastLoaded(pullRequestRepository app.PullRequestRepository, random app.RandomProvider) app.ReviewerAssignmentStrategy {
+	return &leastLoadedStrategy{
+		pullRequestRepository: pullRequestRepository,
+		random:                random,
+	}
+}
+
+func (s *leastLoadedStrategy) SelectReviewers(ctx context.Context, _ entities.Team, candidates []value_objects.UserID, count int) ([]value_objects.UserID, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return nil, nil
+	}
+
+	openReviews, err := s.pullRequestRepository.CountOpenReviews(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	ordered := make([]value_objects.UserID, len(candidates))
+	copy(ordered, candidates)
+
+	s.random.Shuffle(len(ordered), func(i, j int) {
+		ordered[i], ordered[j] = ordered[j], ordered[i]
+	})
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return openReviews[ordered[i]] < openReviews[ordered[j]]
+	})
+
+	return takeFirst(ordered, count), nil
+}
@@ -0,0 +1,47 @@
+package assignment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func TestLeastLoadedStrategy_SelectReviewers(t *testing.T) {
+	ctx := context.Background()
+	team := entities.Team{Name: "backend", AssignmentStrategy: entities.StrategyLeastLoaded}
+	candidates := []value_objects.UserID{"user1", "user2", "user3"}
+
+	t.Run("select candidates with fewest open reviews", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		random := &mocks.RandomProvider{}
+
+		pullRequestRepository.On("CountOpenReviews", ctx, candidates).
+			Return(map[value_objects.UserID]int{"user1": 4, "user2": 1}, nil)
+		random.On("Shuffle", 3, mock.AnythingOfType("func(int, int)"))
+
+		selected, err := NewLeastLoaded(pullRequestRepository, random).SelectReviewers(ctx, team, candidates, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user3", "user2"}, selected)
+	})
+
+	t.Run("return error when counting fails", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		random := &mocks.RandomProvider{}
+
+		pullRequestRepository.On("CountOpenReviews", ctx, candidates).
+			Return(map[value_objects.UserID]int(nil), errors.New("database error"))
+
+		selected, err := NewLeastLoaded(pullRequestRepository, random).SelectReviewers(ctx, team, candidates, 2)
+
+		assert.Error(t, err)
+		assert.Nil(t, selected)
+	})
+}
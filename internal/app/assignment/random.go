@@ -0,0 +1,40 @@
+package assignment
+
+import (
+	"context"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type randomStrategy struct {
+	random app.RandomProvider
+}
+
+func NewRandom(random app.RandomProvider) app.ReviewerAssignmentStrategy {
+	return &randomStrategy{random: random}
+}
+
+func (s *randomStrategy) SelectReviewers(_ context.Context, _ entities.Team, candidates []value_objects.UserID, count int) ([]value_objects.UserID, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return nil, nil
+	}
+
+	shuffled := make([]value_objects.UserID, len(candidates))
+	copy(shuffled, candidates)
+
+	s.random.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return takeFirst(shuffled, count), nil
+}
+
+func takeFirst(candidates []value_objects.UserID, count int) []value_objects.UserID {
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	return candidates[:count]
+}
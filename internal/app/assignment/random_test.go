@@ -0,0 +1,55 @@
+package assignment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func TestRandomStrategy_SelectReviewers(t *testing.T) {
+	ctx := context.Background()
+	team := entities.Team{Name: "backend"}
+
+	t.Run("select shuffled candidates up to count", func(t *testing.T) {
+		random := &mocks.RandomProvider{}
+		random.On("Shuffle", 3, mock.AnythingOfType("func(int, int)")).
+			Run(func(args mock.Arguments) {
+				swap := args.Get(1).(func(i, j int))
+				swap(0, 2)
+			})
+
+		candidates := []value_objects.UserID{"user1", "user2", "user3"}
+
+		selected, err := NewRandom(random).SelectReviewers(ctx, team, candidates, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user3", "user2"}, selected)
+		assert.Equal(t, []value_objects.UserID{"user1", "user2", "user3"}, candidates)
+	})
+
+	t.Run("return all candidates when count exceeds them", func(t *testing.T) {
+		random := &mocks.RandomProvider{}
+		random.On("Shuffle", 1, mock.AnythingOfType("func(int, int)"))
+
+		selected, err := NewRandom(random).SelectReviewers(ctx, team, []value_objects.UserID{"user1"}, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user1"}, selected)
+	})
+
+	t.Run("return nil when no candidates", func(t *testing.T) {
+		random := &mocks.RandomProvider{}
+
+		selected, err := NewRandom(random).SelectReviewers(ctx, team, nil, 2)
+
+		assert.NoError(t, err)
+		assert.Nil(t, selected)
+		random.AssertNotCalled(t, "Shuffle", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,37 @@
+package assignment
+
+import (
+	"context"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type registry struct {
+	strategies map[entities.AssignmentStrategy]app.ReviewerAssignmentStrategy
+	fallback   app.ReviewerAssignmentStrategy
+}
+
+func NewRegistry(teamRepository app.TeamRepository, pullRequestRepository app.PullRequestRepository, random app.RandomProvider) app.ReviewerAssignmentStrategy {
+	randomStrategy := NewRandom(random)
+
+	return &registry{
+		strategies: map[entities.AssignmentStrategy]app.ReviewerAssignmentStrategy{
+			entities.StrategyRandom:         randomStrategy,
+			entities.StrategyLeastLoaded:    NewLeastLoaded(pullRequestRepository, random),
+			entities.StrategyRoundRobin:     NewRoundRobin(teamRepository),
+			entities.StrategyWeightedRandom: NewWeightedRandom(pullRequestRepository, random),
+		},
+		fallback: randomStrategy,
+	}
+}
+
+func (r *registry) SelectReviewers(ctx context.Context, team entities.Team, candidates []value_objects.UserID, count int) ([]value_objects.UserID, error) {
+	strategy, ok := r.strategies[team.AssignmentStrategy]
+	if !ok {
+		strategy = r.fallback
+	}
+
+	return strategy.SelectReviewers(ctx, team, candidates, count)
+}
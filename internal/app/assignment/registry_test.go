@@ -0,0 +1,49 @@
+package assignment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func TestRegistry_SelectReviewers(t *testing.T) {
+	ctx := context.Background()
+	candidates := []value_objects.UserID{"user2", "user1"}
+
+	t.Run("use strategy configured on team", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		random := &mocks.RandomProvider{}
+		team := entities.Team{Name: "backend", AssignmentStrategy: entities.StrategyRoundRobin}
+
+		teamRepository.On("LockRoundRobinCursor", ctx, team.Name).Return(value_objects.UserID(""), nil)
+		teamRepository.On("UpdateRoundRobinCursor", ctx, team.Name, value_objects.UserID("user1")).Return(nil)
+
+		selected, err := NewRegistry(teamRepository, pullRequestRepository, random).SelectReviewers(ctx, team, candidates, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user1"}, selected)
+		random.AssertNotCalled(t, "Shuffle", mock.Anything, mock.Anything)
+	})
+
+	t.Run("fall back to random for unknown strategy", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		random := &mocks.RandomProvider{}
+		team := entities.Team{Name: "backend"}
+
+		random.On("Shuffle", 2, mock.AnythingOfType("func(int, int)"))
+
+		selected, err := NewRegistry(teamRepository, pullRequestRepository, random).SelectReviewers(ctx, team, candidates, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user2"}, selected)
+		random.AssertExpectations(t)
+	})
+}
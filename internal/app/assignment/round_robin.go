@@ -0,0 +1,50 @@
+package assignment
+
+import (
+	"context"
+	"sort"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type roundRobinStrategy struct {
+	teamRepository app.TeamRepository
+}
+
+func NewRoundRobin(teamRepository app.TeamRepository) app.ReviewerAssignmentStrategy {
+	return &roundRobinStrategy{teamRepository: teamRepository}
+}
+
+func (s *roundRobinStrategy) SelectReviewers(ctx context.Context, team entities.Team, candidates []value_objects.UserID, count int) ([]value_objects.UserID, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return nil, nil
+	}
+
+	cursor, err := s.teamRepository.LockRoundRobinCursor(ctx, team.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ordered := make([]value_objects.UserID, len(candidates))
+	copy(ordered, candidates)
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i] < ordered[j] })
+
+	start := sort.Search(len(ordered), func(i int) bool { return ordered[i] > cursor })
+
+	if count > len(ordered) {
+		count = len(ordered)
+	}
+
+	selected := make([]value_objects.UserID, 0, count)
+	for i := 0; i < count; i++ {
+		selected = append(selected, ordered[(start+i)%len(ordered)])
+	}
+
+	if err := s.teamRepository.UpdateRoundRobinCursor(ctx, team.Name, selected[len(selected)-1]); err != nil {
+		return nil, err
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,72 @@
+package assignment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func TestRoundRobinStrategy_SelectReviewers(t *testing.T) {
+	ctx := context.Background()
+	candidates := []value_objects.UserID{"user3", "user1", "user2"}
+
+	t.Run("start from the beginning without cursor", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		team := entities.Team{Name: "backend", AssignmentStrategy: entities.StrategyRoundRobin}
+
+		teamRepository.On("LockRoundRobinCursor", ctx, team.Name).Return(value_objects.UserID(""), nil)
+		teamRepository.On("UpdateRoundRobinCursor", ctx, team.Name, value_objects.UserID("user2")).Return(nil)
+
+		selected, err := NewRoundRobin(teamRepository).SelectReviewers(ctx, team, candidates, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user1", "user2"}, selected)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("continue after cursor and wrap around", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		team := entities.Team{Name: "backend", AssignmentStrategy: entities.StrategyRoundRobin, RoundRobinCursor: "user2"}
+
+		teamRepository.On("LockRoundRobinCursor", ctx, team.Name).Return(value_objects.UserID("user2"), nil)
+		teamRepository.On("UpdateRoundRobinCursor", ctx, team.Name, value_objects.UserID("user1")).Return(nil)
+
+		selected, err := NewRoundRobin(teamRepository).SelectReviewers(ctx, team, candidates, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user3", "user1"}, selected)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("continue after cursor that left the team", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		team := entities.Team{Name: "backend", AssignmentStrategy: entities.StrategyRoundRobin, RoundRobinCursor: "user15"}
+
+		teamRepository.On("LockRoundRobinCursor", ctx, team.Name).Return(value_objects.UserID("user15"), nil)
+		teamRepository.On("UpdateRoundRobinCursor", ctx, team.Name, value_objects.UserID("user2")).Return(nil)
+
+		selected, err := NewRoundRobin(teamRepository).SelectReviewers(ctx, team, candidates, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user2"}, selected)
+	})
+
+	t.Run("use locked cursor instead of stale team snapshot", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		team := entities.Team{Name: "backend", AssignmentStrategy: entities.StrategyRoundRobin}
+
+		teamRepository.On("LockRoundRobinCursor", ctx, team.Name).Return(value_objects.UserID("user1"), nil)
+		teamRepository.On("UpdateRoundRobinCursor", ctx, team.Name, value_objects.UserID("user2")).Return(nil)
+
+		selected, err := NewRoundRobin(teamRepository).SelectReviewers(ctx, team, candidates, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user2"}, selected)
+		teamRepository.AssertExpectations(t)
+	})
+}
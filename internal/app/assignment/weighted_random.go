@@ -0,0 +1,72 @@
+package assignment
+
+import (
+	"context"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type weightedRandomStrategy struct {
+	pullRequestRepository app.PullRequestRepository
+	random                app.RandomProvider
+}
+
+// NewWeightedRandom picks reviewers at random, giving candidates with fewer
+// open reviews a proportionally higher chance to be selected.
+func NewWeightedRandom(pullRequestRepository app.PullRequestRepository, random app.RandomProvider) app.ReviewerAssignmentStrategy {
+	return &weightedRandomStrategy{
+		pullRequestRepository: pullRequestRepository,
+		random:                random,
+	}
+}
+
+func (s *weightedRandomStrategy) SelectReviewers(ctx context.Context, _ entities.Team, candidates []value_objects.UserID, count int) ([]value_objects.UserID, error) {
+	if len(candidates) == 0 || count <= 0 {
+		return nil, nil
+	}
+
+	openReviews, err := s.pullRequestRepository.CountOpenReviews(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	maxLoad := 0
+	for _, candidate := range candidates {
+		if openReviews[candidate] > maxLoad {
+			maxLoad = openReviews[candidate]
+		}
+	}
+
+	remaining := make([]value_objects.UserID, len(candidates))
+	copy(remaining, candidates)
+
+	weights := make([]int, len(remaining))
+	for i, candidate := range remaining {
+		weights[i] = maxLoad - openReviews[candidate] + 1
+	}
+
+	var selected []value_objects.UserID
+
+	for len(selected) < count && len(remaining) > 0 {
+		totalWeight := 0
+		for _, weight := range weights {
+			totalWeight += weight
+		}
+
+		point := s.random.Intn(totalWeight)
+
+		index := 0
+		for point >= weights[index] {
+			point -= weights[index]
+			index++
+		}
+
+		selected = append(selected, remaining[index])
+		remaining = append(remaining[:index], remaining[index+1:]...)
+		weights = append(weights[:index], weights[index+1:]...)
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,32 @@
+package assignment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func TestWeightedRandomStrategy_SelectReviewers(t *testing.T) {
+	ctx := context.Background()
+	team := entities.Team{Name: "backend", AssignmentStrategy: entities.StrategyWeightedRandom}
+	candidates := []value_objects.UserID{"user1", "user2", "user3"}
+
+	pullRequestRepository := &mocks.PullRequestRepository{}
+	random := &mocks.RandomProvider{}
+
+	pullRequestRepository.On("CountOpenReviews", ctx, candidates).
+		Return(map[value_objects.UserID]int{"user1": 2, "user2": 0, "user3": 1}, nil)
+	random.On("Intn", 6).Return(3).Once()
+	random.On("Intn", 3).Return(2).Once()
+
+	selected, err := NewWeightedRandom(pullRequestRepository, random).SelectReviewers(ctx, team, candidates, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []value_objects.UserID{"user2", "user3"}, selected)
+	random.AssertExpectations(t)
+}
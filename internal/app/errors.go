@@ -1,7 +1,31 @@
 package app
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
 	ErrTransactionRequired = errors.New("TRANSACTION_REQUIRED")
 )
+
+// RetryError is returned by an EventSink that has not finished delivering an
+// entry and knows when the next attempt is due. Err is nil when no attempt
+// failed during the call and the sink is only waiting for the retry time.
+type RetryError struct {
+	RetryAt time.Time
+	Err     error
+}
+
+func (e *RetryError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("retry scheduled at %s", e.RetryAt.Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("%v, retry scheduled at %s", e.Err, e.RetryAt.Format(time.RFC3339))
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
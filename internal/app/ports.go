@@ -16,23 +16,58 @@ type TimeProvider interface {
 	Now() time.Time
 }
 
+type LeaderElector interface {
+	RunIfLeader(ctx context.Context, job func(ctx context.Context) error) (bool, error)
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, reminder entities.ReviewReminder) error
+}
+
+type EventSink interface {
+	Deliver(ctx context.Context, entry entities.OutboxEntry) error
+}
+
 type RandomProvider interface {
 	Shuffle(n int, swapFunc func(i, j int))
 	Intn(n int) int
 }
 
+type ReviewerAssignmentStrategy interface {
+	SelectReviewers(ctx context.Context, team entities.Team, candidates []value_objects.UserID, count int) ([]value_objects.UserID, error)
+}
+
 type UserRepository interface {
 	GetByID(ctx context.Context, id value_objects.UserID) (entities.User, error)
 	GetUsersByTeam(ctx context.Context, teamName value_objects.TeamName) ([]entities.User, error)
 	GetAll(ctx context.Context) ([]entities.User, error)
 	UpsertMembers(ctx context.Context, teamName value_objects.TeamName, members []entities.User) error
 	SetIsActive(ctx context.Context, id value_objects.UserID, isActive bool) (entities.User, error)
+	SetIsActiveByTeam(ctx context.Context, teamName value_objects.TeamName, userIDs []value_objects.UserID, isActive bool) ([]entities.User, error)
+	SetTeam(ctx context.Context, userIDs []value_objects.UserID, teamName value_objects.TeamName) error
+	SetMaxOpenReviews(ctx context.Context, id value_objects.UserID, maxOpenReviews int) (entities.User, error)
+	AddAvailabilityWindow(ctx context.Context, window entities.AvailabilityWindow) (entities.AvailabilityWindow, error)
+	GetAvailabilityWindows(ctx context.Context, id value_objects.UserID) ([]entities.AvailabilityWindow, error)
+	DeleteAvailabilityWindow(ctx context.Context, id value_objects.UserID, windowID int64) error
+	GetUnavailableUserIDs(ctx context.Context, ids []value_objects.UserID, at time.Time) (map[value_objects.UserID]bool, error)
 }
 
 type TeamRepository interface {
-	Create(ctx context.Context, team entities.Team) error
+	Create(ctx context.Context, team entities.Team) (entities.Team, error)
 	GetByName(ctx context.Context, name value_objects.TeamName) (entities.Team, error)
-	GetAll(ctx context.Context) ([]entities.Team, error)
+	GetAll(ctx context.Context, includeArchived bool) ([]entities.Team, error)
+	UpdateAssignmentStrategy(ctx context.Context, name value_objects.TeamName, strategy entities.AssignmentStrategy) error
+	UpdateMergePolicy(ctx context.Context, name value_objects.TeamName, leadID value_objects.UserID, policy entities.MergePolicy) error
+	LockRoundRobinCursor(ctx context.Context, name value_objects.TeamName) (value_objects.UserID, error)
+	UpdateRoundRobinCursor(ctx context.Context, name value_objects.TeamName, cursor value_objects.UserID) error
+	AddMembershipChanges(ctx context.Context, changes []entities.MembershipChange) error
+	GetMembershipHistory(ctx context.Context, name value_objects.TeamName) ([]entities.MembershipChange, error)
+	Archive(ctx context.Context, name value_objects.TeamName, archivedAt time.Time) error
+	Delete(ctx context.Context, name value_objects.TeamName) error
+	Rename(ctx context.Context, name value_objects.TeamName, newName value_objects.TeamName) error
+	UpdateParent(ctx context.Context, name value_objects.TeamName, parentName value_objects.TeamName) error
+	UpdateMaxOpenReviews(ctx context.Context, name value_objects.TeamName, maxOpenReviews int) error
+	UpdateReviewSLA(ctx context.Context, name value_objects.TeamName, sla entities.ReviewSLA) error
 }
 
 type PullRequestRepository interface {
@@ -41,5 +76,43 @@ type PullRequestRepository interface {
 	GetByID(ctx context.Context, id value_objects.PullRequestID) (*entities.PullRequest, error)
 	GetByReviewer(ctx context.Context, reviewerID value_objects.UserID) ([]entities.PullRequest, error)
 	GetAll(ctx context.Context) ([]entities.PullRequest, error)
-	ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID, newReviewerID value_objects.UserID) error
+	ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID, assignment entities.ReviewerAssignment) error
+	CountOpenReviews(ctx context.Context, reviewerIDs []value_objects.UserID) (map[value_objects.UserID]int, error)
+	AddReviewers(ctx context.Context, pullRequestID value_objects.PullRequestID, assignments []entities.ReviewerAssignment) error
+	RemoveReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID) error
+	RemoveReviewerQuotas(ctx context.Context, pullRequestID value_objects.PullRequestID) error
+	SaveReview(ctx context.Context, pullRequestID value_objects.PullRequestID, review entities.Review) error
+	CountOpenByTeam(ctx context.Context, teamName value_objects.TeamName) (int, error)
+	LockUnderAssigned(ctx context.Context, afterID value_objects.PullRequestID, limit int) ([]value_objects.PullRequestID, error)
+	GetOpenCreatedBefore(ctx context.Context, createdBefore time.Time) ([]entities.PullRequest, error)
+	AddReviewerChange(ctx context.Context, change entities.ReviewerChange) error
+	GetReviewerChanges(ctx context.Context, pullRequestID value_objects.PullRequestID) ([]entities.ReviewerChange, error)
+}
+
+type ReviewReminderRepository interface {
+	Record(ctx context.Context, reminder entities.ReviewReminder) (bool, error)
+	GetUndelivered(ctx context.Context) ([]entities.ReviewReminder, error)
+	MarkDelivered(ctx context.Context, reminder entities.ReviewReminder, deliveredAt time.Time) error
+}
+
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error)
+	GetAll(ctx context.Context) ([]entities.Webhook, error)
+	Delete(ctx context.Context, id int64) error
+	AddDeadLetter(ctx context.Context, deadLetter entities.WebhookDeadLetter) error
+	GetDeadLetters(ctx context.Context, webhookID int64) ([]entities.WebhookDeadLetter, error)
+	GetDeliveries(ctx context.Context, outboxEntryID int64) ([]entities.WebhookDelivery, error)
+	SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error
+}
+
+type OutboxRepository interface {
+	Add(ctx context.Context, events ...entities.Event) error
+	GetPending(ctx context.Context, limit int) ([]entities.OutboxEntry, error)
+	MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error
+	ScheduleRetry(ctx context.Context, id int64, nextAttemptAt time.Time) error
+}
+
+type AuditRepository interface {
+	Add(ctx context.Context, entries ...entities.AuditEntry) error
+	Find(ctx context.Context, query entities.AuditQuery) ([]entities.AuditEntry, error)
 }
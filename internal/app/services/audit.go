@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+func newAuditEntry(ctx context.Context, action entities.AuditAction, entityType entities.AuditEntityType, entityID string, before, after map[string]any, createdAt time.Time) entities.AuditEntry {
+	meta := app.RequestMetaFrom(ctx)
+
+	actor := meta.Actor
+	if actor == "" {
+		actor = entities.SystemActor
+	}
+
+	return entities.AuditEntry{
+		Actor:      actor,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Before:     before,
+		After:      after,
+		RequestID:  meta.RequestID,
+		CreatedAt:  createdAt,
+	}
+}
+
+func teamAuditState(team entities.Team, members []entities.User) map[string]any {
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, string(member.ID))
+	}
+
+	return map[string]any{
+		"team_id":             string(team.ID),
+		"team_name":           string(team.Name),
+		"parent_team_name":    string(team.Parent),
+		"assignment_strategy": string(team.AssignmentStrategy),
+		"reviewers_limit":     team.ReviewersLimit,
+		"lead_id":             string(team.LeadID),
+		"member_ids":          memberIDs,
+	}
+}
+
+func userAuditState(user entities.User) map[string]any {
+	return map[string]any{
+		"user_id":   string(user.ID),
+		"username":  user.Username,
+		"team_name": string(user.Team),
+		"is_active": user.IsActive,
+	}
+}
+
+func pullRequestAuditState(pullRequest *entities.PullRequest) map[string]any {
+	reviewers := pullRequest.Reviewers()
+	reviewerIDs := make([]string, 0, len(reviewers))
+	for _, reviewerID := range reviewers {
+		reviewerIDs = append(reviewerIDs, string(reviewerID))
+	}
+
+	state := map[string]any{
+		"pull_request_id":   string(pullRequest.ID),
+		"pull_request_name": pullRequest.Name,
+		"author_id":         string(pullRequest.AuthorID),
+		"status":            string(pullRequest.Status),
+		"version":           pullRequest.Version,
+		"reviewer_ids":      reviewerIDs,
+	}
+	if pullRequest.MergedAt != nil {
+		state["merged_at"] = pullRequest.MergedAt.UTC().Format(time.RFC3339)
+		state["force_merged"] = pullRequest.ForceMerged
+	}
+
+	return state
+}
+
+func userActivationAuditEntries(ctx context.Context, before, after []entities.User, createdAt time.Time) []entities.AuditEntry {
+	beforeByID := make(map[string]entities.User, len(before))
+	for _, user := range before {
+		beforeByID[string(user.ID)] = user
+	}
+
+	entries := make([]entities.AuditEntry, 0, len(after))
+
+	for _, user := range after {
+		action := entities.AuditUserDeactivated
+		if user.IsActive {
+			action = entities.AuditUserActivated
+		}
+
+		var beforeState map[string]any
+		if previous, ok := beforeByID[string(user.ID)]; ok {
+			beforeState = userAuditState(previous)
+		}
+
+		entries = append(entries, newAuditEntry(ctx, action, entities.AuditEntityUser, string(user.ID), beforeState, userAuditState(user), createdAt))
+	}
+
+	return entries
+}
+
+func reviewerReassignedAuditEntries(ctx context.Context, report ReassignmentReport, createdAt time.Time) []entities.AuditEntry {
+	entries := make([]entities.AuditEntry, 0, len(report.Reassigned))
+
+	for i, reassignment := range report.Reassigned {
+		var states pullRequestAuditStates
+		if i < len(report.auditStates) {
+			states = report.auditStates[i]
+		}
+
+		entries = append(entries, newAuditEntry(ctx, entities.AuditReviewerReassigned, entities.AuditEntityPullRequest, string(reassignment.PullRequestID), states.before, states.after, createdAt))
+	}
+
+	return entries
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+type AuditService interface {
+	GetEntries(ctx context.Context, query entities.AuditQuery) (AuditPage, error)
+}
+
+type AuditPage struct {
+	Entries    []entities.AuditEntry
+	NextCursor *int64
+}
+
+type auditService struct {
+	auditRepository app.AuditRepository
+}
+
+func NewAuditService(auditRepository app.AuditRepository) AuditService {
+	return &auditService{auditRepository: auditRepository}
+}
+
+func (s *auditService) GetEntries(ctx context.Context, query entities.AuditQuery) (AuditPage, error) {
+	if err := query.Validate(); err != nil {
+		return AuditPage{}, err
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = entities.DefaultAuditLimit
+	}
+	query.Limit = limit + 1
+
+	entries, err := s.auditRepository.Find(ctx, query)
+	if err != nil {
+		return AuditPage{}, err
+	}
+
+	if len(entries) <= limit {
+		return AuditPage{Entries: entries}, nil
+	}
+
+	entries = entries[:limit]
+	nextCursor := entries[limit-1].ID
+
+	return AuditPage{Entries: entries, NextCursor: &nextCursor}, nil
+}
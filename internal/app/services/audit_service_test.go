@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/memory"
+)
+
+func TestAuditService_GetEntries(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	auditRepository := memory.NewAuditRepository(memory.NewStore())
+	require.NoError(t, auditRepository.Add(ctx,
+		entities.AuditEntry{Action: entities.AuditPullRequestCreated, EntityType: entities.AuditEntityPullRequest, EntityID: "pr-1", CreatedAt: createdAt},
+		entities.AuditEntry{Action: entities.AuditTeamCreated, EntityType: entities.AuditEntityTeam, EntityID: "backend", CreatedAt: createdAt},
+		entities.AuditEntry{Action: entities.AuditReviewerReassigned, EntityType: entities.AuditEntityPullRequest, EntityID: "pr-1", CreatedAt: createdAt.Add(time.Hour)},
+		entities.AuditEntry{Action: entities.AuditPullRequestMerged, EntityType: entities.AuditEntityPullRequest, EntityID: "pr-1", CreatedAt: createdAt.Add(2 * time.Hour)},
+	))
+
+	service := NewAuditService(auditRepository)
+
+	t.Run("paginate entries of entity", func(t *testing.T) {
+		page, err := service.GetEntries(ctx, entities.AuditQuery{EntityID: "pr-1", Limit: 2})
+		require.NoError(t, err)
+
+		require.Len(t, page.Entries, 2)
+		assert.Equal(t, entities.AuditPullRequestCreated, page.Entries[0].Action)
+		assert.Equal(t, entities.AuditReviewerReassigned, page.Entries[1].Action)
+		require.NotNil(t, page.NextCursor)
+
+		page, err = service.GetEntries(ctx, entities.AuditQuery{EntityID: "pr-1", Limit: 2, AfterID: *page.NextCursor})
+		require.NoError(t, err)
+
+		require.Len(t, page.Entries, 1)
+		assert.Equal(t, entities.AuditPullRequestMerged, page.Entries[0].Action)
+		assert.Nil(t, page.NextCursor)
+	})
+
+	t.Run("filter entries since time", func(t *testing.T) {
+		page, err := service.GetEntries(ctx, entities.AuditQuery{Since: createdAt.Add(time.Hour)})
+		require.NoError(t, err)
+
+		assert.Len(t, page.Entries, 2)
+	})
+
+	t.Run("reject invalid query", func(t *testing.T) {
+		_, err := service.GetEntries(ctx, entities.AuditQuery{Limit: entities.MaxAuditLimit + 1})
+		assert.ErrorIs(t, err, domain.ErrInvalidAuditQuery)
+
+		_, err = service.GetEntries(ctx, entities.AuditQuery{EntityType: "PROJECT"})
+		assert.ErrorIs(t, err, domain.ErrInvalidAuditQuery)
+	})
+}
+
+func TestNewAuditEntry_RequestMeta(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	entry := newAuditEntry(context.Background(), entities.AuditTeamCreated, entities.AuditEntityTeam, "backend", nil, nil, createdAt)
+	assert.Equal(t, entities.SystemActor, entry.Actor)
+	assert.Empty(t, entry.RequestID)
+
+	ctx := app.WithRequestMeta(context.Background(), app.RequestMeta{Actor: "alice", RequestID: "req-1"})
+
+	entry = newAuditEntry(ctx, entities.AuditTeamCreated, entities.AuditEntityTeam, "backend", nil, nil, createdAt)
+	assert.Equal(t, "alice", entry.Actor)
+	assert.Equal(t, "req-1", entry.RequestID)
+}
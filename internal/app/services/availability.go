@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+func excludeUnavailable(ctx context.Context, userRepository app.UserRepository, timeProvider app.TimeProvider, users []entities.User) ([]entities.User, error) {
+	if len(users) == 0 {
+		return users, nil
+	}
+
+	unavailable, err := userRepository.GetUnavailableUserIDs(ctx, toUserIDs(users), timeProvider.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var available []entities.User
+
+	for _, user := range users {
+		if !unavailable[user.ID] {
+			available = append(available, user)
+		}
+	}
+
+	return available, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func expectAllAvailable(userRepository *mocks.UserRepository) {
+	userRepository.On("GetUnavailableUserIDs", mock.Anything, mock.Anything, mock.Anything).Return(map[value_objects.UserID]bool{}, nil)
+}
+
+func TestExcludeUnavailable(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	userRepository := &mocks.UserRepository{}
+	timeProvider := &mocks.TimeProvider{}
+
+	users := []entities.User{
+		{ID: "user1", IsActive: true},
+		{ID: "user2", IsActive: true},
+	}
+
+	timeProvider.On("Now").Return(now)
+	userRepository.On("GetUnavailableUserIDs", ctx, []value_objects.UserID{"user1", "user2"}, now).
+		Return(map[value_objects.UserID]bool{"user1": true}, nil)
+
+	available, err := excludeUnavailable(ctx, userRepository, timeProvider, users)
+
+	require.NoError(t, err)
+	assert.Equal(t, []entities.User{{ID: "user2", IsActive: true}}, available)
+
+	available, err = excludeUnavailable(ctx, userRepository, timeProvider, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, available)
+	userRepository.AssertNumberOfCalls(t, "GetUnavailableUserIDs", 1)
+}
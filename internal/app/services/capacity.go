@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func excludeAtCapacity(ctx context.Context, pullRequestRepository app.PullRequestRepository, team entities.Team, users []entities.User) ([]entities.User, error) {
+	var limitedUserIDs []value_objects.UserID
+	for _, user := range users {
+		if user.ReviewCapacity(team) > 0 {
+			limitedUserIDs = append(limitedUserIDs, user.ID)
+		}
+	}
+
+	if len(limitedUserIDs) == 0 {
+		return users, nil
+	}
+
+	openReviews, err := pullRequestRepository.CountOpenReviews(ctx, limitedUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var available []entities.User
+	for _, user := range users {
+		capacity := user.ReviewCapacity(team)
+		if capacity > 0 && openReviews[user.ID] >= capacity {
+			continue
+		}
+
+		available = append(available, user)
+	}
+
+	return available, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func TestExcludeAtCapacity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("skip count when nobody has a limit", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		users := []entities.User{{ID: "user1"}, {ID: "user2"}}
+
+		available, err := excludeAtCapacity(ctx, pullRequestRepository, entities.Team{}, users)
+
+		require.NoError(t, err)
+		assert.Equal(t, users, available)
+		pullRequestRepository.AssertNotCalled(t, "CountOpenReviews")
+	})
+
+	t.Run("exclude users at personal or team capacity", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		team := entities.Team{Name: "backend", MaxOpenReviews: 2}
+		users := []entities.User{
+			{ID: "user1"},
+			{ID: "user2", MaxOpenReviews: 5},
+			{ID: "user3", MaxOpenReviews: 1},
+			{ID: "user4"},
+		}
+
+		pullRequestRepository.On("CountOpenReviews", ctx, []value_objects.UserID{"user1", "user2", "user3", "user4"}).
+			Return(map[value_objects.UserID]int{"user1": 2, "user2": 2, "user3": 1}, nil)
+
+		available, err := excludeAtCapacity(ctx, pullRequestRepository, team, users)
+
+		require.NoError(t, err)
+		assert.Equal(t, []entities.User{{ID: "user2", MaxOpenReviews: 5}, {ID: "user4"}}, available)
+	})
+}
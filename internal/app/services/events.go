@@ -0,0 +1,124 @@
+package services
+
+import (
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func pullRequestCreatedEvent(pullRequest *entities.PullRequest) entities.Event {
+	return entities.Event{
+		Type:        entities.EventPullRequestCreated,
+		AggregateID: string(pullRequest.ID),
+		OccurredAt:  pullRequest.CreatedAt,
+		Payload: map[string]any{
+			"pull_request_id":   string(pullRequest.ID),
+			"pull_request_name": pullRequest.Name,
+			"author_id":         string(pullRequest.AuthorID),
+			"status":            string(pullRequest.Status),
+		},
+	}
+}
+
+func reviewerAssignedEvents(pullRequestID value_objects.PullRequestID, assignments []entities.ReviewerAssignment) []entities.Event {
+	events := make([]entities.Event, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		events = append(events, entities.Event{
+			Type:        entities.EventReviewerAssigned,
+			AggregateID: string(pullRequestID),
+			OccurredAt:  assignment.AssignedAt,
+			Payload: map[string]any{
+				"pull_request_id": string(pullRequestID),
+				"reviewer_id":     string(assignment.ReviewerID),
+			},
+		})
+	}
+
+	return events
+}
+
+func reviewerReassignedEvents(reassignments []ReviewReassignment, occurredAt time.Time) []entities.Event {
+	events := make([]entities.Event, 0, len(reassignments))
+
+	for _, reassignment := range reassignments {
+		events = append(events, entities.Event{
+			Type:        entities.EventReviewerReassigned,
+			AggregateID: string(reassignment.PullRequestID),
+			OccurredAt:  occurredAt,
+			Payload: map[string]any{
+				"pull_request_id": string(reassignment.PullRequestID),
+				"old_reviewer_id": string(reassignment.OldReviewerID),
+				"new_reviewer_id": string(reassignment.NewReviewerID),
+			},
+		})
+	}
+
+	return events
+}
+
+func reviewerUnassignedEvent(pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID, occurredAt time.Time) entities.Event {
+	return entities.Event{
+		Type:        entities.EventReviewerUnassigned,
+		AggregateID: string(pullRequestID),
+		OccurredAt:  occurredAt,
+		Payload: map[string]any{
+			"pull_request_id": string(pullRequestID),
+			"reviewer_id":     string(reviewerID),
+		},
+	}
+}
+
+func pullRequestMergedEvent(pullRequest *entities.PullRequest) entities.Event {
+	var mergedAt time.Time
+	if pullRequest.MergedAt != nil {
+		mergedAt = *pullRequest.MergedAt
+	}
+
+	return entities.Event{
+		Type:        entities.EventPullRequestMerged,
+		AggregateID: string(pullRequest.ID),
+		OccurredAt:  mergedAt,
+		Payload: map[string]any{
+			"pull_request_id": string(pullRequest.ID),
+			"author_id":       string(pullRequest.AuthorID),
+			"force_merged":    pullRequest.ForceMerged,
+		},
+	}
+}
+
+func userDeactivatedEvents(users []entities.User, occurredAt time.Time) []entities.Event {
+	events := make([]entities.Event, 0, len(users))
+
+	for _, user := range users {
+		events = append(events, entities.Event{
+			Type:        entities.EventUserDeactivated,
+			AggregateID: string(user.ID),
+			OccurredAt:  occurredAt,
+			Payload: map[string]any{
+				"user_id":   string(user.ID),
+				"team_name": string(user.Team),
+			},
+		})
+	}
+
+	return events
+}
+
+func teamCreatedEvent(team entities.Team, members []entities.User, occurredAt time.Time) entities.Event {
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, string(member.ID))
+	}
+
+	return entities.Event{
+		Type:        entities.EventTeamCreated,
+		AggregateID: string(team.Name),
+		OccurredAt:  occurredAt,
+		Payload: map[string]any{
+			"team_name":  string(team.Name),
+			"member_ids": memberIDs,
+		},
+	}
+}
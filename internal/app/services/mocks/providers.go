@@ -1,9 +1,12 @@
 package mocks
 
 import (
+	"context"
 	"time"
 
 	"github.com/stretchr/testify/mock"
+
+	"pr-service/internal/domain/entities"
 )
 
 type TimeProvider struct {
@@ -29,3 +32,23 @@ func (m *RandomProvider) Intn(n int) int {
 
 	return args.Int(0)
 }
+
+type Notifier struct {
+	mock.Mock
+}
+
+func (m *Notifier) Notify(ctx context.Context, reminder entities.ReviewReminder) error {
+	args := m.Called(ctx, reminder)
+
+	return args.Error(0)
+}
+
+type EventSink struct {
+	mock.Mock
+}
+
+func (m *EventSink) Deliver(ctx context.Context, entry entities.OutboxEntry) error {
+	args := m.Called(ctx, entry)
+
+	return args.Error(0)
+}
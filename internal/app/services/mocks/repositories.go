@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -43,6 +44,48 @@ func (m *UserRepository) SetIsActive(ctx context.Context, userID value_objects.U
 	return args.Get(0).(entities.User), args.Error(1)
 }
 
+func (m *UserRepository) SetMaxOpenReviews(ctx context.Context, userID value_objects.UserID, maxOpenReviews int) (entities.User, error) {
+	args := m.Called(ctx, userID, maxOpenReviews)
+
+	return args.Get(0).(entities.User), args.Error(1)
+}
+
+func (m *UserRepository) SetIsActiveByTeam(ctx context.Context, teamName value_objects.TeamName, userIDs []value_objects.UserID, isActive bool) ([]entities.User, error) {
+	args := m.Called(ctx, teamName, userIDs, isActive)
+
+	return args.Get(0).([]entities.User), args.Error(1)
+}
+
+func (m *UserRepository) SetTeam(ctx context.Context, userIDs []value_objects.UserID, teamName value_objects.TeamName) error {
+	args := m.Called(ctx, userIDs, teamName)
+
+	return args.Error(0)
+}
+
+func (m *UserRepository) AddAvailabilityWindow(ctx context.Context, window entities.AvailabilityWindow) (entities.AvailabilityWindow, error) {
+	args := m.Called(ctx, window)
+
+	return args.Get(0).(entities.AvailabilityWindow), args.Error(1)
+}
+
+func (m *UserRepository) GetAvailabilityWindows(ctx context.Context, id value_objects.UserID) ([]entities.AvailabilityWindow, error) {
+	args := m.Called(ctx, id)
+
+	return args.Get(0).([]entities.AvailabilityWindow), args.Error(1)
+}
+
+func (m *UserRepository) DeleteAvailabilityWindow(ctx context.Context, id value_objects.UserID, windowID int64) error {
+	args := m.Called(ctx, id, windowID)
+
+	return args.Error(0)
+}
+
+func (m *UserRepository) GetUnavailableUserIDs(ctx context.Context, ids []value_objects.UserID, at time.Time) (map[value_objects.UserID]bool, error) {
+	args := m.Called(ctx, ids, at)
+
+	return args.Get(0).(map[value_objects.UserID]bool), args.Error(1)
+}
+
 type TeamRepository struct {
 	mock.Mock
 }
@@ -53,18 +96,90 @@ func (m *TeamRepository) GetByName(ctx context.Context, name value_objects.TeamN
 	return args.Get(0).(entities.Team), args.Error(1)
 }
 
-func (m *TeamRepository) Create(ctx context.Context, team entities.Team) error {
+func (m *TeamRepository) Create(ctx context.Context, team entities.Team) (entities.Team, error) {
 	args := m.Called(ctx, team)
 
-	return args.Error(0)
+	return args.Get(0).(entities.Team), args.Error(1)
 }
 
-func (m *TeamRepository) GetAll(ctx context.Context) ([]entities.Team, error) {
-	args := m.Called(ctx)
+func (m *TeamRepository) GetAll(ctx context.Context, includeArchived bool) ([]entities.Team, error) {
+	args := m.Called(ctx, includeArchived)
 
 	return args.Get(0).([]entities.Team), args.Error(1)
 }
 
+func (m *TeamRepository) UpdateMergePolicy(ctx context.Context, name value_objects.TeamName, leadID value_objects.UserID, policy entities.MergePolicy) error {
+	args := m.Called(ctx, name, leadID, policy)
+
+	return args.Error(0)
+}
+
+func (m *TeamRepository) UpdateAssignmentStrategy(ctx context.Context, name value_objects.TeamName, strategy entities.AssignmentStrategy) error {
+	args := m.Called(ctx, name, strategy)
+
+	return args.Error(0)
+}
+
+func (m *TeamRepository) UpdateMaxOpenReviews(ctx context.Context, name value_objects.TeamName, maxOpenReviews int) error {
+	args := m.Called(ctx, name, maxOpenReviews)
+
+	return args.Error(0)
+}
+
+func (m *TeamRepository) UpdateReviewSLA(ctx context.Context, name value_objects.TeamName, sla entities.ReviewSLA) error {
+	args := m.Called(ctx, name, sla)
+
+	return args.Error(0)
+}
+
+func (m *TeamRepository) AddMembershipChanges(ctx context.Context, changes []entities.MembershipChange) error {
+	args := m.Called(ctx, changes)
+
+	return args.Error(0)
+}
+
+func (m *TeamRepository) GetMembershipHistory(ctx context.Context, name value_objects.TeamName) ([]entities.MembershipChange, error) {
+	args := m.Called(ctx, name)
+
+	return args.Get(0).([]entities.MembershipChange), args.Error(1)
+}
+
+func (m *TeamRepository) Archive(ctx context.Context, name value_objects.TeamName, archivedAt time.Time) error {
+	args := m.Called(ctx, name, archivedAt)
+
+	return args.Error(0)
+}
+
+func (m *TeamRepository) Delete(ctx context.Context, name value_objects.TeamName) error {
+	args := m.Called(ctx, name)
+
+	return args.Error(0)
+}
+
+func (m *TeamRepository) Rename(ctx context.Context, name value_objects.TeamName, newName value_objects.TeamName) error {
+	args := m.Called(ctx, name, newName)
+
+	return args.Error(0)
+}
+
+func (m *TeamRepository) UpdateParent(ctx context.Context, name value_objects.TeamName, parentName value_objects.TeamName) error {
+	args := m.Called(ctx, name, parentName)
+
+	return args.Error(0)
+}
+
+func (m *TeamRepository) LockRoundRobinCursor(ctx context.Context, name value_objects.TeamName) (value_objects.UserID, error) {
+	args := m.Called(ctx, name)
+
+	return args.Get(0).(value_objects.UserID), args.Error(1)
+}
+
+func (m *TeamRepository) UpdateRoundRobinCursor(ctx context.Context, name value_objects.TeamName, cursor value_objects.UserID) error {
+	args := m.Called(ctx, name, cursor)
+
+	return args.Error(0)
+}
+
 type PullRequestRepository struct {
 	mock.Mock
 }
@@ -103,8 +218,180 @@ func (m *PullRequestRepository) GetAll(ctx context.Context) ([]entities.PullRequ
 	return args.Get(0).([]entities.PullRequest), args.Error(1)
 }
 
-func (m *PullRequestRepository) ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID, newReviewerID value_objects.UserID) error {
-	args := m.Called(ctx, pullRequestID, oldReviewerID, newReviewerID)
+func (m *PullRequestRepository) ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID, assignment entities.ReviewerAssignment) error {
+	args := m.Called(ctx, pullRequestID, oldReviewerID, assignment)
+
+	return args.Error(0)
+}
+
+func (m *PullRequestRepository) CountOpenReviews(ctx context.Context, reviewerIDs []value_objects.UserID) (map[value_objects.UserID]int, error) {
+	args := m.Called(ctx, reviewerIDs)
+
+	return args.Get(0).(map[value_objects.UserID]int), args.Error(1)
+}
+
+func (m *PullRequestRepository) AddReviewers(ctx context.Context, pullRequestID value_objects.PullRequestID, assignments []entities.ReviewerAssignment) error {
+	args := m.Called(ctx, pullRequestID, assignments)
+
+	return args.Error(0)
+}
+
+func (m *PullRequestRepository) RemoveReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID) error {
+	args := m.Called(ctx, pullRequestID, reviewerID)
+
+	return args.Error(0)
+}
+
+func (m *PullRequestRepository) RemoveReviewerQuotas(ctx context.Context, pullRequestID value_objects.PullRequestID) error {
+	args := m.Called(ctx, pullRequestID)
+
+	return args.Error(0)
+}
+
+func (m *PullRequestRepository) SaveReview(ctx context.Context, pullRequestID value_objects.PullRequestID, review entities.Review) error {
+	args := m.Called(ctx, pullRequestID, review)
+
+	return args.Error(0)
+}
+
+func (m *PullRequestRepository) CountOpenByTeam(ctx context.Context, teamName value_objects.TeamName) (int, error) {
+	args := m.Called(ctx, teamName)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *PullRequestRepository) LockUnderAssigned(ctx context.Context, afterID value_objects.PullRequestID, limit int) ([]value_objects.PullRequestID, error) {
+	args := m.Called(ctx, afterID, limit)
+
+	return args.Get(0).([]value_objects.PullRequestID), args.Error(1)
+}
+
+func (m *PullRequestRepository) GetOpenCreatedBefore(ctx context.Context, createdBefore time.Time) ([]entities.PullRequest, error) {
+	args := m.Called(ctx, createdBefore)
+
+	return args.Get(0).([]entities.PullRequest), args.Error(1)
+}
+
+func (m *PullRequestRepository) AddReviewerChange(ctx context.Context, change entities.ReviewerChange) error {
+	args := m.Called(ctx, change)
 
 	return args.Error(0)
 }
+
+func (m *PullRequestRepository) GetReviewerChanges(ctx context.Context, pullRequestID value_objects.PullRequestID) ([]entities.ReviewerChange, error) {
+	args := m.Called(ctx, pullRequestID)
+
+	return args.Get(0).([]entities.ReviewerChange), args.Error(1)
+}
+
+type ReviewReminderRepository struct {
+	mock.Mock
+}
+
+func (m *ReviewReminderRepository) Record(ctx context.Context, reminder entities.ReviewReminder) (bool, error) {
+	args := m.Called(ctx, reminder)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *ReviewReminderRepository) GetUndelivered(ctx context.Context) ([]entities.ReviewReminder, error) {
+	args := m.Called(ctx)
+
+	return args.Get(0).([]entities.ReviewReminder), args.Error(1)
+}
+
+func (m *ReviewReminderRepository) MarkDelivered(ctx context.Context, reminder entities.ReviewReminder, deliveredAt time.Time) error {
+	args := m.Called(ctx, reminder, deliveredAt)
+
+	return args.Error(0)
+}
+
+type WebhookRepository struct {
+	mock.Mock
+}
+
+func (m *WebhookRepository) Create(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	args := m.Called(ctx, webhook)
+
+	return args.Get(0).(entities.Webhook), args.Error(1)
+}
+
+func (m *WebhookRepository) GetAll(ctx context.Context) ([]entities.Webhook, error) {
+	args := m.Called(ctx)
+
+	return args.Get(0).([]entities.Webhook), args.Error(1)
+}
+
+func (m *WebhookRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+func (m *WebhookRepository) AddDeadLetter(ctx context.Context, deadLetter entities.WebhookDeadLetter) error {
+	args := m.Called(ctx, deadLetter)
+
+	return args.Error(0)
+}
+
+func (m *WebhookRepository) GetDeadLetters(ctx context.Context, webhookID int64) ([]entities.WebhookDeadLetter, error) {
+	args := m.Called(ctx, webhookID)
+
+	return args.Get(0).([]entities.WebhookDeadLetter), args.Error(1)
+}
+
+func (m *WebhookRepository) GetDeliveries(ctx context.Context, outboxEntryID int64) ([]entities.WebhookDelivery, error) {
+	args := m.Called(ctx, outboxEntryID)
+
+	return args.Get(0).([]entities.WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookRepository) SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+
+	return args.Error(0)
+}
+
+type OutboxRepository struct {
+	mock.Mock
+}
+
+func (m *OutboxRepository) Add(ctx context.Context, events ...entities.Event) error {
+	args := m.Called(ctx, events)
+
+	return args.Error(0)
+}
+
+func (m *OutboxRepository) GetPending(ctx context.Context, limit int) ([]entities.OutboxEntry, error) {
+	args := m.Called(ctx, limit)
+
+	return args.Get(0).([]entities.OutboxEntry), args.Error(1)
+}
+
+func (m *OutboxRepository) MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error {
+	args := m.Called(ctx, id, deliveredAt)
+
+	return args.Error(0)
+}
+
+func (m *OutboxRepository) ScheduleRetry(ctx context.Context, id int64, nextAttemptAt time.Time) error {
+	args := m.Called(ctx, id, nextAttemptAt)
+
+	return args.Error(0)
+}
+
+type AuditRepository struct {
+	mock.Mock
+}
+
+func (m *AuditRepository) Add(ctx context.Context, entries ...entities.AuditEntry) error {
+	args := m.Called(ctx, entries)
+
+	return args.Error(0)
+}
+
+func (m *AuditRepository) Find(ctx context.Context, query entities.AuditQuery) ([]entities.AuditEntry, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).([]entities.AuditEntry), args.Error(1)
+}
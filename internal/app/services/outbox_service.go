@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"pr-service/internal/app"
+)
+
+type OutboxService interface {
+	Relay(ctx context.Context, batchSize int) (RelayReport, error)
+}
+
+type RelayReport struct {
+	Checked   int
+	Delivered int
+	Failed    int
+	Deferred  int
+}
+
+type outboxService struct {
+	outboxRepository app.OutboxRepository
+	sink             app.EventSink
+	timeProvider     app.TimeProvider
+}
+
+func NewOutboxService(outboxRepository app.OutboxRepository, sink app.EventSink, timeProvider app.TimeProvider) OutboxService {
+	return &outboxService{
+		outboxRepository: outboxRepository,
+		sink:             sink,
+		timeProvider:     timeProvider,
+	}
+}
+
+func (s *outboxService) Relay(ctx context.Context, batchSize int) (RelayReport, error) {
+	entries, err := s.outboxRepository.GetPending(ctx, batchSize)
+	if err != nil {
+		return RelayReport{}, err
+	}
+
+	report := RelayReport{Checked: len(entries)}
+	blocked := make(map[string]bool)
+	now := s.timeProvider.Now()
+	var errs []error
+
+	for _, entry := range entries {
+		if blocked[entry.Event.AggregateID] {
+			continue
+		}
+
+		if entry.NextAttemptAt != nil && now.Before(*entry.NextAttemptAt) {
+			blocked[entry.Event.AggregateID] = true
+			report.Deferred++
+			continue
+		}
+
+		if err := s.sink.Deliver(ctx, entry); err != nil {
+			blocked[entry.Event.AggregateID] = true
+
+			var retryErr *app.RetryError
+			if errors.As(err, &retryErr) {
+				if err := s.outboxRepository.ScheduleRetry(ctx, entry.ID, retryErr.RetryAt); err != nil {
+					return report, err
+				}
+				if retryErr.Err == nil {
+					report.Deferred++
+					continue
+				}
+			}
+
+			report.Failed++
+			errs = append(errs, fmt.Errorf("failed to deliver outbox entry %d: %w", entry.ID, err))
+			continue
+		}
+
+		if err := s.outboxRepository.MarkDelivered(ctx, entry.ID, s.timeProvider.Now()); err != nil {
+			return report, err
+		}
+		report.Delivered++
+	}
+
+	return report, errors.Join(errs...)
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/app"
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/memory"
+)
+
+func newOutboxFixture(t *testing.T, aggregateIDs ...string) (app.OutboxRepository, *mocks.TimeProvider) {
+	t.Helper()
+
+	outboxRepository := memory.NewOutboxRepository(memory.NewStore())
+	for _, aggregateID := range aggregateIDs {
+		require.NoError(t, outboxRepository.Add(context.Background(), entities.Event{Type: entities.EventReviewerAssigned, AggregateID: aggregateID}))
+	}
+
+	timeProvider := &mocks.TimeProvider{}
+	timeProvider.On("Now").Return(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+
+	return outboxRepository, timeProvider
+}
+
+func deliveredIDs(entries []entities.OutboxEntry) []int64 {
+	ids := make([]int64, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+
+	return ids
+}
+
+func TestOutboxService_Relay(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deliver pending entries in order and mark them delivered", func(t *testing.T) {
+		outboxRepository, timeProvider := newOutboxFixture(t, "pr-1", "pr-2", "pr-1")
+		sink := memory.NewEventSink()
+		service := NewOutboxService(outboxRepository, sink, timeProvider)
+
+		report, err := service.Relay(ctx, 10)
+		require.NoError(t, err)
+
+		assert.Equal(t, RelayReport{Checked: 3, Delivered: 3}, report)
+		assert.Equal(t, []int64{1, 2, 3}, deliveredIDs(sink.Delivered()))
+
+		report, err = service.Relay(ctx, 10)
+		require.NoError(t, err)
+
+		assert.Zero(t, report.Checked)
+		assert.Len(t, sink.Delivered(), 3)
+	})
+
+	t.Run("hold back later entries of a failed pull request until redelivery succeeds", func(t *testing.T) {
+		outboxRepository, timeProvider := newOutboxFixture(t, "pr-1", "pr-2", "pr-1")
+
+		sinkErr := errors.New("receiver unavailable")
+		failingSink := &mocks.EventSink{}
+		failingSink.On("Deliver", mock.Anything, mock.MatchedBy(func(entry entities.OutboxEntry) bool {
+			return entry.Event.AggregateID == "pr-1"
+		})).Return(sinkErr)
+		failingSink.On("Deliver", mock.Anything, mock.Anything).Return(nil)
+
+		report, err := NewOutboxService(outboxRepository, failingSink, timeProvider).Relay(ctx, 10)
+		assert.ErrorIs(t, err, sinkErr)
+
+		assert.Equal(t, RelayReport{Checked: 3, Delivered: 1, Failed: 1}, report)
+		failingSink.AssertNumberOfCalls(t, "Deliver", 2)
+
+		sink := memory.NewEventSink()
+		report, err = NewOutboxService(outboxRepository, sink, timeProvider).Relay(ctx, 10)
+		require.NoError(t, err)
+
+		assert.Equal(t, RelayReport{Checked: 2, Delivered: 2}, report)
+		assert.Equal(t, []int64{1, 3}, deliveredIDs(sink.Delivered()))
+	})
+
+	t.Run("skip entries until their scheduled retry is due", func(t *testing.T) {
+		outboxRepository, timeProvider := newOutboxFixture(t, "pr-1", "pr-2", "pr-1")
+		retryAt := time.Date(2024, 1, 1, 9, 1, 0, 0, time.UTC)
+
+		sinkErr := errors.New("receiver unavailable")
+		failingSink := &mocks.EventSink{}
+		failingSink.On("Deliver", mock.Anything, mock.MatchedBy(func(entry entities.OutboxEntry) bool {
+			return entry.Event.AggregateID == "pr-1"
+		})).Return(&app.RetryError{RetryAt: retryAt, Err: sinkErr})
+		failingSink.On("Deliver", mock.Anything, mock.Anything).Return(nil)
+
+		service := NewOutboxService(outboxRepository, failingSink, timeProvider)
+
+		report, err := service.Relay(ctx, 10)
+		assert.ErrorIs(t, err, sinkErr)
+		assert.Equal(t, RelayReport{Checked: 3, Delivered: 1, Failed: 1}, report)
+
+		report, err = service.Relay(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, RelayReport{Checked: 2, Deferred: 1}, report)
+		failingSink.AssertNumberOfCalls(t, "Deliver", 2)
+
+		later := &mocks.TimeProvider{}
+		later.On("Now").Return(retryAt)
+		sink := memory.NewEventSink()
+
+		report, err = NewOutboxService(outboxRepository, sink, later).Relay(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, RelayReport{Checked: 2, Delivered: 2}, report)
+		assert.Equal(t, []int64{1, 3}, deliveredIDs(sink.Delivered()))
+	})
+
+	t.Run("defer entry without failing when sink only waits for a retry", func(t *testing.T) {
+		outboxRepository, timeProvider := newOutboxFixture(t, "pr-1")
+		retryAt := time.Date(2024, 1, 1, 9, 1, 0, 0, time.UTC)
+
+		waitingSink := &mocks.EventSink{}
+		waitingSink.On("Deliver", mock.Anything, mock.Anything).Return(&app.RetryError{RetryAt: retryAt})
+
+		report, err := NewOutboxService(outboxRepository, waitingSink, timeProvider).Relay(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, RelayReport{Checked: 1, Deferred: 1}, report)
+
+		entries, err := outboxRepository.GetPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.NotNil(t, entries[0].NextAttemptAt)
+		assert.Equal(t, retryAt, *entries[0].NextAttemptAt)
+	})
+}
@@ -2,6 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"time"
 
 	"pr-service/internal/app"
 	"pr-service/internal/domain"
@@ -10,9 +13,48 @@ import (
 )
 
 type PullRequestService interface {
-	Create(ctx context.Context, pullRequestID value_objects.PullRequestID, pullRequestName string, authorID value_objects.UserID) (*entities.PullRequest, error)
-	Merge(ctx context.Context, pullRequestID value_objects.PullRequestID) (*entities.PullRequest, error)
-	ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID) (*entities.PullRequest, value_objects.UserID, error)
+	Create(ctx context.Context, pullRequestID value_objects.PullRequestID, pullRequestName string, authorID value_objects.UserID, options CreateOptions) (*entities.PullRequest, error)
+	GetByID(ctx context.Context, pullRequestID value_objects.PullRequestID) (*entities.PullRequest, error)
+	Merge(ctx context.Context, pullRequestID value_objects.PullRequestID, options MergeOptions) (*entities.PullRequest, error)
+	ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID, options ReassignOptions) (*entities.PullRequest, value_objects.UserID, error)
+	SubmitReview(ctx context.Context, pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID, decision entities.ReviewDecision, options ReviewOptions) (*entities.PullRequest, error)
+	MarkReady(ctx context.Context, pullRequestID value_objects.PullRequestID, options TransitionOptions) (*entities.PullRequest, error)
+	Close(ctx context.Context, pullRequestID value_objects.PullRequestID, options TransitionOptions) (*entities.PullRequest, error)
+	Reopen(ctx context.Context, pullRequestID value_objects.PullRequestID, options TransitionOptions) (*entities.PullRequest, error)
+	AssignReviewers(ctx context.Context, pullRequestID value_objects.PullRequestID, options TransitionOptions) (*entities.PullRequest, error)
+	UnassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID, options TransitionOptions) (*entities.PullRequest, error)
+	BackfillReviewers(ctx context.Context, batchSize int) (BackfillReport, error)
+	ReassignOverdueReviewers(ctx context.Context) (ReassignmentReport, error)
+	GetReviewerHistory(ctx context.Context, pullRequestID value_objects.PullRequestID) ([]entities.ReviewerChange, error)
+}
+
+type CreateOptions struct {
+	ReviewersLimit    *int
+	Draft             bool
+	BorrowedReviewers []entities.ReviewerQuota
+}
+
+type MergeOptions struct {
+	ExpectedVersion *int
+	Force           bool
+}
+
+type ReassignOptions struct {
+	ExpectedVersion *int
+	NewReviewerID   *value_objects.UserID
+}
+
+type ReviewOptions struct {
+	ExpectedVersion *int
+}
+
+type TransitionOptions struct {
+	ExpectedVersion *int
+}
+
+type BackfillReport struct {
+	Checked    int
+	Backfilled []value_objects.PullRequestID
 }
 
 type pullRequestService struct {
@@ -21,25 +63,41 @@ type pullRequestService struct {
 	pullRequestRepository app.PullRequestRepository
 	txManager             app.TxManager
 	timeProvider          app.TimeProvider
-	random                app.RandomProvider
+	assignmentStrategy    app.ReviewerAssignmentStrategy
+	outboxRepository      app.OutboxRepository
+	auditRepository       app.AuditRepository
+	reassigner            *reviewerReassigner
 }
 
-func NewPullRequestService(userRepository app.UserRepository, teamRepository app.TeamRepository, pullRequestRepository app.PullRequestRepository, txManager app.TxManager, timeProvider app.TimeProvider, randomProvider app.RandomProvider) PullRequestService {
+func NewPullRequestService(userRepository app.UserRepository, teamRepository app.TeamRepository, pullRequestRepository app.PullRequestRepository, txManager app.TxManager, timeProvider app.TimeProvider, assignmentStrategy app.ReviewerAssignmentStrategy, outboxRepository app.OutboxRepository, auditRepository app.AuditRepository) PullRequestService {
 	return &pullRequestService{
 		userRepository:        userRepository,
 		teamRepository:        teamRepository,
 		pullRequestRepository: pullRequestRepository,
 		txManager:             txManager,
 		timeProvider:          timeProvider,
-		random:                randomProvider,
+		assignmentStrategy:    assignmentStrategy,
+		outboxRepository:      outboxRepository,
+		auditRepository:       auditRepository,
+		reassigner:            newReviewerReassigner(userRepository, teamRepository, pullRequestRepository, timeProvider, assignmentStrategy),
 	}
 }
 
-func (s *pullRequestService) Create(ctx context.Context, pullRequestID value_objects.PullRequestID, pullRequestName string, authorID value_objects.UserID) (*entities.PullRequest, error) {
+func (s *pullRequestService) Create(ctx context.Context, pullRequestID value_objects.PullRequestID, pullRequestName string, authorID value_objects.UserID, options CreateOptions) (*entities.PullRequest, error) {
 	if s.txManager == nil {
 		return nil, app.ErrTransactionRequired
 	}
 
+	if options.ReviewersLimit != nil {
+		if err := entities.ValidateReviewersLimit(*options.ReviewersLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := entities.ValidateReviewerQuotas(options.BorrowedReviewers); err != nil {
+		return nil, err
+	}
+
 	var resultPullRequest *entities.PullRequest
 
 	operation := func(ctx context.Context) error {
@@ -62,28 +120,47 @@ func (s *pullRequestService) Create(ctx context.Context, pullRequestID value_obj
 			return domain.ErrAuthorNotActive
 		}
 
-		teamMembers, err := s.userRepository.GetUsersByTeam(ctx, team.Name)
-		if err != nil {
-			return err
+		if team.IsArchived() {
+			return domain.ErrTeamArchived
 		}
 
 		resultPullRequest = entities.NewPullRequest(pullRequestID, pullRequestName, authorID, s.timeProvider.Now())
+		resultPullRequest.ReviewersLimit = team.DefaultReviewersLimit()
+		if options.ReviewersLimit != nil {
+			resultPullRequest.ReviewersLimit = *options.ReviewersLimit
+		}
 
-		activeCandidates := s.filterActiveUsersExcludeAuthor(authorID, teamMembers)
-		if len(activeCandidates) > 0 {
-			activeCandidatesIDs := toUserIDs(activeCandidates)
-			s.random.Shuffle(len(activeCandidatesIDs), func(i, j int) {
-				activeCandidatesIDs[i], activeCandidatesIDs[j] = activeCandidatesIDs[j], activeCandidatesIDs[i]
-			})
+		if len(options.BorrowedReviewers) > 0 {
+			resultPullRequest.ReviewersLimit += entities.TotalReviewerQuota(options.BorrowedReviewers)
+			if err := entities.ValidateReviewersLimit(resultPullRequest.ReviewersLimit); err != nil {
+				return err
+			}
 
-			resultPullRequest.AddReviewers(activeCandidatesIDs)
+			if options.Draft {
+				resultPullRequest.BorrowedReviewers = options.BorrowedReviewers
+			} else if _, err := s.borrowReviewers(ctx, resultPullRequest, team, options.BorrowedReviewers); err != nil {
+				return err
+			}
+		}
+
+		if options.Draft {
+			resultPullRequest.Status = entities.StatusDraft
+		} else if _, err := s.assignReviewers(ctx, resultPullRequest, team); err != nil {
+			return err
 		}
 
 		if err := s.pullRequestRepository.Create(ctx, resultPullRequest); err != nil {
 			return err
 		}
 
-		return nil
+		events := append([]entities.Event{pullRequestCreatedEvent(resultPullRequest)}, reviewerAssignedEvents(resultPullRequest.ID, resultPullRequest.Assignments())...)
+		if err := s.outboxRepository.Add(ctx, events...); err != nil {
+			return err
+		}
+
+		entry := newAuditEntry(ctx, entities.AuditPullRequestCreated, entities.AuditEntityPullRequest, string(resultPullRequest.ID), nil, pullRequestAuditState(resultPullRequest), resultPullRequest.CreatedAt)
+
+		return s.auditRepository.Add(ctx, entry)
 	}
 
 	if err := s.txManager.Do(ctx, operation); err != nil {
@@ -93,22 +170,71 @@ func (s *pullRequestService) Create(ctx context.Context, pullRequestID value_obj
 	return resultPullRequest, nil
 }
 
-func (s *pullRequestService) Merge(ctx context.Context, pullRequestID value_objects.PullRequestID) (*entities.PullRequest, error) {
-	pullRequest, err := s.pullRequestRepository.GetByID(ctx, pullRequestID)
-	if err != nil {
-		return nil, err
+func (s *pullRequestService) GetByID(ctx context.Context, pullRequestID value_objects.PullRequestID) (*entities.PullRequest, error) {
+	return s.pullRequestRepository.GetByID(ctx, pullRequestID)
+}
+
+func (s *pullRequestService) Merge(ctx context.Context, pullRequestID value_objects.PullRequestID, options MergeOptions) (*entities.PullRequest, error) {
+	if s.txManager == nil {
+		return nil, app.ErrTransactionRequired
 	}
 
-	pullRequest.Merge(s.timeProvider.Now())
+	var resultPullRequest *entities.PullRequest
 
-	if err := s.pullRequestRepository.Save(ctx, pullRequest); err != nil {
+	operation := func(ctx context.Context) error {
+		pullRequest, err := s.pullRequestRepository.GetByID(ctx, pullRequestID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkExpectedVersion(pullRequest, options.ExpectedVersion); err != nil {
+			return err
+		}
+
+		if pullRequest.IsMerged() {
+			resultPullRequest = pullRequest
+			return nil
+		}
+
+		if !pullRequest.IsOpen() {
+			return domain.ErrPRNotOpen
+		}
+
+		before := pullRequestAuditState(pullRequest)
+
+		if options.Force {
+			pullRequest.ForceMerge(s.timeProvider.Now())
+		} else {
+			if err := s.checkMergePolicy(ctx, pullRequest); err != nil {
+				return err
+			}
+
+			pullRequest.Merge(s.timeProvider.Now())
+		}
+
+		if err := s.pullRequestRepository.Save(ctx, pullRequest); err != nil {
+			return err
+		}
+
+		resultPullRequest = pullRequest
+
+		if err := s.outboxRepository.Add(ctx, pullRequestMergedEvent(pullRequest)); err != nil {
+			return err
+		}
+
+		entry := newAuditEntry(ctx, entities.AuditPullRequestMerged, entities.AuditEntityPullRequest, string(pullRequest.ID), before, pullRequestAuditState(pullRequest), *pullRequest.MergedAt)
+
+		return s.auditRepository.Add(ctx, entry)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
 		return nil, err
 	}
 
-	return pullRequest, nil
+	return resultPullRequest, nil
 }
 
-func (s *pullRequestService) ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID) (*entities.PullRequest, value_objects.UserID, error) {
+func (s *pullRequestService) ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID, options ReassignOptions) (*entities.PullRequest, value_objects.UserID, error) {
 	if s.txManager == nil {
 		return nil, "", app.ErrTransactionRequired
 	}
@@ -122,9 +248,16 @@ func (s *pullRequestService) ReassignReviewer(ctx context.Context, pullRequestID
 			return err
 		}
 
+		if err := checkExpectedVersion(pullRequest, options.ExpectedVersion); err != nil {
+			return err
+		}
+
 		if pullRequest.IsMerged() {
 			return domain.ErrPRMerged
 		}
+		if !pullRequest.IsOpen() {
+			return domain.ErrPRNotOpen
+		}
 
 		_, err = s.userRepository.GetByID(ctx, oldReviewerID)
 		if err != nil {
@@ -135,6 +268,121 @@ func (s *pullRequestService) ReassignReviewer(ctx context.Context, pullRequestID
 			return domain.ErrNotAssigned
 		}
 
+		previous := pullRequest.Assignment(oldReviewerID)
+		before := pullRequestAuditState(pullRequest)
+
+		if options.NewReviewerID != nil {
+			newReviewerID, err = s.reassigner.reassignTo(ctx, pullRequest, oldReviewerID, *options.NewReviewerID)
+		} else {
+			newReviewerID, err = s.reassigner.reassign(ctx, pullRequest, oldReviewerID, reassignScope{})
+		}
+		if err != nil {
+			return err
+		}
+
+		resultPullRequest = pullRequest
+
+		reassignment := ReviewReassignment{PullRequestID: pullRequestID, OldReviewerID: oldReviewerID, NewReviewerID: newReviewerID}
+		reassignedAt := pullRequest.Assignment(newReviewerID).AssignedAt
+
+		err = s.pullRequestRepository.AddReviewerChange(ctx, entities.ReviewerChange{
+			PullRequestID: pullRequestID,
+			OldReviewerID: oldReviewerID,
+			NewReviewerID: newReviewerID,
+			Level:         previous.Level,
+			SourceTeam:    previous.SourceTeam,
+			AssignedAt:    previous.AssignedAt,
+			Reason:        entities.ReviewerChangeManual,
+			ChangedAt:     reassignedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := s.outboxRepository.Add(ctx, reviewerReassignedEvents([]ReviewReassignment{reassignment}, reassignedAt)...); err != nil {
+			return err
+		}
+
+		entry := newAuditEntry(ctx, entities.AuditReviewerReassigned, entities.AuditEntityPullRequest, string(pullRequestID), before, pullRequestAuditState(pullRequest), reassignedAt)
+
+		return s.auditRepository.Add(ctx, entry)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return nil, "", err
+	}
+
+	return resultPullRequest, newReviewerID, nil
+}
+
+func (s *pullRequestService) SubmitReview(ctx context.Context, pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID, decision entities.ReviewDecision, options ReviewOptions) (*entities.PullRequest, error) {
+	if s.txManager == nil {
+		return nil, app.ErrTransactionRequired
+	}
+
+	var resultPullRequest *entities.PullRequest
+
+	operation := func(ctx context.Context) error {
+		pullRequest, err := s.pullRequestRepository.GetByID(ctx, pullRequestID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkExpectedVersion(pullRequest, options.ExpectedVersion); err != nil {
+			return err
+		}
+
+		_, err = s.userRepository.GetByID(ctx, reviewerID)
+		if err != nil {
+			return domain.ErrUserNotFound
+		}
+
+		review, err := pullRequest.SubmitReview(reviewerID, decision, s.timeProvider.Now())
+		if err != nil {
+			return err
+		}
+
+		if err := s.pullRequestRepository.Save(ctx, pullRequest); err != nil {
+			return err
+		}
+
+		if err := s.pullRequestRepository.SaveReview(ctx, pullRequestID, review); err != nil {
+			return err
+		}
+
+		resultPullRequest = pullRequest
+
+		return nil
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	return resultPullRequest, nil
+}
+
+func (s *pullRequestService) MarkReady(ctx context.Context, pullRequestID value_objects.PullRequestID, options TransitionOptions) (*entities.PullRequest, error) {
+	if s.txManager == nil {
+		return nil, app.ErrTransactionRequired
+	}
+
+	var resultPullRequest *entities.PullRequest
+
+	operation := func(ctx context.Context) error {
+		pullRequest, err := s.pullRequestRepository.GetByID(ctx, pullRequestID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkExpectedVersion(pullRequest, options.ExpectedVersion); err != nil {
+			return err
+		}
+
+		if err := pullRequest.MarkReady(); err != nil {
+			return err
+		}
+
 		author, err := s.userRepository.GetByID(ctx, pullRequest.AuthorID)
 		if err != nil {
 			return err
@@ -145,69 +393,601 @@ func (s *pullRequestService) ReassignReviewer(ctx context.Context, pullRequestID
 			return err
 		}
 
-		teamMembers, err := s.userRepository.GetUsersByTeam(ctx, team.Name)
+		addedAssignments, err := s.borrowReviewers(ctx, pullRequest, team, pullRequest.BorrowedReviewers)
 		if err != nil {
 			return err
 		}
+		if len(pullRequest.BorrowedReviewers) > 0 {
+			if err := s.pullRequestRepository.RemoveReviewerQuotas(ctx, pullRequestID); err != nil {
+				return err
+			}
+			pullRequest.BorrowedReviewers = nil
+		}
 
-		activeCandidates := s.filterActiveUsersExcludeAuthorAndReviewer(pullRequest, pullRequest.AuthorID, oldReviewerID, teamMembers)
-		if len(activeCandidates) == 0 {
+		teamAssignments, err := s.assignReviewers(ctx, pullRequest, team)
+		if err != nil {
+			return err
+		}
+		addedAssignments = append(addedAssignments, teamAssignments...)
+
+		if err := s.pullRequestRepository.Save(ctx, pullRequest); err != nil {
+			return err
+		}
+
+		if err := s.pullRequestRepository.AddReviewers(ctx, pullRequestID, addedAssignments); err != nil {
+			return err
+		}
+
+		resultPullRequest = pullRequest
+
+		return s.outboxRepository.Add(ctx, reviewerAssignedEvents(pullRequestID, addedAssignments)...)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	return resultPullRequest, nil
+}
+
+func (s *pullRequestService) AssignReviewers(ctx context.Context, pullRequestID value_objects.PullRequestID, options TransitionOptions) (*entities.PullRequest, error) {
+	if s.txManager == nil {
+		return nil, app.ErrTransactionRequired
+	}
+
+	var resultPullRequest *entities.PullRequest
+
+	operation := func(ctx context.Context) error {
+		pullRequest, err := s.pullRequestRepository.GetByID(ctx, pullRequestID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkExpectedVersion(pullRequest, options.ExpectedVersion); err != nil {
+			return err
+		}
+
+		if pullRequest.IsMerged() {
+			return domain.ErrPRMerged
+		}
+		if !pullRequest.IsOpen() {
+			return domain.ErrPRNotOpen
+		}
+
+		resultPullRequest = pullRequest
+		if pullRequest.IsFullyAssigned() {
+			return nil
+		}
+
+		addedAssignments, err := s.fillReviewerSlots(ctx, pullRequest)
+		if err != nil {
+			return err
+		}
+		if len(addedAssignments) == 0 {
 			return domain.ErrNoCandidate
 		}
 
-		activeCandidatesIDs := toUserIDs(activeCandidates)
-		newReviewerID = activeCandidatesIDs[s.random.Intn(len(activeCandidatesIDs))]
+		return s.outboxRepository.Add(ctx, reviewerAssignedEvents(pullRequestID, addedAssignments)...)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	return resultPullRequest, nil
+}
+
+func (s *pullRequestService) BackfillReviewers(ctx context.Context, batchSize int) (BackfillReport, error) {
+	if s.txManager == nil {
+		return BackfillReport{}, app.ErrTransactionRequired
+	}
+
+	var report BackfillReport
+	var afterID value_objects.PullRequestID
+
+	for {
+		var batch []value_objects.PullRequestID
+		var backfilled []value_objects.PullRequestID
+
+		operation := func(ctx context.Context) error {
+			var err error
+			batch, err = s.pullRequestRepository.LockUnderAssigned(ctx, afterID, batchSize)
+			if err != nil {
+				return err
+			}
+
+			for _, pullRequestID := range batch {
+				pullRequest, err := s.pullRequestRepository.GetByID(ctx, pullRequestID)
+				if err != nil {
+					return err
+				}
+				if pullRequest.AvailableReviewerSlots() == 0 {
+					continue
+				}
+
+				addedAssignments, err := s.fillReviewerSlots(ctx, pullRequest)
+				if err != nil {
+					return err
+				}
+				if len(addedAssignments) == 0 {
+					continue
+				}
+
+				if err := s.outboxRepository.Add(ctx, reviewerAssignedEvents(pullRequestID, addedAssignments)...); err != nil {
+					return err
+				}
+				backfilled = append(backfilled, pullRequestID)
+			}
+
+			return nil
+		}
+
+		if err := s.txManager.Do(ctx, operation); err != nil {
+			return report, err
+		}
+
+		report.Checked += len(batch)
+		report.Backfilled = append(report.Backfilled, backfilled...)
+
+		if len(batch) < batchSize {
+			return report, nil
+		}
+		afterID = batch[len(batch)-1]
+	}
+}
+
+func (s *pullRequestService) ReassignOverdueReviewers(ctx context.Context) (ReassignmentReport, error) {
+	if s.txManager == nil {
+		return ReassignmentReport{}, app.ErrTransactionRequired
+	}
+
+	teams, err := s.teamRepository.GetAll(ctx, false)
+	if err != nil {
+		return ReassignmentReport{}, err
+	}
+
+	reassignAfter := make(map[value_objects.TeamName]time.Duration, len(teams))
+	var shortestThreshold time.Duration
 
-		err = s.pullRequestRepository.ReassignReviewer(ctx, pullRequestID, oldReviewerID, newReviewerID)
+	for _, team := range teams {
+		if team.ReviewSLA.ReassignAfter <= 0 {
+			continue
+		}
+
+		reassignAfter[team.Name] = team.ReviewSLA.ReassignAfter
+		if shortestThreshold == 0 || team.ReviewSLA.ReassignAfter < shortestThreshold {
+			shortestThreshold = team.ReviewSLA.ReassignAfter
+		}
+	}
+
+	var report ReassignmentReport
+	if len(reassignAfter) == 0 {
+		return report, nil
+	}
+
+	now := s.timeProvider.Now()
+
+	pullRequests, err := s.pullRequestRepository.GetOpenCreatedBefore(ctx, now.Add(-shortestThreshold))
+	if err != nil {
+		return report, err
+	}
+
+	authorTeams := make(map[value_objects.UserID]value_objects.TeamName)
+
+	for _, pullRequest := range pullRequests {
+		teamName, ok := authorTeams[pullRequest.AuthorID]
+		if !ok {
+			author, err := s.userRepository.GetByID(ctx, pullRequest.AuthorID)
+			if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+				return report, err
+			}
+
+			teamName = author.Team
+			authorTeams[pullRequest.AuthorID] = teamName
+		}
+
+		threshold, ok := reassignAfter[teamName]
+		if !ok {
+			continue
+		}
+
+		for _, reviewerID := range pullRequest.OverdueReviewers(threshold, now) {
+			reassigned, err := s.reassignOverdueReviewer(ctx, pullRequest.ID, reviewerID, threshold)
+			if errors.Is(err, domain.ErrNoCandidate) {
+				report.WithoutCandidate = append(report.WithoutCandidate, pullRequest.ID)
+				continue
+			}
+			if err != nil {
+				return report, err
+			}
+			if reassigned != nil {
+				report.Reassigned = append(report.Reassigned, *reassigned)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func (s *pullRequestService) reassignOverdueReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID, threshold time.Duration) (*ReviewReassignment, error) {
+	var reassignment *ReviewReassignment
+
+	operation := func(ctx context.Context) error {
+		pullRequest, err := s.pullRequestRepository.GetByID(ctx, pullRequestID)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(pullRequest.OverdueReviewers(threshold, s.timeProvider.Now()), reviewerID) {
+			return nil
+		}
+
+		previous := pullRequest.Assignment(reviewerID)
+		before := pullRequestAuditState(pullRequest)
+
+		newReviewerID, err := s.reassigner.reassign(ctx, pullRequest, reviewerID, reassignScope{})
 		if err != nil {
 			return err
 		}
 
-		resultPullRequest, err = s.pullRequestRepository.GetByID(ctx, pullRequestID)
+		err = s.pullRequestRepository.AddReviewerChange(ctx, entities.ReviewerChange{
+			PullRequestID: pullRequestID,
+			OldReviewerID: reviewerID,
+			NewReviewerID: newReviewerID,
+			Level:         previous.Level,
+			SourceTeam:    previous.SourceTeam,
+			AssignedAt:    previous.AssignedAt,
+			Reason:        entities.ReviewerChangeSLAExceeded,
+			ChangedAt:     s.timeProvider.Now(),
+		})
 		if err != nil {
 			return err
 		}
 
+		reassignment = &ReviewReassignment{
+			PullRequestID: pullRequestID,
+			OldReviewerID: reviewerID,
+			NewReviewerID: newReviewerID,
+		}
+
+		reassignedAt := pullRequest.Assignment(newReviewerID).AssignedAt
+
+		if err := s.outboxRepository.Add(ctx, reviewerReassignedEvents([]ReviewReassignment{*reassignment}, reassignedAt)...); err != nil {
+			return err
+		}
+
+		entry := newAuditEntry(ctx, entities.AuditReviewerReassigned, entities.AuditEntityPullRequest, string(pullRequestID), before, pullRequestAuditState(pullRequest), reassignedAt)
+
+		return s.auditRepository.Add(ctx, entry)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	return reassignment, nil
+}
+
+func (s *pullRequestService) GetReviewerHistory(ctx context.Context, pullRequestID value_objects.PullRequestID) ([]entities.ReviewerChange, error) {
+	if _, err := s.pullRequestRepository.GetByID(ctx, pullRequestID); err != nil {
+		return nil, err
+	}
+
+	return s.pullRequestRepository.GetReviewerChanges(ctx, pullRequestID)
+}
+
+func (s *pullRequestService) fillReviewerSlots(ctx context.Context, pullRequest *entities.PullRequest) ([]entities.ReviewerAssignment, error) {
+	author, err := s.userRepository.GetByID(ctx, pullRequest.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepository.GetByName(ctx, author.Team)
+	if err != nil {
+		return nil, err
+	}
+
+	addedAssignments, err := s.assignReviewers(ctx, pullRequest, team)
+	if err != nil || len(addedAssignments) == 0 {
+		return nil, err
+	}
+
+	if err := s.pullRequestRepository.Save(ctx, pullRequest); err != nil {
+		return nil, err
+	}
+
+	if err := s.pullRequestRepository.AddReviewers(ctx, pullRequest.ID, addedAssignments); err != nil {
+		return nil, err
+	}
+
+	return addedAssignments, nil
+}
+
+func (s *pullRequestService) UnassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID, options TransitionOptions) (*entities.PullRequest, error) {
+	if s.txManager == nil {
+		return nil, app.ErrTransactionRequired
+	}
+
+	var resultPullRequest *entities.PullRequest
+
+	operation := func(ctx context.Context) error {
+		pullRequest, err := s.pullRequestRepository.GetByID(ctx, pullRequestID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkExpectedVersion(pullRequest, options.ExpectedVersion); err != nil {
+			return err
+		}
+
+		previous := pullRequest.Assignment(reviewerID)
+		before := pullRequestAuditState(pullRequest)
+
+		if err := pullRequest.RemoveReviewer(reviewerID); err != nil {
+			return err
+		}
+
+		if err := s.pullRequestRepository.Save(ctx, pullRequest); err != nil {
+			return err
+		}
+
+		if err := s.pullRequestRepository.RemoveReviewer(ctx, pullRequestID, reviewerID); err != nil {
+			return err
+		}
+
+		unassignedAt := s.timeProvider.Now()
+
+		err = s.pullRequestRepository.AddReviewerChange(ctx, entities.ReviewerChange{
+			PullRequestID: pullRequestID,
+			OldReviewerID: reviewerID,
+			Level:         previous.Level,
+			SourceTeam:    previous.SourceTeam,
+			AssignedAt:    previous.AssignedAt,
+			Reason:        entities.ReviewerChangeUnassigned,
+			ChangedAt:     unassignedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		resultPullRequest = pullRequest
+
+		if err := s.outboxRepository.Add(ctx, reviewerUnassignedEvent(pullRequestID, reviewerID, unassignedAt)); err != nil {
+			return err
+		}
+
+		entry := newAuditEntry(ctx, entities.AuditReviewerUnassigned, entities.AuditEntityPullRequest, string(pullRequestID), before, pullRequestAuditState(pullRequest), unassignedAt)
+
+		return s.auditRepository.Add(ctx, entry)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	return resultPullRequest, nil
+}
+
+func (s *pullRequestService) Close(ctx context.Context, pullRequestID value_objects.PullRequestID, options TransitionOptions) (*entities.PullRequest, error) {
+	return s.transition(ctx, pullRequestID, options, func(pullRequest *entities.PullRequest) error {
+		return pullRequest.Close(s.timeProvider.Now())
+	})
+}
+
+func (s *pullRequestService) Reopen(ctx context.Context, pullRequestID value_objects.PullRequestID, options TransitionOptions) (*entities.PullRequest, error) {
+	return s.transition(ctx, pullRequestID, options, func(pullRequest *entities.PullRequest) error {
+		return pullRequest.Reopen()
+	})
+}
+
+func (s *pullRequestService) transition(ctx context.Context, pullRequestID value_objects.PullRequestID, options TransitionOptions, apply func(pullRequest *entities.PullRequest) error) (*entities.PullRequest, error) {
+	if s.txManager == nil {
+		return nil, app.ErrTransactionRequired
+	}
+
+	var resultPullRequest *entities.PullRequest
+
+	operation := func(ctx context.Context) error {
+		pullRequest, err := s.pullRequestRepository.GetByID(ctx, pullRequestID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkExpectedVersion(pullRequest, options.ExpectedVersion); err != nil {
+			return err
+		}
+
+		if err := apply(pullRequest); err != nil {
+			return err
+		}
+
+		if err := s.pullRequestRepository.Save(ctx, pullRequest); err != nil {
+			return err
+		}
+
+		resultPullRequest = pullRequest
+
 		return nil
 	}
 
 	if err := s.txManager.Do(ctx, operation); err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	return resultPullRequest, newReviewerID, nil
+	return resultPullRequest, nil
 }
 
-func (s *pullRequestService) filterActiveUsersExcludeAuthor(authorID value_objects.UserID, candidates []entities.User) []entities.User {
-	var activeCandidates []entities.User
+func (s *pullRequestService) assignReviewers(ctx context.Context, pullRequest *entities.PullRequest, team entities.Team) ([]entities.ReviewerAssignment, error) {
+	hierarchy, err := teamHierarchy(ctx, s.teamRepository, team)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, candidate := range candidates {
-		if candidate.IsActive && candidate.ID != authorID {
-			activeCandidates = append(activeCandidates, candidate)
+	addedAssignments, err := s.assignLead(ctx, pullRequest, team)
+	if err != nil {
+		return nil, err
+	}
+
+	for level, levelTeam := range hierarchy {
+		if pullRequest.AvailableReviewerSlots() == 0 {
+			break
+		}
+
+		teamMembers, err := s.userRepository.GetUsersByTeam(ctx, levelTeam.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		activeCandidates, err := s.filterAvailableCandidates(ctx, pullRequest, levelTeam, teamMembers)
+		if err != nil {
+			return nil, err
+		}
+		if len(activeCandidates) == 0 {
+			continue
+		}
+
+		selectedReviewers, err := s.assignmentStrategy.SelectReviewers(ctx, levelTeam, toUserIDs(activeCandidates), pullRequest.AvailableReviewerSlots())
+		if err != nil {
+			return nil, err
+		}
+
+		assignedAt := s.timeProvider.Now()
+		for _, reviewerID := range pullRequest.AddReviewers(selectedReviewers) {
+			pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: reviewerID, Level: level, AssignedAt: assignedAt})
+			addedAssignments = append(addedAssignments, pullRequest.Assignment(reviewerID))
 		}
 	}
 
-	return activeCandidates
+	return addedAssignments, nil
 }
 
-func (s *pullRequestService) filterActiveUsersExcludeAuthorAndReviewer(pullRequest *entities.PullRequest, authorID, reviewerID value_objects.UserID, candidates []entities.User) []entities.User {
-	var activeCandidates []entities.User
+// assignLead takes the first reviewer slot for the team lead when the merge
+// policy requires the lead's approval, so that the policy can be satisfied.
+func (s *pullRequestService) assignLead(ctx context.Context, pullRequest *entities.PullRequest, team entities.Team) ([]entities.ReviewerAssignment, error) {
+	if !team.MergePolicy.RequireLeadApproval || team.LeadID == "" || pullRequest.AvailableReviewerSlots() == 0 {
+		return nil, nil
+	}
 
-	for _, candidate := range candidates {
-		isAlreadyReviewer := false
-		for _, existingReviewer := range pullRequest.Reviewers() {
-			if existingReviewer == candidate.ID && existingReviewer != reviewerID {
-				isAlreadyReviewer = true
-				break
-			}
+	lead, err := s.userRepository.GetByID(ctx, team.LeadID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	availableLeads, err := s.filterAvailableCandidates(ctx, pullRequest, team, []entities.User{lead})
+	if err != nil {
+		return nil, err
+	}
+
+	var addedAssignments []entities.ReviewerAssignment
+
+	assignedAt := s.timeProvider.Now()
+	for _, reviewerID := range pullRequest.AddReviewers(toUserIDs(availableLeads)) {
+		pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: reviewerID, AssignedAt: assignedAt})
+		addedAssignments = append(addedAssignments, pullRequest.Assignment(reviewerID))
+	}
+
+	return addedAssignments, nil
+}
+
+func (s *pullRequestService) borrowReviewers(ctx context.Context, pullRequest *entities.PullRequest, authorTeam entities.Team, quotas []entities.ReviewerQuota) ([]entities.ReviewerAssignment, error) {
+	var addedAssignments []entities.ReviewerAssignment
+
+	for _, quota := range quotas {
+		if quota.TeamName == authorTeam.Name {
+			return nil, domain.ErrInvalidReviewerQuota
+		}
+
+		team, err := s.teamRepository.GetByName(ctx, quota.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		if team.IsArchived() {
+			return nil, domain.ErrTeamArchived
+		}
+
+		teamMembers, err := s.userRepository.GetUsersByTeam(ctx, team.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		activeCandidates, err := s.filterAvailableCandidates(ctx, pullRequest, team, teamMembers)
+		if err != nil {
+			return nil, err
+		}
+		if len(activeCandidates) < quota.Count {
+			return nil, domain.ErrNoCandidate
+		}
+
+		selectedReviewers, err := s.assignmentStrategy.SelectReviewers(ctx, team, toUserIDs(activeCandidates), quota.Count)
+		if err != nil {
+			return nil, err
+		}
+
+		addedReviewers := pullRequest.AddReviewers(selectedReviewers)
+		if len(addedReviewers) < quota.Count {
+			return nil, domain.ErrNoCandidate
 		}
 
-		if candidate.IsActive && candidate.ID != authorID && candidate.ID != reviewerID && !isAlreadyReviewer {
+		assignedAt := s.timeProvider.Now()
+		for _, reviewerID := range addedReviewers {
+			pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: reviewerID, SourceTeam: team.Name, AssignedAt: assignedAt})
+			addedAssignments = append(addedAssignments, pullRequest.Assignment(reviewerID))
+		}
+	}
+
+	return addedAssignments, nil
+}
+
+func (s *pullRequestService) checkMergePolicy(ctx context.Context, pullRequest *entities.PullRequest) error {
+	author, err := s.userRepository.GetByID(ctx, pullRequest.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	team, err := s.teamRepository.GetByName(ctx, author.Team)
+	if err != nil {
+		return err
+	}
+
+	unmetConditions := team.MergePolicy.UnmetConditions(pullRequest, team.LeadID)
+	if len(unmetConditions) == 0 {
+		return nil
+	}
+
+	conditions := make([]string, 0, len(unmetConditions))
+	for _, condition := range unmetConditions {
+		conditions = append(conditions, string(condition))
+	}
+
+	return &domain.MergeBlockedError{Conditions: conditions}
+}
+
+func (s *pullRequestService) filterAvailableCandidates(ctx context.Context, pullRequest *entities.PullRequest, team entities.Team, candidates []entities.User) ([]entities.User, error) {
+	var activeCandidates []entities.User
+
+	for _, candidate := range candidates {
+		if candidate.IsActive && candidate.ID != pullRequest.AuthorID && !pullRequest.IsReviewer(candidate.ID) {
 			activeCandidates = append(activeCandidates, candidate)
 		}
 	}
 
-	return activeCandidates
+	availableCandidates, err := excludeUnavailable(ctx, s.userRepository, s.timeProvider, activeCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	return excludeAtCapacity(ctx, s.pullRequestRepository, team, availableCandidates)
+}
+
+func checkExpectedVersion(pullRequest *entities.PullRequest, expectedVersion *int) error {
+	if expectedVersion != nil && *expectedVersion != pullRequest.Version {
+		return domain.ErrConcurrentModification
+	}
+
+	return nil
 }
 
 func toUserIDs(users []entities.User) []value_objects.UserID {
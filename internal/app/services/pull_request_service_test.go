@@ -8,12 +8,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"pr-service/internal/app"
+	"pr-service/internal/app/assignment"
 	"pr-service/internal/app/services/mocks"
 	"pr-service/internal/domain"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/memory"
 )
 
 func TestPullRequestService_Create(t *testing.T) {
@@ -57,8 +60,10 @@ func TestPullRequestService_Create(t *testing.T) {
 		pullRequestRepository.On("Create", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		result, err := service.Create(ctx, pullRequestID, pullRequestName, authorID)
+		expectAllAvailable(userRepository)
+		outboxRepository := memory.NewOutboxRepository(memory.NewStore())
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), outboxRepository, memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Create(ctx, pullRequestID, pullRequestName, authorID, CreateOptions{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -66,6 +71,14 @@ func TestPullRequestService_Create(t *testing.T) {
 		assert.Equal(t, pullRequestName, result.Name)
 		assert.Equal(t, authorID, result.AuthorID)
 		assert.Len(t, result.Reviewers(), 2)
+
+		entries, err := outboxRepository.GetPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, entities.EventPullRequestCreated, entries[0].Event.Type)
+		assert.Equal(t, "pull-request-1", entries[0].Event.AggregateID)
+		assert.Equal(t, entities.EventReviewerAssigned, entries[1].Event.Type)
+		assert.Equal(t, entities.EventReviewerAssigned, entries[2].Event.Type)
 	})
 
 	t.Run("fail when txManager is nil", func(t *testing.T) {
@@ -75,8 +88,8 @@ func TestPullRequestService_Create(t *testing.T) {
 		timeProvider := &mocks.TimeProvider{}
 		random := &mocks.RandomProvider{}
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, nil, timeProvider, random)
-		result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", "author1")
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, nil, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", "author1", CreateOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, app.ErrTransactionRequired))
@@ -97,8 +110,8 @@ func TestPullRequestService_Create(t *testing.T) {
 		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(existingPullRequest, nil)
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrPRExists)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		result, err := service.Create(ctx, pullRequestID, "Test Pull Request", "author1")
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Create(ctx, pullRequestID, "Test Pull Request", "author1", CreateOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, domain.ErrPRExists))
@@ -120,8 +133,8 @@ func TestPullRequestService_Create(t *testing.T) {
 		userRepository.On("GetByID", ctx, authorID).Return(entities.User{}, domain.ErrUserNotFound)
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrUserNotFound)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		result, err := service.Create(ctx, pullRequestID, "Test Pull Request", authorID)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Create(ctx, pullRequestID, "Test Pull Request", authorID, CreateOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, domain.ErrUserNotFound))
@@ -154,13 +167,379 @@ func TestPullRequestService_Create(t *testing.T) {
 		timeProvider.On("Now").Return(fixedTime)
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrNoCandidate)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		result, err := service.Create(ctx, pullRequestID, "Test Pull Request", authorID)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Create(ctx, pullRequestID, "Test Pull Request", authorID, CreateOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, domain.ErrNoCandidate))
 		assert.Nil(t, result)
 	})
+
+	t.Run("fail when author team is archived", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		pullRequestID := value_objects.PullRequestID("pull-request-1")
+		author := entities.User{ID: "author1", Team: "backend", IsActive: true}
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(nil, domain.ErrPRNotFound)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, author.Team).Return(entities.Team{Name: "backend", ArchivedAt: &fixedTime}, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Create(ctx, pullRequestID, "Test Pull Request", author.ID, CreateOptions{})
+
+		assert.ErrorIs(t, err, domain.ErrTeamArchived)
+		assert.Nil(t, result)
+		pullRequestRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPullRequestService_Create_ReviewersLimit(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	authorID := value_objects.UserID("author1")
+	author := entities.User{ID: authorID, Username: "author", Team: "security", IsActive: true}
+	teamMembers := []entities.User{
+		{ID: "user1", Username: "user1", Team: "security", IsActive: true},
+		{ID: "user2", Username: "user2", Team: "security", IsActive: true},
+		{ID: "user3", Username: "user3", Team: "security", IsActive: true},
+		{ID: "user4", Username: "user4", Team: "security", IsActive: true},
+		author,
+	}
+
+	newService := func(team entities.Team) PullRequestService {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+		random := &mocks.RandomProvider{}
+
+		pullRequestRepository.On("GetByID", ctx, mock.Anything).Return(nil, domain.ErrPRNotFound)
+		userRepository.On("GetByID", ctx, authorID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, author.Team).Return(team, nil)
+		userRepository.On("GetUsersByTeam", ctx, team.Name).Return(teamMembers, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("Create", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+		expectAllAvailable(userRepository)
+
+		return NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+	}
+
+	t.Run("use team default", func(t *testing.T) {
+		service := newService(entities.Team{Name: "security", ReviewersLimit: 3})
+
+		result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", authorID, CreateOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.ReviewersLimit)
+		assert.Len(t, result.Reviewers(), 3)
+		assert.True(t, result.IsFullyAssigned())
+	})
+
+	t.Run("per pull request override wins over team default", func(t *testing.T) {
+		service := newService(entities.Team{Name: "security", ReviewersLimit: 3})
+		reviewersCount := 1
+
+		result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", authorID, CreateOptions{ReviewersLimit: &reviewersCount})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.ReviewersLimit)
+		assert.Len(t, result.Reviewers(), 1)
+	})
+
+	t.Run("fall back to global default when team has none", func(t *testing.T) {
+		service := newService(entities.Team{Name: "security"})
+
+		result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", authorID, CreateOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.DefaultReviewersLimit, result.ReviewersLimit)
+		assert.Len(t, result.Reviewers(), entities.DefaultReviewersLimit)
+	})
+
+	t.Run("reject out of range override", func(t *testing.T) {
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		reviewersCount := 0
+
+		result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", authorID, CreateOptions{ReviewersLimit: &reviewersCount})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidReviewersCount)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPullRequestService_ParentTeamFallback(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	author := entities.User{ID: "author1", Team: "payments", IsActive: true}
+	payments := entities.Team{Name: "payments", Parent: "backend"}
+	backend := entities.Team{Name: "backend", Parent: "engineering"}
+	engineering := entities.Team{Name: "engineering"}
+
+	t.Run("fill remaining slots from parent teams", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+		random := &mocks.RandomProvider{}
+
+		pullRequestRepository.On("GetByID", ctx, value_objects.PullRequestID("pull-request-1")).Return(nil, domain.ErrPRNotFound)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, payments.Name).Return(payments, nil)
+		teamRepository.On("GetByName", ctx, backend.Name).Return(backend, nil)
+		teamRepository.On("GetByName", ctx, engineering.Name).Return(engineering, nil)
+		userRepository.On("GetUsersByTeam", ctx, payments.Name).Return([]entities.User{author}, nil)
+		userRepository.On("GetUsersByTeam", ctx, backend.Name).Return([]entities.User{{ID: "user1", Team: "backend", IsActive: true}}, nil)
+		userRepository.On("GetUsersByTeam", ctx, engineering.Name).Return([]entities.User{{ID: "user2", Team: "engineering", IsActive: true}}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("Create", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		expectAllAvailable(userRepository)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", author.ID, CreateOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []entities.ReviewerAssignment{
+			{ReviewerID: "user1", Level: 1, AssignedAt: fixedTime},
+			{ReviewerID: "user2", Level: 2, AssignedAt: fixedTime},
+		}, result.Assignments())
+	})
+
+	t.Run("reassign to parent team member when own team is exhausted", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		random := &mocks.RandomProvider{}
+
+		pullRequest := entities.NewPullRequest("pull-request-1", "Test Pull Request", author.ID, fixedTime)
+		pullRequest.AddReviewers([]value_objects.UserID{"reviewer1"})
+
+		pullRequestRepository.On("GetByID", ctx, pullRequest.ID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, value_objects.UserID("reviewer1")).Return(entities.User{ID: "reviewer1", Team: "payments", IsActive: true}, nil)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, payments.Name).Return(payments, nil)
+		teamRepository.On("GetByName", ctx, backend.Name).Return(backend, nil)
+		teamRepository.On("GetByName", ctx, engineering.Name).Return(engineering, nil)
+		userRepository.On("GetUsersByTeam", ctx, payments.Name).Return([]entities.User{author, {ID: "reviewer1", Team: "payments", IsActive: true}}, nil)
+		userRepository.On("GetUsersByTeam", ctx, backend.Name).Return([]entities.User{{ID: "user1", Team: "backend", IsActive: true}}, nil)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		pullRequestRepository.On("ReassignReviewer", ctx, pullRequest.ID, value_objects.UserID("reviewer1"), entities.ReviewerAssignment{ReviewerID: "user1", Level: 1, AssignedAt: fixedTime}).Return(nil)
+		pullRequestRepository.On("AddReviewerChange", ctx, mock.AnythingOfType("entities.ReviewerChange")).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		timeProvider := &mocks.TimeProvider{}
+		timeProvider.On("Now").Return(fixedTime)
+		expectAllAvailable(userRepository)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, newReviewerID, err := service.ReassignReviewer(ctx, pullRequest.ID, "reviewer1", ReassignOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, value_objects.UserID("user1"), newReviewerID)
+		assert.Equal(t, 1, result.Assignment("user1").Level)
+		userRepository.AssertNotCalled(t, "GetUsersByTeam", mock.Anything, engineering.Name)
+	})
+}
+
+func TestPullRequestService_BorrowedReviewers(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	author := entities.User{ID: "author1", Team: "backend", IsActive: true}
+	backend := entities.Team{Name: "backend"}
+	platform := entities.Team{Name: "platform"}
+
+	t.Run("assign quota from other team alongside teammates", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+		random := &mocks.RandomProvider{}
+
+		pullRequestRepository.On("GetByID", ctx, value_objects.PullRequestID("pull-request-1")).Return(nil, domain.ErrPRNotFound)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, backend.Name).Return(backend, nil)
+		teamRepository.On("GetByName", ctx, platform.Name).Return(platform, nil)
+		userRepository.On("GetUsersByTeam", ctx, backend.Name).Return([]entities.User{
+			author,
+			{ID: "user1", Team: "backend", IsActive: true},
+			{ID: "user2", Team: "backend", IsActive: true},
+		}, nil)
+		userRepository.On("GetUsersByTeam", ctx, platform.Name).Return([]entities.User{{ID: "platform1", Team: "platform", IsActive: true}}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("Create", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		expectAllAvailable(userRepository)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", author.ID, CreateOptions{
+			BorrowedReviewers: []entities.ReviewerQuota{{TeamName: "platform", Count: 1}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.MaxReviewers())
+		assert.Equal(t, []entities.ReviewerAssignment{
+			{ReviewerID: "platform1", SourceTeam: "platform", AssignedAt: fixedTime},
+			{ReviewerID: "user1", AssignedAt: fixedTime},
+			{ReviewerID: "user2", AssignedAt: fixedTime},
+		}, result.Assignments())
+	})
+
+	t.Run("fail when other team can not fill its quota", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+
+		pullRequestRepository.On("GetByID", ctx, value_objects.PullRequestID("pull-request-1")).Return(nil, domain.ErrPRNotFound)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, backend.Name).Return(backend, nil)
+		teamRepository.On("GetByName", ctx, platform.Name).Return(platform, nil)
+		userRepository.On("GetUsersByTeam", ctx, platform.Name).Return([]entities.User{{ID: "platform1", Team: "platform", IsActive: false}}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.Create(ctx, "pull-request-1", "Test Pull Request", author.ID, CreateOptions{
+			BorrowedReviewers: []entities.ReviewerQuota{{TeamName: "platform", Count: 1}},
+		})
+
+		assert.ErrorIs(t, err, domain.ErrNoCandidate)
+		pullRequestRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("fail when quota is invalid", func(t *testing.T) {
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+
+		_, err := service.Create(ctx, "pull-request-1", "Test Pull Request", author.ID, CreateOptions{
+			BorrowedReviewers: []entities.ReviewerQuota{{TeamName: "platform", Count: 0}},
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidReviewerQuota)
+	})
+
+	t.Run("keep quota of draft until it becomes ready", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+
+		pullRequestRepository.On("GetByID", ctx, value_objects.PullRequestID("pull-request-1")).Return(nil, domain.ErrPRNotFound)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, backend.Name).Return(backend, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		pullRequestRepository.On("Create", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", author.ID, CreateOptions{
+			Draft:             true,
+			BorrowedReviewers: []entities.ReviewerQuota{{TeamName: "platform", Count: 1}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusDraft, result.Status)
+		assert.Equal(t, 3, result.MaxReviewers())
+		assert.Empty(t, result.Reviewers())
+		assert.Equal(t, []entities.ReviewerQuota{{TeamName: "platform", Count: 1}}, result.BorrowedReviewers)
+		userRepository.AssertNotCalled(t, "GetUsersByTeam", mock.Anything, platform.Name)
+	})
+
+	t.Run("borrow quota when draft becomes ready", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+		random := &mocks.RandomProvider{}
+
+		pullRequest := entities.NewPullRequest("pull-request-1", "Test Pull Request", author.ID, fixedTime)
+		pullRequest.Status = entities.StatusDraft
+		pullRequest.ReviewersLimit = 3
+		pullRequest.BorrowedReviewers = []entities.ReviewerQuota{{TeamName: "platform", Count: 1}}
+
+		pullRequestRepository.On("GetByID", ctx, pullRequest.ID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, backend.Name).Return(backend, nil)
+		teamRepository.On("GetByName", ctx, platform.Name).Return(platform, nil)
+		userRepository.On("GetUsersByTeam", ctx, backend.Name).Return([]entities.User{
+			author,
+			{ID: "user1", Team: "backend", IsActive: true},
+			{ID: "user2", Team: "backend", IsActive: true},
+		}, nil)
+		userRepository.On("GetUsersByTeam", ctx, platform.Name).Return([]entities.User{{ID: "platform1", Team: "platform", IsActive: true}}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("RemoveReviewerQuotas", ctx, pullRequest.ID).Return(nil)
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		pullRequestRepository.On("AddReviewers", ctx, pullRequest.ID, []entities.ReviewerAssignment{
+			{ReviewerID: "platform1", SourceTeam: "platform", AssignedAt: fixedTime},
+			{ReviewerID: "user1", AssignedAt: fixedTime},
+			{ReviewerID: "user2", AssignedAt: fixedTime},
+		}).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		expectAllAvailable(userRepository)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.MarkReady(ctx, pullRequest.ID, TransitionOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusOpen, result.Status)
+		assert.Empty(t, result.BorrowedReviewers)
+		pullRequestRepository.AssertExpectations(t)
+	})
+
+	t.Run("reassign borrowed slot within its source team", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		random := &mocks.RandomProvider{}
+
+		pullRequest := entities.NewPullRequest("pull-request-1", "Test Pull Request", author.ID, fixedTime)
+		pullRequest.AddReviewers([]value_objects.UserID{"platform1", "user1"})
+		pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: "platform1", SourceTeam: "platform"})
+
+		pullRequestRepository.On("GetByID", ctx, pullRequest.ID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, value_objects.UserID("platform1")).Return(entities.User{ID: "platform1", Team: "platform", IsActive: true}, nil)
+		teamRepository.On("GetByName", ctx, platform.Name).Return(platform, nil)
+		userRepository.On("GetUsersByTeam", ctx, platform.Name).Return([]entities.User{
+			{ID: "platform1", Team: "platform", IsActive: true},
+			{ID: "platform2", Team: "platform", IsActive: true},
+		}, nil)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		pullRequestRepository.On("ReassignReviewer", ctx, pullRequest.ID, value_objects.UserID("platform1"), entities.ReviewerAssignment{ReviewerID: "platform2", SourceTeam: "platform", AssignedAt: fixedTime}).Return(nil)
+		pullRequestRepository.On("AddReviewerChange", ctx, mock.AnythingOfType("entities.ReviewerChange")).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		timeProvider := &mocks.TimeProvider{}
+		timeProvider.On("Now").Return(fixedTime)
+		expectAllAvailable(userRepository)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, newReviewerID, err := service.ReassignReviewer(ctx, pullRequest.ID, "platform1", ReassignOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, value_objects.UserID("platform2"), newReviewerID)
+		assert.Equal(t, value_objects.TeamName("platform"), result.Assignment("platform2").SourceTeam)
+		userRepository.AssertNotCalled(t, "GetUsersByTeam", mock.Anything, backend.Name)
+	})
 }
 
 func TestPullRequestService_Merge(t *testing.T) {
@@ -183,15 +562,36 @@ func TestPullRequestService_Merge(t *testing.T) {
 		}
 
 		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, pullRequest.AuthorID).Return(entities.User{ID: pullRequest.AuthorID, Team: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
 		timeProvider.On("Now").Return(fixedTime)
 		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		result, err := service.Merge(ctx, pullRequestID)
+		outboxRepository := memory.NewOutboxRepository(memory.NewStore())
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		auditRepository := memory.NewAuditRepository(memory.NewStore())
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), outboxRepository, auditRepository)
+		result, err := service.Merge(ctx, pullRequestID, MergeOptions{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.Equal(t, entities.StatusMerged, result.Status)
+
+		entries, err := outboxRepository.GetPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, entities.EventPullRequestMerged, entries[0].Event.Type)
+		assert.Equal(t, fixedTime, entries[0].Event.OccurredAt)
+
+		auditEntries, err := auditRepository.Find(ctx, entities.AuditQuery{EntityID: string(pullRequestID)})
+		require.NoError(t, err)
+		require.Len(t, auditEntries, 1)
+		assert.Equal(t, entities.AuditPullRequestMerged, auditEntries[0].Action)
+		assert.Equal(t, entities.SystemActor, auditEntries[0].Actor)
+		assert.Equal(t, "OPEN", auditEntries[0].Before["status"])
+		assert.Equal(t, "MERGED", auditEntries[0].After["status"])
 	})
 
 	t.Run("fail when pull request not found", func(t *testing.T) {
@@ -206,8 +606,10 @@ func TestPullRequestService_Merge(t *testing.T) {
 
 		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(nil, domain.ErrPRNotFound)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		result, err := service.Merge(ctx, pullRequestID)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Merge(ctx, pullRequestID, MergeOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, domain.ErrPRNotFound))
@@ -230,20 +632,84 @@ func TestPullRequestService_Merge(t *testing.T) {
 		}
 
 		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, pullRequest.AuthorID).Return(entities.User{ID: pullRequest.AuthorID, Team: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
 		timeProvider.On("Now").Return(fixedTime)
 		pullRequestRepository.On("Save", ctx, pullRequest).Return(errors.New("save error"))
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		result, err := service.Merge(ctx, pullRequestID)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Merge(ctx, pullRequestID, MergeOptions{})
 
 		assert.Error(t, err)
 		assert.Equal(t, "save error", err.Error())
 		assert.Nil(t, result)
 	})
+
+	t.Run("return merged pull request without saving it again", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		pullRequestID := value_objects.PullRequestID("pull-request-1")
+		mergedAt := fixedTime.Add(-time.Hour)
+		pullRequest := &entities.PullRequest{
+			ID:       pullRequestID,
+			Name:     "Test Pull Request",
+			Status:   entities.StatusMerged,
+			MergedAt: &mergedAt,
+			Version:  2,
+		}
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Merge(ctx, pullRequestID, MergeOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Version)
+		assert.Equal(t, &mergedAt, result.MergedAt)
+		pullRequestRepository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPullRequestService_Merge_VersionMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	userRepository := &mocks.UserRepository{}
+	teamRepository := &mocks.TeamRepository{}
+	pullRequestRepository := &mocks.PullRequestRepository{}
+	txManager := &mocks.TxManager{}
+	timeProvider := &mocks.TimeProvider{}
+	random := &mocks.RandomProvider{}
+
+	pullRequestID := value_objects.PullRequestID("pull-request-1")
+	pullRequest := &entities.PullRequest{
+		ID:      pullRequestID,
+		Name:    "Test Pull Request",
+		Status:  entities.StatusOpen,
+		Version: 3,
+	}
+	staleVersion := 2
+
+	pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+
+	txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+	service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+	result, err := service.Merge(ctx, pullRequestID, MergeOptions{ExpectedVersion: &staleVersion})
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, domain.ErrConcurrentModification))
+	assert.Nil(t, result)
+	assert.Equal(t, entities.StatusOpen, pullRequest.Status)
+	pullRequestRepository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
 }
 
 func TestPullRequestService_ReassignReviewer(t *testing.T) {
 	ctx := context.Background()
+	fixedTime := time.Now()
 
 	t.Run("successfully reassign reviewer", func(t *testing.T) {
 		userRepository := &mocks.UserRepository{}
@@ -266,14 +732,6 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
 		}
 		initialPullRequest.AddReviewers([]value_objects.UserID{oldReviewerID})
 
-		updatedPullRequest := &entities.PullRequest{
-			ID:       pullRequestID,
-			Name:     "Test Pull Request",
-			AuthorID: authorID,
-			Status:   entities.StatusOpen,
-		}
-		updatedPullRequest.AddReviewers([]value_objects.UserID{newReviewerID})
-
 		author := entities.User{
 			ID:       authorID,
 			Username: "author",
@@ -300,22 +758,31 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
 		userRepository.On("GetByID", ctx, authorID).Return(author, nil)
 		teamRepository.On("GetByName", ctx, author.Team).Return(team, nil)
 		userRepository.On("GetUsersByTeam", ctx, team.Name).Return(teamMembers, nil)
-		random.On("Intn", 2).Return(0)
-
-		pullRequestRepository.On("ReassignReviewer", ctx, pullRequestID, oldReviewerID, newReviewerID).Return(nil)
+		random.On("Shuffle", 2, mock.AnythingOfType("func(int, int)"))
 
-		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(updatedPullRequest, nil).Once()
+		pullRequestRepository.On("Save", ctx, initialPullRequest).Return(nil)
+		pullRequestRepository.On("ReassignReviewer", ctx, pullRequestID, oldReviewerID, entities.ReviewerAssignment{ReviewerID: newReviewerID, AssignedAt: fixedTime}).Return(nil)
+		pullRequestRepository.On("AddReviewerChange", ctx, mock.AnythingOfType("entities.ReviewerChange")).Return(nil)
 
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, oldReviewerID)
+		timeProvider.On("Now").Return(fixedTime)
+		expectAllAvailable(userRepository)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, oldReviewerID, ReassignOptions{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resultPullRequest)
 		assert.Equal(t, newReviewerID, resultReviewer)
 		assert.Contains(t, resultPullRequest.Reviewers(), newReviewerID)
 		assert.NotContains(t, resultPullRequest.Reviewers(), oldReviewerID)
+		pullRequestRepository.AssertCalled(t, "AddReviewerChange", ctx, entities.ReviewerChange{
+			PullRequestID: pullRequestID,
+			OldReviewerID: oldReviewerID,
+			NewReviewerID: newReviewerID,
+			Reason:        entities.ReviewerChangeManual,
+			ChangedAt:     fixedTime,
+		})
 	})
 
 	t.Run("fail when txManager is nil", func(t *testing.T) {
@@ -325,8 +792,8 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
 		timeProvider := &mocks.TimeProvider{}
 		random := &mocks.RandomProvider{}
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, nil, timeProvider, random)
-		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, "pull-request-1", "reviewer1")
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, nil, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, "pull-request-1", "reviewer1", ReassignOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, app.ErrTransactionRequired))
@@ -347,8 +814,8 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
 		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(nil, domain.ErrPRNotFound)
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrPRNotFound)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, "reviewer1")
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, "reviewer1", ReassignOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, domain.ErrPRNotFound))
@@ -374,8 +841,8 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
 		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrPRMerged)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, "reviewer1")
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, "reviewer1", ReassignOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, domain.ErrPRMerged))
@@ -402,8 +869,8 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
 		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrNotAssigned)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, "reviewer1")
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, "reviewer1", ReassignOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, domain.ErrNotAssigned))
@@ -411,6 +878,36 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
 		assert.Equal(t, value_objects.UserID(""), resultReviewer)
 	})
 
+	t.Run("fail when expected version is stale", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+		random := &mocks.RandomProvider{}
+
+		pullRequestID := value_objects.PullRequestID("pull-request-1")
+		pullRequest := &entities.PullRequest{
+			ID:      pullRequestID,
+			Name:    "Test Pull Request",
+			Status:  entities.StatusOpen,
+			Version: 5,
+		}
+		pullRequest.AddReviewers([]value_objects.UserID{"reviewer1"})
+		staleVersion := 4
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, "reviewer1", ReassignOptions{ExpectedVersion: &staleVersion})
+
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, domain.ErrConcurrentModification))
+		assert.Nil(t, resultPullRequest)
+		assert.Equal(t, value_objects.UserID(""), resultReviewer)
+	})
+
 	t.Run("fail when no candidates available", func(t *testing.T) {
 		userRepository := &mocks.UserRepository{}
 		teamRepository := &mocks.TeamRepository{}
@@ -446,8 +943,8 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
 		userRepository.On("GetUsersByTeam", ctx, team.Name).Return(teamMembers, nil)
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrNoCandidate)
 
-		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, random)
-		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, oldReviewerID)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultPullRequest, resultReviewer, err := service.ReassignReviewer(ctx, pullRequestID, oldReviewerID, ReassignOptions{})
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, domain.ErrNoCandidate))
@@ -455,3 +952,929 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
 		assert.Equal(t, value_objects.UserID(""), resultReviewer)
 	})
 }
+
+func TestPullRequestService_SubmitReview(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	pullRequestID := value_objects.PullRequestID("pull-request-1")
+	reviewerID := value_objects.UserID("reviewer1")
+
+	newPullRequest := func() *entities.PullRequest {
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", "author1", fixedTime)
+		pullRequest.AddReviewers([]value_objects.UserID{reviewerID, "reviewer2"})
+
+		return pullRequest
+	}
+
+	t.Run("successfully approve", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+
+		pullRequest := newPullRequest()
+		expectedReview := entities.Review{ReviewerID: reviewerID, Decision: entities.DecisionApproved, DecidedAt: &fixedTime}
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, reviewerID).Return(entities.User{ID: reviewerID}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		pullRequestRepository.On("SaveReview", ctx, pullRequestID, expectedReview).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(userRepository, &mocks.TeamRepository{}, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.SubmitReview(ctx, pullRequestID, reviewerID, entities.DecisionApproved, ReviewOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, expectedReview, result.Review(reviewerID))
+		assert.Equal(t, entities.DecisionPending, result.ReviewDecision())
+		pullRequestRepository.AssertExpectations(t)
+	})
+
+	t.Run("fail when reviewer is not assigned", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(newPullRequest(), nil)
+		userRepository.On("GetByID", ctx, value_objects.UserID("stranger")).Return(entities.User{ID: "stranger"}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrNotAssigned)
+
+		service := NewPullRequestService(userRepository, &mocks.TeamRepository{}, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.SubmitReview(ctx, pullRequestID, "stranger", entities.DecisionApproved, ReviewOptions{})
+
+		assert.ErrorIs(t, err, domain.ErrNotAssigned)
+		assert.Nil(t, result)
+		pullRequestRepository.AssertNotCalled(t, "SaveReview", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("fail on stale version", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(newPullRequest(), nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrConcurrentModification)
+
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		staleVersion := 7
+		_, err := service.SubmitReview(ctx, pullRequestID, reviewerID, entities.DecisionApproved, ReviewOptions{ExpectedVersion: &staleVersion})
+
+		assert.ErrorIs(t, err, domain.ErrConcurrentModification)
+		pullRequestRepository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPullRequestService_Merge_Policy(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	pullRequestID := value_objects.PullRequestID("pull-request-1")
+	authorID := value_objects.UserID("author1")
+	team := entities.Team{
+		Name:        "backend",
+		LeadID:      "lead",
+		MergePolicy: entities.MergePolicy{MinApprovals: 2, RequireLeadApproval: true},
+	}
+
+	setup := func(pullRequest *entities.PullRequest) (PullRequestService, *mocks.PullRequestRepository) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		timeProvider := &mocks.TimeProvider{}
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, authorID).Return(entities.User{ID: authorID, Team: team.Name}, nil)
+		teamRepository.On("GetByName", ctx, team.Name).Return(team, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		txManager := &mocks.TxManager{}
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		return NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore())), pullRequestRepository
+	}
+
+	newPullRequest := func() *entities.PullRequest {
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", authorID, fixedTime)
+		pullRequest.ReviewersLimit = 3
+		pullRequest.AddReviewers([]value_objects.UserID{"lead", "user1", "user2"})
+
+		return pullRequest
+	}
+
+	t.Run("block and list every unmet condition", func(t *testing.T) {
+		pullRequest := newPullRequest()
+		_, err := pullRequest.SubmitReview("user1", entities.DecisionChangesRequested, fixedTime)
+		require.NoError(t, err)
+
+		service, pullRequestRepository := setup(pullRequest)
+		result, err := service.Merge(ctx, pullRequestID, MergeOptions{})
+
+		require.ErrorIs(t, err, domain.ErrMergeBlocked)
+		var mergeBlockedErr *domain.MergeBlockedError
+		require.True(t, errors.As(err, &mergeBlockedErr))
+		assert.Equal(t, []string{"MIN_APPROVALS", "NO_CHANGES_REQUESTED", "LEAD_APPROVAL"}, mergeBlockedErr.Conditions)
+		assert.Nil(t, result)
+		pullRequestRepository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("merge when policy is satisfied", func(t *testing.T) {
+		pullRequest := newPullRequest()
+		_, err := pullRequest.SubmitReview("lead", entities.DecisionApproved, fixedTime)
+		require.NoError(t, err)
+		_, err = pullRequest.SubmitReview("user1", entities.DecisionApproved, fixedTime)
+		require.NoError(t, err)
+
+		service, _ := setup(pullRequest)
+		result, err := service.Merge(ctx, pullRequestID, MergeOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusMerged, result.Status)
+		assert.False(t, result.ForceMerged)
+	})
+
+	t.Run("force bypasses policy and is recorded", func(t *testing.T) {
+		pullRequest := newPullRequest()
+
+		service, _ := setup(pullRequest)
+		result, err := service.Merge(ctx, pullRequestID, MergeOptions{Force: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusMerged, result.Status)
+		assert.True(t, result.ForceMerged)
+	})
+}
+
+func TestPullRequestService_Merge_LeadApprovalWithoutRandomSelection(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	store := memory.NewStore()
+	userRepository := memory.NewUserRepository(store)
+	teamRepository := memory.NewTeamRepository(store)
+	pullRequestRepository := memory.NewPullRequestRepository(store)
+
+	_, err := teamRepository.Create(ctx, entities.Team{
+		Name:           "backend",
+		ReviewersLimit: 2,
+		LeadID:         "z-lead",
+		MergePolicy:    entities.MergePolicy{MinApprovals: 2, RequireLeadApproval: true},
+	})
+	require.NoError(t, err)
+	require.NoError(t, userRepository.UpsertMembers(ctx, "backend", []entities.User{
+		{ID: "author", Username: "Author", IsActive: true},
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "z-lead", Username: "Lead", IsActive: true},
+	}))
+
+	random := &mocks.RandomProvider{}
+	random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+	timeProvider := &mocks.TimeProvider{}
+	timeProvider.On("Now").Return(createdAt)
+
+	service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, memory.NewTxManager(store), timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+
+	created, err := service.Create(ctx, "pr-1", "Add search", "author", CreateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []value_objects.UserID{"z-lead", "u1"}, created.Reviewers())
+
+	_, err = service.SubmitReview(ctx, "pr-1", "u1", entities.DecisionApproved, ReviewOptions{})
+	require.NoError(t, err)
+	_, err = service.SubmitReview(ctx, "pr-1", "z-lead", entities.DecisionApproved, ReviewOptions{})
+	require.NoError(t, err)
+
+	merged, err := service.Merge(ctx, "pr-1", MergeOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusMerged, merged.Status)
+	assert.False(t, merged.ForceMerged)
+}
+
+func TestPullRequestService_Create_Draft(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	userRepository := &mocks.UserRepository{}
+	teamRepository := &mocks.TeamRepository{}
+	pullRequestRepository := &mocks.PullRequestRepository{}
+	txManager := &mocks.TxManager{}
+	timeProvider := &mocks.TimeProvider{}
+
+	pullRequestID := value_objects.PullRequestID("pull-request-1")
+	authorID := value_objects.UserID("author1")
+	author := entities.User{ID: authorID, Username: "author", Team: "backend", IsActive: true}
+
+	pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(nil, domain.ErrPRNotFound)
+	userRepository.On("GetByID", ctx, authorID).Return(author, nil)
+	teamRepository.On("GetByName", ctx, author.Team).Return(entities.Team{Name: "backend"}, nil)
+	timeProvider.On("Now").Return(fixedTime)
+	pullRequestRepository.On("Create", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
+	txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+	service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+	result, err := service.Create(ctx, pullRequestID, "Draft Pull Request", authorID, CreateOptions{Draft: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusDraft, result.Status)
+	assert.Empty(t, result.Reviewers())
+	userRepository.AssertNotCalled(t, "GetUsersByTeam", mock.Anything, mock.Anything)
+}
+
+func TestPullRequestService_MarkReady(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	pullRequestID := value_objects.PullRequestID("pull-request-1")
+	authorID := value_objects.UserID("author1")
+	author := entities.User{ID: authorID, Username: "author", Team: "backend", IsActive: true}
+	team := entities.Team{Name: "backend"}
+
+	newDraft := func() *entities.PullRequest {
+		pullRequest := entities.NewPullRequest(pullRequestID, "Draft Pull Request", authorID, fixedTime)
+		pullRequest.Status = entities.StatusDraft
+
+		return pullRequest
+	}
+
+	t.Run("assign reviewers when draft becomes ready", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		random := &mocks.RandomProvider{}
+
+		teamMembers := []entities.User{
+			author,
+			{ID: "user1", Username: "user1", Team: "backend", IsActive: true},
+			{ID: "user2", Username: "user2", Team: "backend", IsActive: true},
+		}
+		pullRequest := newDraft()
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, authorID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, author.Team).Return(team, nil)
+		userRepository.On("GetUsersByTeam", ctx, team.Name).Return(teamMembers, nil)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		pullRequestRepository.On("AddReviewers", ctx, pullRequestID, mock.AnythingOfType("[]entities.ReviewerAssignment")).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		timeProvider := &mocks.TimeProvider{}
+		timeProvider.On("Now").Return(fixedTime)
+		expectAllAvailable(userRepository)
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.MarkReady(ctx, pullRequestID, TransitionOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusOpen, result.Status)
+		assert.ElementsMatch(t, []value_objects.UserID{"user1", "user2"}, result.Reviewers())
+		pullRequestRepository.AssertExpectations(t)
+	})
+
+	t.Run("fail when pull request is not a draft", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).
+			Return(entities.NewPullRequest(pullRequestID, "Open Pull Request", authorID, fixedTime), nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(domain.ErrInvalidTransition)
+
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.MarkReady(ctx, pullRequestID, TransitionOptions{})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidTransition)
+		assert.Nil(t, result)
+		pullRequestRepository.AssertNotCalled(t, "AddReviewers", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPullRequestService_CloseAndReopen(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	pullRequestID := value_objects.PullRequestID("pull-request-1")
+
+	t.Run("close open pull request", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		timeProvider := &mocks.TimeProvider{}
+
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", "author1", fixedTime)
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		txManager := &mocks.TxManager{}
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Close(ctx, pullRequestID, TransitionOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusClosed, result.Status)
+		assert.Equal(t, &fixedTime, result.ClosedAt)
+	})
+
+	t.Run("reopen closed pull request", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", "author1", fixedTime)
+		require.NoError(t, pullRequest.Close(fixedTime))
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		txManager := &mocks.TxManager{}
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Reopen(ctx, pullRequestID, TransitionOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusOpen, result.Status)
+		assert.Nil(t, result.ClosedAt)
+	})
+
+	t.Run("return error when txManager is nil", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, pullRequestRepository, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Close(ctx, pullRequestID, TransitionOptions{})
+
+		assert.ErrorIs(t, err, app.ErrTransactionRequired)
+		assert.Nil(t, result)
+		pullRequestRepository.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("fail to merge closed pull request", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", "author1", fixedTime)
+		require.NoError(t, pullRequest.Close(fixedTime))
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		txManager := &mocks.TxManager{}
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.Merge(ctx, pullRequestID, MergeOptions{})
+
+		assert.ErrorIs(t, err, domain.ErrPRNotOpen)
+		assert.Nil(t, result)
+		pullRequestRepository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPullRequestService_Create_SkipsReviewersAtCapacity(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	author := entities.User{ID: "author1", Username: "author", Team: "backend", IsActive: true}
+	team := entities.Team{Name: "backend", ReviewersLimit: 2, MaxOpenReviews: 3}
+	teamMembers := []entities.User{
+		author,
+		{ID: "user1", Username: "user1", Team: "backend", IsActive: true},
+		{ID: "user2", Username: "user2", Team: "backend", IsActive: true, MaxOpenReviews: 1},
+		{ID: "user3", Username: "user3", Team: "backend", IsActive: true},
+	}
+
+	userRepository := &mocks.UserRepository{}
+	teamRepository := &mocks.TeamRepository{}
+	pullRequestRepository := &mocks.PullRequestRepository{}
+	txManager := &mocks.TxManager{}
+	timeProvider := &mocks.TimeProvider{}
+	random := &mocks.RandomProvider{}
+
+	pullRequestRepository.On("GetByID", ctx, mock.Anything).Return(nil, domain.ErrPRNotFound)
+	userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+	teamRepository.On("GetByName", ctx, team.Name).Return(team, nil)
+	userRepository.On("GetUsersByTeam", ctx, team.Name).Return(teamMembers, nil)
+	pullRequestRepository.On("CountOpenReviews", ctx, []value_objects.UserID{"user1", "user2", "user3"}).
+		Return(map[value_objects.UserID]int{"user1": 3, "user2": 1, "user3": 2}, nil)
+	timeProvider.On("Now").Return(fixedTime)
+	random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+	pullRequestRepository.On("Create", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
+	txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+	expectAllAvailable(userRepository)
+
+	service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+	result, err := service.Create(ctx, "pull-request-1", "Test Pull Request", author.ID, CreateOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []value_objects.UserID{"user3"}, result.Reviewers())
+	assert.False(t, result.IsFullyAssigned())
+}
+
+func TestPullRequestService_ReassignReviewer_ToChosenReviewer(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	pullRequestID := value_objects.PullRequestID("pull-request-1")
+	author := entities.User{ID: "author1", Username: "author", Team: "backend", IsActive: true}
+	oldReviewer := entities.User{ID: "reviewer1", Username: "reviewer1", Team: "backend", IsActive: true}
+	otherReviewer := entities.User{ID: "reviewer2", Username: "reviewer2", Team: "backend", IsActive: true}
+
+	tests := []struct {
+		name             string
+		newReviewer      entities.User
+		unavailable      bool
+		openReviews      int
+		expectedError    error
+		expectedReviewer value_objects.UserID
+	}{
+		{
+			name:             "reassign to chosen team member",
+			newReviewer:      entities.User{ID: "user3", Username: "user3", Team: "backend", IsActive: true},
+			expectedReviewer: "user3",
+		},
+		{
+			name:          "reject inactive reviewer",
+			newReviewer:   entities.User{ID: "user3", Username: "user3", Team: "backend", IsActive: false},
+			expectedError: domain.ErrReviewerNotEligible,
+		},
+		{
+			name:          "reject reviewer outside allowed teams",
+			newReviewer:   entities.User{ID: "user3", Username: "user3", Team: "frontend", IsActive: true},
+			expectedError: domain.ErrReviewerNotEligible,
+		},
+		{
+			name:          "reject unavailable reviewer",
+			newReviewer:   entities.User{ID: "user3", Username: "user3", Team: "backend", IsActive: true},
+			unavailable:   true,
+			expectedError: domain.ErrReviewerNotEligible,
+		},
+		{
+			name:          "reject reviewer at capacity",
+			newReviewer:   entities.User{ID: "user3", Username: "user3", Team: "backend", IsActive: true, MaxOpenReviews: 2},
+			openReviews:   2,
+			expectedError: domain.ErrReviewerNotEligible,
+		},
+		{
+			name:             "reassign to reviewer below capacity",
+			newReviewer:      entities.User{ID: "user3", Username: "user3", Team: "backend", IsActive: true, MaxOpenReviews: 2},
+			openReviews:      1,
+			expectedReviewer: "user3",
+		},
+		{
+			name:          "reject author",
+			newReviewer:   author,
+			expectedError: domain.ErrReviewerNotEligible,
+		},
+		{
+			name:          "reject already assigned reviewer",
+			newReviewer:   otherReviewer,
+			expectedError: domain.ErrReviewerNotEligible,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepository := &mocks.UserRepository{}
+			teamRepository := &mocks.TeamRepository{}
+			pullRequestRepository := &mocks.PullRequestRepository{}
+			txManager := &mocks.TxManager{}
+			timeProvider := &mocks.TimeProvider{}
+
+			pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", author.ID, fixedTime)
+			pullRequest.AddReviewers([]value_objects.UserID{oldReviewer.ID, otherReviewer.ID})
+
+			pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+			userRepository.On("GetByID", ctx, oldReviewer.ID).Return(oldReviewer, nil)
+			userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+			userRepository.On("GetByID", ctx, otherReviewer.ID).Return(otherReviewer, nil)
+			userRepository.On("GetByID", ctx, tt.newReviewer.ID).Return(tt.newReviewer, nil)
+			userRepository.On("GetUnavailableUserIDs", ctx, []value_objects.UserID{tt.newReviewer.ID}, fixedTime).
+				Return(map[value_objects.UserID]bool{tt.newReviewer.ID: tt.unavailable}, nil)
+			pullRequestRepository.On("CountOpenReviews", ctx, []value_objects.UserID{tt.newReviewer.ID}).
+				Return(map[value_objects.UserID]int{tt.newReviewer.ID: tt.openReviews}, nil)
+			teamRepository.On("GetByName", ctx, author.Team).Return(entities.Team{Name: "backend"}, nil)
+			pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+			pullRequestRepository.On("ReassignReviewer", ctx, pullRequestID, oldReviewer.ID, entities.ReviewerAssignment{ReviewerID: tt.newReviewer.ID, AssignedAt: fixedTime}).Return(nil)
+			pullRequestRepository.On("AddReviewerChange", ctx, mock.AnythingOfType("entities.ReviewerChange")).Return(nil)
+			timeProvider.On("Now").Return(fixedTime)
+			txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+			service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+			newReviewerID := tt.newReviewer.ID
+			result, replacedBy, err := service.ReassignReviewer(ctx, pullRequestID, oldReviewer.ID, ReassignOptions{NewReviewerID: &newReviewerID})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				pullRequestRepository.AssertNotCalled(t, "ReassignReviewer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReviewer, replacedBy)
+			assert.ElementsMatch(t, []value_objects.UserID{otherReviewer.ID, tt.expectedReviewer}, result.Reviewers())
+			userRepository.AssertNotCalled(t, "GetUsersByTeam", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPullRequestService_AssignReviewers(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	pullRequestID := value_objects.PullRequestID("pull-request-1")
+	author := entities.User{ID: "author1", Username: "author", Team: "backend", IsActive: true}
+	team := entities.Team{Name: "backend"}
+
+	t.Run("fill empty slots", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+		random := &mocks.RandomProvider{}
+
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", author.ID, time.Now())
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, team.Name).Return(team, nil)
+		userRepository.On("GetUsersByTeam", ctx, team.Name).Return([]entities.User{
+			author,
+			{ID: "user1", Team: "backend", IsActive: true},
+			{ID: "user2", Team: "backend", IsActive: true},
+		}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		pullRequestRepository.On("AddReviewers", ctx, pullRequestID, []entities.ReviewerAssignment{{ReviewerID: "user2", AssignedAt: fixedTime}}).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+		expectAllAvailable(userRepository)
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.AssignReviewers(ctx, pullRequestID, TransitionOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user1", "user2"}, result.Reviewers())
+		pullRequestRepository.AssertExpectations(t)
+	})
+
+	t.Run("no candidate for empty slot", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", author.ID, time.Now())
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		teamRepository.On("GetByName", ctx, team.Name).Return(team, nil)
+		userRepository.On("GetUsersByTeam", ctx, team.Name).Return([]entities.User{author, {ID: "user1", Team: "backend", IsActive: true}}, nil)
+		timeProvider.On("Now").Return(time.Now())
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+		expectAllAvailable(userRepository)
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.AssignReviewers(ctx, pullRequestID, TransitionOptions{})
+
+		assert.ErrorIs(t, err, domain.ErrNoCandidate)
+		pullRequestRepository.AssertNotCalled(t, "AddReviewers", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("fully assigned pull request is left unchanged", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", author.ID, time.Now())
+		pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2"})
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(userRepository, &mocks.TeamRepository{}, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.AssignReviewers(ctx, pullRequestID, TransitionOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user1", "user2"}, result.Reviewers())
+		pullRequestRepository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPullRequestService_UnassignReviewer(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	pullRequestID := value_objects.PullRequestID("pull-request-1")
+
+	t.Run("successfully unassign reviewer", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		timeProvider := &mocks.TimeProvider{}
+
+		assignedAt := fixedTime.Add(-time.Hour)
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", "author1", assignedAt)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2"})
+		pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: "user1", Level: 1, AssignedAt: assignedAt})
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		pullRequestRepository.On("Save", ctx, pullRequest).Return(nil)
+		pullRequestRepository.On("RemoveReviewer", ctx, pullRequestID, value_objects.UserID("user1")).Return(nil)
+		pullRequestRepository.On("AddReviewerChange", ctx, entities.ReviewerChange{
+			PullRequestID: pullRequestID,
+			OldReviewerID: "user1",
+			Level:         1,
+			AssignedAt:    assignedAt,
+			Reason:        entities.ReviewerChangeUnassigned,
+			ChangedAt:     fixedTime,
+		}).Return(nil)
+		timeProvider.On("Now").Return(fixedTime)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		outboxRepository := memory.NewOutboxRepository(memory.NewStore())
+		auditRepository := memory.NewAuditRepository(memory.NewStore())
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), outboxRepository, auditRepository)
+		result, err := service.UnassignReviewer(ctx, pullRequestID, "user1", TransitionOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user2"}, result.Reviewers())
+		pullRequestRepository.AssertExpectations(t)
+
+		entries, err := outboxRepository.GetPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, entities.EventReviewerUnassigned, entries[0].Event.Type)
+		assert.Equal(t, "user1", entries[0].Event.Payload["reviewer_id"])
+
+		auditEntries, err := auditRepository.Find(ctx, entities.AuditQuery{EntityID: string(pullRequestID)})
+		require.NoError(t, err)
+		require.Len(t, auditEntries, 1)
+		assert.Equal(t, entities.AuditReviewerUnassigned, auditEntries[0].Action)
+		assert.Equal(t, []string{"user1", "user2"}, auditEntries[0].Before["reviewer_ids"])
+		assert.Equal(t, []string{"user2"}, auditEntries[0].After["reviewer_ids"])
+	})
+
+	t.Run("fail when reviewer not assigned", func(t *testing.T) {
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		pullRequest := entities.NewPullRequest(pullRequestID, "Test Pull Request", "author1", time.Now())
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+
+		pullRequestRepository.On("GetByID", ctx, pullRequestID).Return(pullRequest, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewPullRequestService(&mocks.UserRepository{}, &mocks.TeamRepository{}, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.UnassignReviewer(ctx, pullRequestID, "user2", TransitionOptions{})
+
+		assert.ErrorIs(t, err, domain.ErrNotAssigned)
+		pullRequestRepository.AssertNotCalled(t, "RemoveReviewer", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPullRequestService_BackfillReviewers(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Now()
+
+	author := entities.User{ID: "author1", Username: "author", Team: "backend", IsActive: true}
+	team := entities.Team{Name: "backend"}
+
+	userRepository := &mocks.UserRepository{}
+	teamRepository := &mocks.TeamRepository{}
+	pullRequestRepository := &mocks.PullRequestRepository{}
+	txManager := &mocks.TxManager{}
+	timeProvider := &mocks.TimeProvider{}
+	random := &mocks.RandomProvider{}
+
+	underAssigned := entities.NewPullRequest("pull-request-1", "Feature A", author.ID, time.Now())
+	underAssigned.AddReviewers([]value_objects.UserID{"user1"})
+	noCandidate := entities.NewPullRequest("pull-request-2", "Feature B", author.ID, time.Now())
+	noCandidate.AddReviewers([]value_objects.UserID{"user1", "user2"})
+	noCandidate.ReviewersLimit = 3
+	filledMeanwhile := entities.NewPullRequest("pull-request-3", "Feature C", author.ID, time.Now())
+	filledMeanwhile.AddReviewers([]value_objects.UserID{"user1", "user2"})
+
+	pullRequestRepository.On("LockUnderAssigned", ctx, value_objects.PullRequestID(""), 2).
+		Return([]value_objects.PullRequestID{underAssigned.ID, noCandidate.ID}, nil)
+	pullRequestRepository.On("LockUnderAssigned", ctx, noCandidate.ID, 2).
+		Return([]value_objects.PullRequestID{filledMeanwhile.ID}, nil)
+	pullRequestRepository.On("GetByID", ctx, underAssigned.ID).Return(underAssigned, nil)
+	pullRequestRepository.On("GetByID", ctx, noCandidate.ID).Return(noCandidate, nil)
+	pullRequestRepository.On("GetByID", ctx, filledMeanwhile.ID).Return(filledMeanwhile, nil)
+	userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+	teamRepository.On("GetByName", ctx, team.Name).Return(team, nil)
+	userRepository.On("GetUsersByTeam", ctx, team.Name).Return([]entities.User{
+		author,
+		{ID: "user1", Team: "backend", IsActive: true},
+		{ID: "user2", Team: "backend", IsActive: true},
+	}, nil)
+	timeProvider.On("Now").Return(fixedTime)
+	random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+	pullRequestRepository.On("Save", ctx, underAssigned).Return(nil)
+	pullRequestRepository.On("AddReviewers", ctx, underAssigned.ID, []entities.ReviewerAssignment{{ReviewerID: "user2", AssignedAt: fixedTime}}).Return(nil)
+	txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+	expectAllAvailable(userRepository)
+
+	service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+	report, err := service.BackfillReviewers(ctx, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Checked)
+	assert.Equal(t, []value_objects.PullRequestID{underAssigned.ID}, report.Backfilled)
+	assert.Equal(t, []value_objects.UserID{"user1", "user2"}, underAssigned.Reviewers())
+	pullRequestRepository.AssertNumberOfCalls(t, "Save", 1)
+	txManager.AssertNumberOfCalls(t, "Do", 2)
+}
+
+func TestPullRequestService_MarkReady_AfterBorrowedTeamRenamed(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	store := memory.NewStore()
+	userRepository := memory.NewUserRepository(store)
+	teamRepository := memory.NewTeamRepository(store)
+	pullRequestRepository := memory.NewPullRequestRepository(store)
+
+	_, err := teamRepository.Create(ctx, entities.Team{Name: "backend"})
+	require.NoError(t, err)
+	_, err = teamRepository.Create(ctx, entities.Team{Name: "platform"})
+	require.NoError(t, err)
+	require.NoError(t, userRepository.UpsertMembers(ctx, "backend", []entities.User{
+		{ID: "author", Username: "Author", IsActive: true},
+		{ID: "u1", Username: "Alice", IsActive: true},
+	}))
+	require.NoError(t, userRepository.UpsertMembers(ctx, "platform", []entities.User{
+		{ID: "p1", Username: "Paul", IsActive: true},
+	}))
+
+	random := &mocks.RandomProvider{}
+	random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+	timeProvider := &mocks.TimeProvider{}
+	timeProvider.On("Now").Return(createdAt)
+
+	service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, memory.NewTxManager(store), timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+
+	_, err = service.Create(ctx, "pr-1", "Add search", "author", CreateOptions{
+		Draft:             true,
+		BorrowedReviewers: []entities.ReviewerQuota{{TeamName: "platform", Count: 1}},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, teamRepository.Rename(ctx, "platform", "infra"))
+
+	result, err := service.MarkReady(ctx, "pr-1", TransitionOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusOpen, result.Status)
+	assert.Contains(t, result.Reviewers(), value_objects.UserID("p1"))
+	assert.Equal(t, value_objects.TeamName("infra"), result.Assignment("p1").SourceTeam)
+	assert.Empty(t, result.BorrowedReviewers)
+
+	stored, err := service.GetByID(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Empty(t, stored.BorrowedReviewers)
+}
+
+func TestPullRequestService_Reopen_ClosedDraft(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	store := memory.NewStore()
+	userRepository := memory.NewUserRepository(store)
+	teamRepository := memory.NewTeamRepository(store)
+	pullRequestRepository := memory.NewPullRequestRepository(store)
+
+	_, err := teamRepository.Create(ctx, entities.Team{Name: "backend"})
+	require.NoError(t, err)
+	_, err = teamRepository.Create(ctx, entities.Team{Name: "platform"})
+	require.NoError(t, err)
+	require.NoError(t, userRepository.UpsertMembers(ctx, "backend", []entities.User{
+		{ID: "author", Username: "Author", IsActive: true},
+		{ID: "u1", Username: "Alice", IsActive: true},
+	}))
+	require.NoError(t, userRepository.UpsertMembers(ctx, "platform", []entities.User{
+		{ID: "p1", Username: "Paul", IsActive: true},
+	}))
+
+	random := &mocks.RandomProvider{}
+	random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+	timeProvider := &mocks.TimeProvider{}
+	timeProvider.On("Now").Return(createdAt)
+
+	service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, memory.NewTxManager(store), timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+
+	_, err = service.Create(ctx, "pr-1", "Add search", "author", CreateOptions{
+		Draft:             true,
+		BorrowedReviewers: []entities.ReviewerQuota{{TeamName: "platform", Count: 1}},
+	})
+	require.NoError(t, err)
+
+	_, err = service.Close(ctx, "pr-1", TransitionOptions{})
+	require.NoError(t, err)
+
+	reopened, err := service.Reopen(ctx, "pr-1", TransitionOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusDraft, reopened.Status)
+	assert.Empty(t, reopened.Reviewers())
+	assert.Equal(t, []entities.ReviewerQuota{{TeamName: "platform", Count: 1}}, reopened.BorrowedReviewers)
+
+	ready, err := service.MarkReady(ctx, "pr-1", TransitionOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusOpen, ready.Status)
+	assert.ElementsMatch(t, []value_objects.UserID{"p1", "u1"}, ready.Reviewers())
+}
+
+func TestPullRequestService_ReassignOverdueReviewers(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	sla := entities.ReviewSLA{ReassignAfter: 48 * time.Hour}
+
+	setup := func(t *testing.T, members []entities.User) (PullRequestService, *mocks.TimeProvider) {
+		t.Helper()
+
+		store := memory.NewStore()
+		userRepository := memory.NewUserRepository(store)
+		teamRepository := memory.NewTeamRepository(store)
+		pullRequestRepository := memory.NewPullRequestRepository(store)
+
+		_, err := teamRepository.Create(ctx, entities.Team{Name: "backend", ReviewSLA: sla})
+		require.NoError(t, err)
+		require.NoError(t, userRepository.UpsertMembers(ctx, "backend", members))
+
+		pullRequest := entities.NewPullRequest("pr-1", "Add search", "author", createdAt)
+		pullRequest.AddReviewers([]value_objects.UserID{"u1", "u2"})
+		pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: "u1", AssignedAt: createdAt})
+		pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: "u2", AssignedAt: createdAt})
+		_, err = pullRequest.SubmitReview("u2", entities.DecisionApproved, createdAt.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, pullRequestRepository.Create(ctx, pullRequest))
+
+		random := &mocks.RandomProvider{}
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		timeProvider := &mocks.TimeProvider{}
+
+		service := NewPullRequestService(userRepository, teamRepository, pullRequestRepository, memory.NewTxManager(store), timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+
+		return service, timeProvider
+	}
+
+	members := []entities.User{
+		{ID: "author", Username: "Author", IsActive: true},
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+	}
+
+	t.Run("reassign pending reviewer after sla and record history", func(t *testing.T) {
+		service, timeProvider := setup(t, append(members, entities.User{ID: "u3", Username: "Carol", IsActive: true}))
+		now := createdAt.Add(49 * time.Hour)
+		timeProvider.On("Now").Return(now)
+
+		report, err := service.ReassignOverdueReviewers(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, []ReviewReassignment{{PullRequestID: "pr-1", OldReviewerID: "u1", NewReviewerID: "u3"}}, report.Reassigned)
+		assert.Empty(t, report.WithoutCandidate)
+
+		pullRequest, err := service.GetByID(ctx, "pr-1")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []value_objects.UserID{"u3", "u2"}, pullRequest.Reviewers())
+		assert.Equal(t, now, pullRequest.Assignment("u3").AssignedAt)
+
+		history, err := service.GetReviewerHistory(ctx, "pr-1")
+		require.NoError(t, err)
+		assert.Equal(t, []entities.ReviewerChange{{
+			PullRequestID: "pr-1",
+			OldReviewerID: "u1",
+			NewReviewerID: "u3",
+			AssignedAt:    createdAt,
+			Reason:        entities.ReviewerChangeSLAExceeded,
+			ChangedAt:     now,
+		}}, history)
+
+		report, err = service.ReassignOverdueReviewers(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, report.Reassigned)
+	})
+
+	t.Run("keep reviewer within sla", func(t *testing.T) {
+		service, timeProvider := setup(t, members)
+		timeProvider.On("Now").Return(createdAt.Add(47 * time.Hour))
+
+		report, err := service.ReassignOverdueReviewers(ctx)
+		require.NoError(t, err)
+
+		assert.Empty(t, report.Reassigned)
+		assert.Empty(t, report.WithoutCandidate)
+	})
+
+	t.Run("report pull request without candidate", func(t *testing.T) {
+		service, timeProvider := setup(t, members)
+		timeProvider.On("Now").Return(createdAt.Add(49 * time.Hour))
+
+		report, err := service.ReassignOverdueReviewers(ctx)
+		require.NoError(t, err)
+
+		assert.Empty(t, report.Reassigned)
+		assert.Equal(t, []value_objects.PullRequestID{"pr-1"}, report.WithoutCandidate)
+
+		history, err := service.GetReviewerHistory(ctx, "pr-1")
+		require.NoError(t, err)
+		assert.Empty(t, history)
+	})
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type ReminderService interface {
+	SendReminders(ctx context.Context) (ReminderReport, error)
+}
+
+type ReminderReport struct {
+	Checked int
+	Sent    []entities.ReviewReminder
+}
+
+type reminderService struct {
+	userRepository           app.UserRepository
+	teamRepository           app.TeamRepository
+	pullRequestRepository    app.PullRequestRepository
+	reviewReminderRepository app.ReviewReminderRepository
+	notifier                 app.Notifier
+	txManager                app.TxManager
+	timeProvider             app.TimeProvider
+}
+
+func NewReminderService(userRepository app.UserRepository, teamRepository app.TeamRepository, pullRequestRepository app.PullRequestRepository, reviewReminderRepository app.ReviewReminderRepository, notifier app.Notifier, txManager app.TxManager, timeProvider app.TimeProvider) ReminderService {
+	return &reminderService{
+		userRepository:           userRepository,
+		teamRepository:           teamRepository,
+		pullRequestRepository:    pullRequestRepository,
+		reviewReminderRepository: reviewReminderRepository,
+		notifier:                 notifier,
+		txManager:                txManager,
+		timeProvider:             timeProvider,
+	}
+}
+
+func (s *reminderService) SendReminders(ctx context.Context) (ReminderReport, error) {
+	if s.txManager == nil {
+		return ReminderReport{}, app.ErrTransactionRequired
+	}
+
+	undelivered, err := s.reviewReminderRepository.GetUndelivered(ctx)
+	if err != nil {
+		return ReminderReport{}, err
+	}
+
+	var report ReminderReport
+	var recorded []entities.ReviewReminder
+
+	err = s.txManager.Do(ctx, func(ctx context.Context) error {
+		report.Checked, recorded, err = s.recordDueReminders(ctx)
+
+		return err
+	})
+	if err != nil {
+		return ReminderReport{}, err
+	}
+
+	for _, reminder := range append(undelivered, recorded...) {
+		if err := s.notifier.Notify(ctx, reminder); err != nil {
+			return report, err
+		}
+
+		if err := s.reviewReminderRepository.MarkDelivered(ctx, reminder, s.timeProvider.Now()); err != nil {
+			return report, err
+		}
+
+		report.Sent = append(report.Sent, reminder)
+	}
+
+	return report, nil
+}
+
+// recordDueReminders stores every reminder that became due without sending
+// it, so that a notification is never sent from an uncommitted transaction.
+func (s *reminderService) recordDueReminders(ctx context.Context) (int, []entities.ReviewReminder, error) {
+	teams, err := s.teamRepository.GetAll(ctx, false)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	teamsByName := make(map[value_objects.TeamName]entities.Team, len(teams))
+	var shortestThreshold time.Duration
+
+	for _, team := range teams {
+		if !team.ReviewSLA.RemindersEnabled() {
+			continue
+		}
+
+		teamsByName[team.Name] = team
+		if threshold := team.ReviewSLA.ShortestThreshold(); shortestThreshold == 0 || threshold < shortestThreshold {
+			shortestThreshold = threshold
+		}
+	}
+
+	if len(teamsByName) == 0 {
+		return 0, nil, nil
+	}
+
+	now := s.timeProvider.Now()
+
+	pullRequests, err := s.pullRequestRepository.GetOpenCreatedBefore(ctx, now.Add(-shortestThreshold))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var recorded []entities.ReviewReminder
+	authorTeams := make(map[value_objects.UserID]value_objects.TeamName)
+
+	for _, pullRequest := range pullRequests {
+		teamName, ok := authorTeams[pullRequest.AuthorID]
+		if !ok {
+			author, err := s.userRepository.GetByID(ctx, pullRequest.AuthorID)
+			if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+				return 0, nil, err
+			}
+
+			teamName = author.Team
+			authorTeams[pullRequest.AuthorID] = teamName
+		}
+
+		team, ok := teamsByName[teamName]
+		if !ok {
+			continue
+		}
+
+		for _, reminder := range dueReminders(pullRequest, team, now) {
+			isNew, err := s.reviewReminderRepository.Record(ctx, reminder)
+			if err != nil {
+				return 0, nil, err
+			}
+			if isNew {
+				recorded = append(recorded, reminder)
+			}
+		}
+	}
+
+	return len(pullRequests), recorded, nil
+}
+
+func dueReminders(pullRequest entities.PullRequest, team entities.Team, now time.Time) []entities.ReviewReminder {
+	var reminders []entities.ReviewReminder
+
+	sla := team.ReviewSLA
+	escalate := false
+
+	for _, review := range pullRequest.Reviews() {
+		if !review.IsPending() {
+			continue
+		}
+
+		assignedAt := pullRequest.Assignment(review.ReviewerID).AssignedAt
+		if assignedAt.IsZero() {
+			assignedAt = pullRequest.CreatedAt
+		}
+		waiting := now.Sub(assignedAt)
+
+		if sla.RemindAfter > 0 && waiting >= sla.RemindAfter {
+			reminders = append(reminders, entities.ReviewReminder{
+				PullRequestID: pullRequest.ID,
+				RecipientID:   review.ReviewerID,
+				Kind:          entities.ReminderKindReminder,
+				SentAt:        now,
+			})
+		}
+
+		if sla.EscalateAfter > 0 && waiting >= sla.EscalateAfter {
+			escalate = true
+		}
+	}
+
+	if escalate && team.LeadID != "" {
+		reminders = append(reminders, entities.ReviewReminder{
+			PullRequestID: pullRequest.ID,
+			RecipientID:   team.LeadID,
+			Kind:          entities.ReminderKindEscalation,
+			SentAt:        now,
+		})
+	}
+
+	return reminders
+}
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/app"
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/memory"
+)
+
+type reminderFixture struct {
+	store                 *memory.Store
+	pullRequestRepository app.PullRequestRepository
+	notifier              *memory.Notifier
+	timeProvider          *mocks.TimeProvider
+	createdAt             time.Time
+}
+
+func newReminderFixture(t *testing.T, sla entities.ReviewSLA) reminderFixture {
+	t.Helper()
+
+	ctx := context.Background()
+	store := memory.NewStore()
+	teamRepository := memory.NewTeamRepository(store)
+	userRepository := memory.NewUserRepository(store)
+	pullRequestRepository := memory.NewPullRequestRepository(store)
+
+	_, err := teamRepository.Create(ctx, entities.Team{Name: "backend", LeadID: "lead", ReviewSLA: sla})
+	require.NoError(t, err)
+
+	members := []entities.User{
+		{ID: "author", Username: "Author", IsActive: true},
+		{ID: "lead", Username: "Lead", IsActive: true},
+		{ID: "u1", Username: "Alice", IsActive: true},
+		{ID: "u2", Username: "Bob", IsActive: true},
+		{ID: "u3", Username: "Carol", IsActive: true},
+	}
+	require.NoError(t, userRepository.UpsertMembers(ctx, "backend", members))
+
+	createdAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	pullRequest := entities.NewPullRequest("pr-1", "Add search", "author", createdAt)
+	pullRequest.AddReviewers([]value_objects.UserID{"u1", "u2"})
+	_, err = pullRequest.SubmitReview("u2", entities.DecisionApproved, createdAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, pullRequestRepository.Create(ctx, pullRequest))
+
+	return reminderFixture{
+		store:                 store,
+		pullRequestRepository: pullRequestRepository,
+		notifier:              memory.NewNotifier(),
+		timeProvider:          &mocks.TimeProvider{},
+		createdAt:             createdAt,
+	}
+}
+
+func (f reminderFixture) service(notifier app.Notifier) ReminderService {
+	return NewReminderService(
+		memory.NewUserRepository(f.store),
+		memory.NewTeamRepository(f.store),
+		f.pullRequestRepository,
+		memory.NewReviewReminderRepository(f.store),
+		notifier,
+		memory.NewTxManager(f.store),
+		f.timeProvider,
+	)
+}
+
+func TestReminderService_SendReminders(t *testing.T) {
+	ctx := context.Background()
+	sla := entities.ReviewSLA{RemindAfter: 24 * time.Hour, EscalateAfter: 72 * time.Hour}
+
+	t.Run("remind pending reviewers once after sla", func(t *testing.T) {
+		fixture := newReminderFixture(t, sla)
+		fixture.timeProvider.On("Now").Return(fixture.createdAt.Add(25 * time.Hour))
+		service := fixture.service(fixture.notifier)
+
+		report, err := service.SendReminders(ctx)
+		require.NoError(t, err)
+
+		expected := entities.ReviewReminder{
+			PullRequestID: "pr-1",
+			RecipientID:   "u1",
+			Kind:          entities.ReminderKindReminder,
+			SentAt:        fixture.createdAt.Add(25 * time.Hour),
+		}
+		assert.Equal(t, 1, report.Checked)
+		assert.Equal(t, []entities.ReviewReminder{expected}, report.Sent)
+		assert.Equal(t, []entities.ReviewReminder{expected}, fixture.notifier.Sent())
+
+		report, err = service.SendReminders(ctx)
+		require.NoError(t, err)
+
+		assert.Empty(t, report.Sent)
+		assert.Len(t, fixture.notifier.Sent(), 1)
+	})
+
+	t.Run("escalate to team lead after escalation threshold", func(t *testing.T) {
+		fixture := newReminderFixture(t, sla)
+		fixture.timeProvider.On("Now").Return(fixture.createdAt.Add(73 * time.Hour))
+		service := fixture.service(fixture.notifier)
+
+		report, err := service.SendReminders(ctx)
+		require.NoError(t, err)
+
+		require.Len(t, report.Sent, 2)
+		assert.Equal(t, value_objects.UserID("u1"), report.Sent[0].RecipientID)
+		assert.Equal(t, entities.ReminderKindEscalation, report.Sent[1].Kind)
+		assert.Equal(t, value_objects.UserID("lead"), report.Sent[1].RecipientID)
+	})
+
+	t.Run("time reminders from reviewer assignment", func(t *testing.T) {
+		fixture := newReminderFixture(t, sla)
+		err := fixture.pullRequestRepository.ReassignReviewer(ctx, "pr-1", "u1", entities.ReviewerAssignment{ReviewerID: "u3", AssignedAt: fixture.createdAt.Add(10 * time.Hour)})
+		require.NoError(t, err)
+		fixture.timeProvider.On("Now").Return(fixture.createdAt.Add(25 * time.Hour))
+
+		report, err := fixture.service(fixture.notifier).SendReminders(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, report.Checked)
+		assert.Empty(t, report.Sent)
+	})
+
+	t.Run("skip escalation when every review is decided", func(t *testing.T) {
+		fixture := newReminderFixture(t, sla)
+		err := fixture.pullRequestRepository.SaveReview(ctx, "pr-1", entities.Review{ReviewerID: "u1", Decision: entities.DecisionApproved})
+		require.NoError(t, err)
+		fixture.timeProvider.On("Now").Return(fixture.createdAt.Add(73 * time.Hour))
+
+		report, err := fixture.service(fixture.notifier).SendReminders(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, report.Checked)
+		assert.Empty(t, report.Sent)
+	})
+
+	t.Run("skip pull requests within sla", func(t *testing.T) {
+		fixture := newReminderFixture(t, sla)
+		fixture.timeProvider.On("Now").Return(fixture.createdAt.Add(23 * time.Hour))
+
+		report, err := fixture.service(fixture.notifier).SendReminders(ctx)
+		require.NoError(t, err)
+
+		assert.Zero(t, report.Checked)
+		assert.Empty(t, fixture.notifier.Sent())
+	})
+
+	t.Run("skip teams without sla", func(t *testing.T) {
+		fixture := newReminderFixture(t, entities.ReviewSLA{})
+
+		report, err := fixture.service(fixture.notifier).SendReminders(ctx)
+		require.NoError(t, err)
+
+		assert.Zero(t, report.Checked)
+		assert.Empty(t, fixture.notifier.Sent())
+		fixture.timeProvider.AssertNotCalled(t, "Now")
+	})
+
+	t.Run("retry reminder when notification fails", func(t *testing.T) {
+		fixture := newReminderFixture(t, sla)
+		fixture.timeProvider.On("Now").Return(fixture.createdAt.Add(25 * time.Hour))
+
+		notifyErr := errors.New("smtp unavailable")
+		failingNotifier := &mocks.Notifier{}
+		failingNotifier.On("Notify", mock.Anything, mock.Anything).Return(notifyErr)
+
+		_, err := fixture.service(failingNotifier).SendReminders(ctx)
+		assert.ErrorIs(t, err, notifyErr)
+
+		report, err := fixture.service(fixture.notifier).SendReminders(ctx)
+		require.NoError(t, err)
+
+		assert.Len(t, report.Sent, 1)
+		assert.Len(t, fixture.notifier.Sent(), 1)
+	})
+
+	t.Run("send nothing when recording reminders fails", func(t *testing.T) {
+		fixture := newReminderFixture(t, sla)
+		err := memory.NewTeamRepository(fixture.store).UpdateMergePolicy(ctx, "backend", "ghost", entities.MergePolicy{})
+		require.NoError(t, err)
+		fixture.timeProvider.On("Now").Return(fixture.createdAt.Add(73 * time.Hour))
+
+		_, err = fixture.service(fixture.notifier).SendReminders(ctx)
+		require.Error(t, err)
+
+		assert.Empty(t, fixture.notifier.Sent())
+		undelivered, err := memory.NewReviewReminderRepository(fixture.store).GetUndelivered(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, undelivered)
+	})
+}
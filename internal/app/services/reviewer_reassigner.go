@@ -0,0 +1,290 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type ReviewReassignment struct {
+	PullRequestID value_objects.PullRequestID
+	OldReviewerID value_objects.UserID
+	NewReviewerID value_objects.UserID
+}
+
+type ReassignmentReport struct {
+	Reassigned       []ReviewReassignment
+	WithoutCandidate []value_objects.PullRequestID
+
+	auditStates []pullRequestAuditStates
+}
+
+type pullRequestAuditStates struct {
+	before map[string]any
+	after  map[string]any
+}
+
+func (r *ReassignmentReport) merge(other ReassignmentReport) {
+	r.Reassigned = append(r.Reassigned, other.Reassigned...)
+	r.WithoutCandidate = append(r.WithoutCandidate, other.WithoutCandidate...)
+	r.auditStates = append(r.auditStates, other.auditStates...)
+}
+
+type reassignScope struct {
+	teamName         *value_objects.TeamName
+	fallbackTeamName *value_objects.TeamName
+}
+
+type reviewerReassigner struct {
+	userRepository        app.UserRepository
+	teamRepository        app.TeamRepository
+	pullRequestRepository app.PullRequestRepository
+	timeProvider          app.TimeProvider
+	assignmentStrategy    app.ReviewerAssignmentStrategy
+}
+
+func newReviewerReassigner(userRepository app.UserRepository, teamRepository app.TeamRepository, pullRequestRepository app.PullRequestRepository, timeProvider app.TimeProvider, assignmentStrategy app.ReviewerAssignmentStrategy) *reviewerReassigner {
+	return &reviewerReassigner{
+		userRepository:        userRepository,
+		teamRepository:        teamRepository,
+		pullRequestRepository: pullRequestRepository,
+		timeProvider:          timeProvider,
+		assignmentStrategy:    assignmentStrategy,
+	}
+}
+
+func (r *reviewerReassigner) reassign(ctx context.Context, pullRequest *entities.PullRequest, oldReviewerID value_objects.UserID, scope reassignScope) (value_objects.UserID, error) {
+	previous := pullRequest.Assignment(oldReviewerID)
+
+	levels, err := r.levelsForSlot(ctx, pullRequest, previous, scope)
+	if err != nil {
+		return "", err
+	}
+
+	for level, team := range levels {
+		activeCandidates, err := r.findCandidates(ctx, pullRequest, oldReviewerID, team)
+		if err != nil {
+			return "", err
+		}
+		if len(activeCandidates) == 0 {
+			continue
+		}
+
+		selectedReviewers, err := r.assignmentStrategy.SelectReviewers(ctx, team, toUserIDs(activeCandidates), 1)
+		if err != nil {
+			return "", err
+		}
+		if len(selectedReviewers) == 0 {
+			continue
+		}
+
+		return r.replaceReviewer(ctx, pullRequest, oldReviewerID, entities.ReviewerAssignment{
+			ReviewerID: selectedReviewers[0],
+			Level:      level,
+			SourceTeam: previous.SourceTeam,
+			AssignedAt: r.timeProvider.Now(),
+		})
+	}
+
+	return "", domain.ErrNoCandidate
+}
+
+func (r *reviewerReassigner) reassignTo(ctx context.Context, pullRequest *entities.PullRequest, oldReviewerID, newReviewerID value_objects.UserID) (value_objects.UserID, error) {
+	previous := pullRequest.Assignment(oldReviewerID)
+
+	newReviewer, err := r.userRepository.GetByID(ctx, newReviewerID)
+	if err != nil {
+		return "", err
+	}
+	if !newReviewer.IsActive {
+		return "", domain.ErrReviewerNotEligible
+	}
+
+	levels, err := r.levelsForSlot(ctx, pullRequest, previous, reassignScope{})
+	if err != nil {
+		return "", err
+	}
+
+	for level, team := range levels {
+		if team.Name != newReviewer.Team {
+			continue
+		}
+
+		eligible, err := r.isEligible(ctx, team, newReviewer)
+		if err != nil {
+			return "", err
+		}
+		if !eligible {
+			return "", domain.ErrReviewerNotEligible
+		}
+
+		replacedBy, err := r.replaceReviewer(ctx, pullRequest, oldReviewerID, entities.ReviewerAssignment{
+			ReviewerID: newReviewerID,
+			Level:      level,
+			SourceTeam: previous.SourceTeam,
+			AssignedAt: r.timeProvider.Now(),
+		})
+		if errors.Is(err, domain.ErrNoCandidate) {
+			return "", domain.ErrReviewerNotEligible
+		}
+
+		return replacedBy, err
+	}
+
+	return "", domain.ErrReviewerNotEligible
+}
+
+func (r *reviewerReassigner) levelsForSlot(ctx context.Context, pullRequest *entities.PullRequest, previous entities.ReviewerAssignment, scope reassignScope) ([]entities.Team, error) {
+	if previous.IsBorrowed() {
+		team, err := r.teamRepository.GetByName(ctx, previous.SourceTeam)
+		if err != nil {
+			return nil, err
+		}
+
+		return []entities.Team{team}, nil
+	}
+
+	var teamName value_objects.TeamName
+	if scope.teamName != nil {
+		teamName = *scope.teamName
+	} else {
+		author, err := r.userRepository.GetByID(ctx, pullRequest.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+
+		teamName = author.Team
+	}
+
+	return r.candidateTeams(ctx, teamName, scope.fallbackTeamName)
+}
+
+func (r *reviewerReassigner) candidateTeams(ctx context.Context, teamName value_objects.TeamName, fallbackTeamName *value_objects.TeamName) ([]entities.Team, error) {
+	team, err := r.teamRepository.GetByName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := teamHierarchy(ctx, r.teamRepository, team)
+	if err != nil {
+		return nil, err
+	}
+
+	if fallbackTeamName == nil {
+		return levels, nil
+	}
+	for _, levelTeam := range levels {
+		if levelTeam.Name == *fallbackTeamName {
+			return levels, nil
+		}
+	}
+
+	fallbackTeam, err := r.teamRepository.GetByName(ctx, *fallbackTeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(levels, fallbackTeam), nil
+}
+
+func (r *reviewerReassigner) replaceReviewer(ctx context.Context, pullRequest *entities.PullRequest, oldReviewerID value_objects.UserID, assignment entities.ReviewerAssignment) (value_objects.UserID, error) {
+	if err := pullRequest.ReassignReviewer(oldReviewerID, assignment.ReviewerID); err != nil {
+		return "", err
+	}
+	pullRequest.SetAssignment(assignment)
+
+	if err := r.pullRequestRepository.Save(ctx, pullRequest); err != nil {
+		return "", err
+	}
+
+	if err := r.pullRequestRepository.ReassignReviewer(ctx, pullRequest.ID, oldReviewerID, assignment); err != nil {
+		return "", err
+	}
+
+	return assignment.ReviewerID, nil
+}
+
+func (r *reviewerReassigner) findCandidates(ctx context.Context, pullRequest *entities.PullRequest, oldReviewerID value_objects.UserID, team entities.Team) ([]entities.User, error) {
+	teamMembers, err := r.userRepository.GetUsersByTeam(ctx, team.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	activeCandidates := filterActiveUsersExcludeAuthorAndReviewer(pullRequest, pullRequest.AuthorID, oldReviewerID, teamMembers)
+
+	availableCandidates, err := excludeUnavailable(ctx, r.userRepository, r.timeProvider, activeCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	return excludeAtCapacity(ctx, r.pullRequestRepository, team, availableCandidates)
+}
+
+func (r *reviewerReassigner) isEligible(ctx context.Context, team entities.Team, reviewer entities.User) (bool, error) {
+	availableReviewers, err := excludeUnavailable(ctx, r.userRepository, r.timeProvider, []entities.User{reviewer})
+	if err != nil {
+		return false, err
+	}
+
+	availableReviewers, err = excludeAtCapacity(ctx, r.pullRequestRepository, team, availableReviewers)
+	if err != nil {
+		return false, err
+	}
+
+	return len(availableReviewers) > 0, nil
+}
+
+func (r *reviewerReassigner) reassignOpenReviews(ctx context.Context, reviewerID value_objects.UserID, scope reassignScope) (ReassignmentReport, error) {
+	var report ReassignmentReport
+
+	pullRequests, err := r.pullRequestRepository.GetByReviewer(ctx, reviewerID)
+	if err != nil {
+		return report, err
+	}
+
+	for _, pullRequest := range filterPendingReviews(reviewerID, pullRequests) {
+		before := pullRequestAuditState(&pullRequest)
+
+		newReviewerID, err := r.reassign(ctx, &pullRequest, reviewerID, scope)
+		if errors.Is(err, domain.ErrNoCandidate) {
+			report.WithoutCandidate = append(report.WithoutCandidate, pullRequest.ID)
+			continue
+		}
+		if err != nil {
+			return report, err
+		}
+
+		report.Reassigned = append(report.Reassigned, ReviewReassignment{
+			PullRequestID: pullRequest.ID,
+			OldReviewerID: reviewerID,
+			NewReviewerID: newReviewerID,
+		})
+		report.auditStates = append(report.auditStates, pullRequestAuditStates{before: before, after: pullRequestAuditState(&pullRequest)})
+	}
+
+	return report, nil
+}
+
+func filterActiveUsersExcludeAuthorAndReviewer(pullRequest *entities.PullRequest, authorID, reviewerID value_objects.UserID, candidates []entities.User) []entities.User {
+	var activeCandidates []entities.User
+
+	for _, candidate := range candidates {
+		isAlreadyReviewer := false
+		for _, existingReviewer := range pullRequest.Reviewers() {
+			if existingReviewer == candidate.ID && existingReviewer != reviewerID {
+				isAlreadyReviewer = true
+				break
+			}
+		}
+
+		if candidate.IsActive && candidate.ID != authorID && candidate.ID != reviewerID && !isAlreadyReviewer {
+			activeCandidates = append(activeCandidates, candidate)
+		}
+	}
+
+	return activeCandidates
+}
@@ -8,7 +8,11 @@ import (
 )
 
 type StatsService interface {
-	GetStats(ctx context.Context) (*dto.StatsResponse, error)
+	GetStats(ctx context.Context, options StatsOptions) (*dto.StatsResponse, error)
+}
+
+type StatsOptions struct {
+	IncludeArchived bool
 }
 
 type statsService struct {
@@ -25,13 +29,13 @@ func NewStatsService(userRepository app.UserRepository, teamRepository app.TeamR
 	}
 }
 
-func (s *statsService) GetStats(ctx context.Context) (*dto.StatsResponse, error) {
+func (s *statsService) GetStats(ctx context.Context, options StatsOptions) (*dto.StatsResponse, error) {
 	allUsers, err := s.userRepository.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	allTeams, err := s.teamRepository.GetAll(ctx)
+	allTeams, err := s.teamRepository.GetAll(ctx, options.IncludeArchived)
 	if err != nil {
 		return nil, err
 	}
@@ -43,8 +47,11 @@ func (s *statsService) GetStats(ctx context.Context) (*dto.StatsResponse, error)
 
 	stats := &dto.StatsResponse{
 		TotalPullRequests:  len(allPullRequests),
-		OpenPullRequests:   countOpenPullRequests(allPullRequests),
-		MergedPullRequests: countMergedPullRequests(allPullRequests),
+		OpenPullRequests:   countPullRequestsByStatus(allPullRequests, entities.StatusOpen),
+		MergedPullRequests: countPullRequestsByStatus(allPullRequests, entities.StatusMerged),
+		DraftPullRequests:  countPullRequestsByStatus(allPullRequests, entities.StatusDraft),
+		ClosedPullRequests: countPullRequestsByStatus(allPullRequests, entities.StatusClosed),
+		UnderAssignedPRs:   countUnderAssignedPullRequests(allPullRequests),
 		UsersStats:         calculateUserStats(allUsers, allPullRequests),
 		TeamsStats:         calculateTeamStats(allTeams, allUsers, allPullRequests),
 		ReviewAssignments:  calculateReviewAssignments(allPullRequests),
@@ -53,11 +60,11 @@ func (s *statsService) GetStats(ctx context.Context) (*dto.StatsResponse, error)
 	return stats, nil
 }
 
-func countOpenPullRequests(pullRequests []entities.PullRequest) int {
+func countPullRequestsByStatus(pullRequests []entities.PullRequest, status entities.PullRequestStatus) int {
 	count := 0
 
 	for _, pullRequest := range pullRequests {
-		if pullRequest.Status == entities.StatusOpen {
+		if pullRequest.Status == status {
 			count++
 		}
 	}
@@ -65,11 +72,11 @@ func countOpenPullRequests(pullRequests []entities.PullRequest) int {
 	return count
 }
 
-func countMergedPullRequests(pullRequests []entities.PullRequest) int {
+func countUnderAssignedPullRequests(pullRequests []entities.PullRequest) int {
 	count := 0
 
 	for _, pullRequest := range pullRequests {
-		if pullRequest.Status == entities.StatusMerged {
+		if pullRequest.Status == entities.StatusOpen && !pullRequest.IsFullyAssigned() {
 			count++
 		}
 	}
@@ -105,6 +112,7 @@ func calculateTeamStats(teams []entities.Team, users []entities.User, pullReques
 	for _, team := range teams {
 		stats := dto.TeamStats{
 			TeamName: string(team.Name),
+			Archived: team.IsArchived(),
 		}
 
 		for _, user := range users {
@@ -140,6 +148,9 @@ func calculateReviewAssignments(pullRequests []entities.PullRequest) []dto.Revie
 			PullRequestName: pullRequest.Name,
 			AuthorID:        string(pullRequest.AuthorID),
 			Status:          string(pullRequest.Status),
+			ReviewersCount:  pullRequest.MaxReviewers(),
+			AssignedCount:   len(pullRequest.Reviewers()),
+			FullyAssigned:   pullRequest.IsFullyAssigned(),
 		}
 
 		assignments = append(assignments, assignment)
@@ -10,6 +10,7 @@ import (
 	"pr-service/internal/api/dto"
 	"pr-service/internal/app/services/mocks"
 	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
 )
 
 func TestStatsService_GetStats(t *testing.T) {
@@ -72,13 +73,16 @@ func TestStatsService_GetStats(t *testing.T) {
 				Status:   entities.StatusMerged,
 			},
 		}
+		pullRequests[0].SetReviewers([]value_objects.UserID{"user2"})
+		pullRequests[1].ReviewersLimit = 1
+		pullRequests[1].SetReviewers([]value_objects.UserID{"user2"})
 
 		userRepository.On("GetAll", ctx).Return(users, nil)
-		teamRepository.On("GetAll", ctx).Return(teams, nil)
+		teamRepository.On("GetAll", ctx, false).Return(teams, nil)
 		pullRequestRepository.On("GetAll", ctx).Return(pullRequests, nil)
 
 		service := NewStatsService(userRepository, teamRepository, pullRequestRepository)
-		stats, err := service.GetStats(ctx)
+		stats, err := service.GetStats(ctx, StatsOptions{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, stats)
@@ -86,6 +90,7 @@ func TestStatsService_GetStats(t *testing.T) {
 		assert.Equal(t, 3, stats.TotalPullRequests)
 		assert.Equal(t, 2, stats.OpenPullRequests)
 		assert.Equal(t, 1, stats.MergedPullRequests)
+		assert.Equal(t, 1, stats.UnderAssignedPRs)
 
 		assert.Len(t, stats.UsersStats, 4)
 
@@ -116,9 +121,14 @@ func TestStatsService_GetStats(t *testing.T) {
 		assert.Equal(t, 1, frontendStats.PullRequestsCount)
 
 		assert.Len(t, stats.ReviewAssignments, 3)
+		assert.Equal(t, 2, stats.ReviewAssignments[0].ReviewersCount)
+		assert.Equal(t, 1, stats.ReviewAssignments[0].AssignedCount)
+		assert.False(t, stats.ReviewAssignments[0].FullyAssigned)
+		assert.Equal(t, 1, stats.ReviewAssignments[1].ReviewersCount)
+		assert.True(t, stats.ReviewAssignments[1].FullyAssigned)
 
 		userRepository.AssertCalled(t, "GetAll", ctx)
-		teamRepository.AssertCalled(t, "GetAll", ctx)
+		teamRepository.AssertCalled(t, "GetAll", ctx, false)
 		pullRequestRepository.AssertCalled(t, "GetAll", ctx)
 	})
 
@@ -128,11 +138,11 @@ func TestStatsService_GetStats(t *testing.T) {
 		pullRequestRepository := &mocks.PullRequestRepository{}
 
 		userRepository.On("GetAll", ctx).Return([]entities.User{}, nil)
-		teamRepository.On("GetAll", ctx).Return([]entities.Team{}, nil)
+		teamRepository.On("GetAll", ctx, false).Return([]entities.Team{}, nil)
 		pullRequestRepository.On("GetAll", ctx).Return([]entities.PullRequest{}, nil)
 
 		service := NewStatsService(userRepository, teamRepository, pullRequestRepository)
-		stats, err := service.GetStats(ctx)
+		stats, err := service.GetStats(ctx, StatsOptions{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, stats)
@@ -152,7 +162,7 @@ func TestStatsService_GetStats(t *testing.T) {
 		userRepository.On("GetAll", ctx).Return([]entities.User{}, errors.New("database error"))
 
 		service := NewStatsService(userRepository, teamRepository, pullRequestRepository)
-		stats, err := service.GetStats(ctx)
+		stats, err := service.GetStats(ctx, StatsOptions{})
 
 		assert.Error(t, err)
 		assert.Nil(t, stats)
@@ -173,17 +183,17 @@ func TestStatsService_GetStats(t *testing.T) {
 		}
 
 		userRepository.On("GetAll", ctx).Return(users, nil)
-		teamRepository.On("GetAll", ctx).Return([]entities.Team{}, errors.New("team database error"))
+		teamRepository.On("GetAll", ctx, false).Return([]entities.Team{}, errors.New("team database error"))
 
 		service := NewStatsService(userRepository, teamRepository, pullRequestRepository)
-		stats, err := service.GetStats(ctx)
+		stats, err := service.GetStats(ctx, StatsOptions{})
 
 		assert.Error(t, err)
 		assert.Nil(t, stats)
 		assert.Equal(t, "team database error", err.Error())
 
 		userRepository.AssertCalled(t, "GetAll", ctx)
-		teamRepository.AssertCalled(t, "GetAll", ctx)
+		teamRepository.AssertCalled(t, "GetAll", ctx, false)
 		pullRequestRepository.AssertNotCalled(t, "GetAll", ctx)
 	})
 
@@ -200,18 +210,18 @@ func TestStatsService_GetStats(t *testing.T) {
 		}
 
 		userRepository.On("GetAll", ctx).Return(users, nil)
-		teamRepository.On("GetAll", ctx).Return(teams, nil)
+		teamRepository.On("GetAll", ctx, false).Return(teams, nil)
 		pullRequestRepository.On("GetAll", ctx).Return([]entities.PullRequest{}, errors.New("pr database error"))
 
 		service := NewStatsService(userRepository, teamRepository, pullRequestRepository)
-		stats, err := service.GetStats(ctx)
+		stats, err := service.GetStats(ctx, StatsOptions{})
 
 		assert.Error(t, err)
 		assert.Nil(t, stats)
 		assert.Equal(t, "pr database error", err.Error())
 
 		userRepository.AssertCalled(t, "GetAll", ctx)
-		teamRepository.AssertCalled(t, "GetAll", ctx)
+		teamRepository.AssertCalled(t, "GetAll", ctx, false)
 		pullRequestRepository.AssertCalled(t, "GetAll", ctx)
 	})
 }
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+func teamHierarchy(ctx context.Context, teamRepository app.TeamRepository, team entities.Team) ([]entities.Team, error) {
+	hierarchy := []entities.Team{team}
+	visited := map[value_objects.TeamName]bool{team.Name: true}
+
+	for parentName := team.Parent; parentName != "" && !visited[parentName]; {
+		parent, err := teamRepository.GetByName(ctx, parentName)
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		visited[parent.Name] = true
+		hierarchy = append(hierarchy, parent)
+		parentName = parent.Parent
+	}
+
+	return hierarchy, nil
+}
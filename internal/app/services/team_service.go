@@ -11,59 +11,140 @@ import (
 )
 
 type TeamService interface {
-	Create(ctx context.Context, teamName value_objects.TeamName, members []entities.User) (entities.Team, []entities.User, error)
+	Create(ctx context.Context, team entities.Team, members []entities.User) (entities.Team, []entities.User, error)
 	GetByName(ctx context.Context, teamName value_objects.TeamName) (entities.Team, []entities.User, error)
+	SetAssignmentStrategy(ctx context.Context, teamName value_objects.TeamName, strategy entities.AssignmentStrategy) (entities.Team, error)
+	SetMergePolicy(ctx context.Context, teamName value_objects.TeamName, leadID value_objects.UserID, policy entities.MergePolicy) (entities.Team, error)
+	Deactivate(ctx context.Context, teamName value_objects.TeamName, options DeactivateOptions) ([]entities.User, ReassignmentReport, error)
+	AddMembers(ctx context.Context, teamName value_objects.TeamName, members []entities.User) ([]entities.MembershipChange, error)
+	RemoveMembers(ctx context.Context, teamName value_objects.TeamName, userIDs []value_objects.UserID, options MembershipOptions) ([]entities.MembershipChange, ReassignmentReport, error)
+	MoveMembers(ctx context.Context, teamName value_objects.TeamName, targetTeamName value_objects.TeamName, userIDs []value_objects.UserID, options MembershipOptions) ([]entities.MembershipChange, ReassignmentReport, error)
+	GetMembershipHistory(ctx context.Context, teamName value_objects.TeamName) ([]entities.MembershipChange, error)
+	Archive(ctx context.Context, teamName value_objects.TeamName) (entities.Team, []entities.User, error)
+	Delete(ctx context.Context, teamName value_objects.TeamName) error
+	Rename(ctx context.Context, teamName value_objects.TeamName, newTeamName value_objects.TeamName) (entities.Team, []entities.User, error)
+	SetParent(ctx context.Context, teamName value_objects.TeamName, parentTeamName value_objects.TeamName) (entities.Team, error)
+	SetMaxOpenReviews(ctx context.Context, teamName value_objects.TeamName, maxOpenReviews int) (entities.Team, error)
+	SetReviewSLA(ctx context.Context, teamName value_objects.TeamName, sla entities.ReviewSLA) (entities.Team, error)
+}
+
+type DeactivateOptions struct {
+	UserIDs          []value_objects.UserID
+	FallbackTeamName *value_objects.TeamName
+}
+
+type MembershipOptions struct {
+	ReassignOpenReviews bool
 }
 
 type teamService struct {
-	userRepository app.UserRepository
-	teamRepository app.TeamRepository
-	txManager      app.TxManager
+	userRepository        app.UserRepository
+	teamRepository        app.TeamRepository
+	pullRequestRepository app.PullRequestRepository
+	txManager             app.TxManager
+	timeProvider          app.TimeProvider
+	outboxRepository      app.OutboxRepository
+	auditRepository       app.AuditRepository
+	reassigner            *reviewerReassigner
 }
 
-func NewTeamService(userRepository app.UserRepository, teamRepository app.TeamRepository, txManager app.TxManager) TeamService {
+func NewTeamService(userRepository app.UserRepository, teamRepository app.TeamRepository, pullRequestRepository app.PullRequestRepository, txManager app.TxManager, timeProvider app.TimeProvider, assignmentStrategy app.ReviewerAssignmentStrategy, outboxRepository app.OutboxRepository, auditRepository app.AuditRepository) TeamService {
 	return &teamService{
-		userRepository: userRepository,
-		teamRepository: teamRepository,
-		txManager:      txManager,
+		userRepository:        userRepository,
+		teamRepository:        teamRepository,
+		pullRequestRepository: pullRequestRepository,
+		txManager:             txManager,
+		timeProvider:          timeProvider,
+		outboxRepository:      outboxRepository,
+		auditRepository:       auditRepository,
+		reassigner:            newReviewerReassigner(userRepository, teamRepository, pullRequestRepository, timeProvider, assignmentStrategy),
 	}
 }
 
-func (s *teamService) Create(ctx context.Context, teamName value_objects.TeamName, members []entities.User) (entities.Team, []entities.User, error) {
+func (s *teamService) Create(ctx context.Context, team entities.Team, members []entities.User) (entities.Team, []entities.User, error) {
 	if s.txManager == nil {
 		return entities.Team{}, nil, app.ErrTransactionRequired
 	}
 
-	var resultTeam entities.Team
+	if team.AssignmentStrategy == "" {
+		team.AssignmentStrategy = entities.StrategyRandom
+	}
+	if !team.AssignmentStrategy.IsValid() {
+		return entities.Team{}, nil, domain.ErrInvalidStrategy
+	}
+
+	if team.ReviewersLimit == 0 {
+		team.ReviewersLimit = entities.DefaultReviewersLimit
+	}
+	if err := entities.ValidateReviewersLimit(team.ReviewersLimit); err != nil {
+		return entities.Team{}, nil, err
+	}
+
+	if err := entities.ValidateMaxOpenReviews(team.MaxOpenReviews); err != nil {
+		return entities.Team{}, nil, err
+	}
+
+	if err := team.ReviewSLA.Validate(); err != nil {
+		return entities.Team{}, nil, err
+	}
+
+	if err := team.MergePolicy.Validate(team.LeadID); err != nil {
+		return entities.Team{}, nil, err
+	}
+	if team.LeadID != "" && !containsUser(members, team.LeadID) {
+		return entities.Team{}, nil, domain.ErrInvalidMergePolicy
+	}
+
+	if team.Parent == team.Name {
+		return entities.Team{}, nil, domain.ErrInvalidTeamParent
+	}
+
 	var resultTeamMembers []entities.User
 
 	operation := func(ctx context.Context) error {
-		_, err := s.teamRepository.GetByName(ctx, teamName)
+		_, err := s.teamRepository.GetByName(ctx, team.Name)
 		if err == nil {
 			return domain.ErrTeamExists
 		} else if !errors.Is(err, domain.ErrTeamNotFound) {
 			return err
 		}
 
-		if createErr := s.teamRepository.Create(ctx, entities.Team{Name: teamName}); createErr != nil {
-			return createErr
+		if team.Parent != "" {
+			if _, err := s.teamRepository.GetByName(ctx, team.Parent); err != nil {
+				return err
+			}
+		}
+
+		team, err = s.teamRepository.Create(ctx, team)
+		if err != nil {
+			return err
 		}
 
-		if upsertErr := s.userRepository.UpsertMembers(ctx, teamName, members); upsertErr != nil {
+		if upsertErr := s.userRepository.UpsertMembers(ctx, team.Name, members); upsertErr != nil {
 			return upsertErr
 		}
 
-		resultTeamMembers, err = s.userRepository.GetUsersByTeam(ctx, teamName)
+		resultTeamMembers, err = s.userRepository.GetUsersByTeam(ctx, team.Name)
 		if err != nil {
 			return err
 		}
 
-		resultTeam = entities.Team{Name: teamName}
+		now := s.timeProvider.Now()
 
-		return nil
+		if err := s.outboxRepository.Add(ctx, teamCreatedEvent(team, resultTeamMembers, now)); err != nil {
+			return err
+		}
+
+		entry := newAuditEntry(ctx, entities.AuditTeamCreated, entities.AuditEntityTeam, string(team.ID), nil, teamAuditState(team, resultTeamMembers), now)
+
+		return s.auditRepository.Add(ctx, entry)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return entities.Team{}, nil, err
 	}
 
-	return resultTeam, resultTeamMembers, s.txManager.Do(ctx, operation)
+	return team, resultTeamMembers, nil
 }
 
 func (s *teamService) GetByName(ctx context.Context, teamName value_objects.TeamName) (entities.Team, []entities.User, error) {
@@ -79,3 +160,503 @@ func (s *teamService) GetByName(ctx context.Context, teamName value_objects.Team
 
 	return team, users, nil
 }
+
+func (s *teamService) SetAssignmentStrategy(ctx context.Context, teamName value_objects.TeamName, strategy entities.AssignmentStrategy) (entities.Team, error) {
+	if !strategy.IsValid() {
+		return entities.Team{}, domain.ErrInvalidStrategy
+	}
+
+	team, err := s.teamRepository.GetByName(ctx, teamName)
+	if err != nil {
+		return entities.Team{}, err
+	}
+
+	if err := s.teamRepository.UpdateAssignmentStrategy(ctx, teamName, strategy); err != nil {
+		return entities.Team{}, err
+	}
+
+	team.AssignmentStrategy = strategy
+
+	return team, nil
+}
+
+func (s *teamService) SetMergePolicy(ctx context.Context, teamName value_objects.TeamName, leadID value_objects.UserID, policy entities.MergePolicy) (entities.Team, error) {
+	if err := policy.Validate(leadID); err != nil {
+		return entities.Team{}, err
+	}
+
+	team, err := s.teamRepository.GetByName(ctx, teamName)
+	if err != nil {
+		return entities.Team{}, err
+	}
+
+	if leadID != "" {
+		lead, err := s.userRepository.GetByID(ctx, leadID)
+		if err != nil {
+			return entities.Team{}, err
+		}
+		if lead.Team != teamName {
+			return entities.Team{}, domain.ErrInvalidMergePolicy
+		}
+	}
+
+	if err := s.teamRepository.UpdateMergePolicy(ctx, teamName, leadID, policy); err != nil {
+		return entities.Team{}, err
+	}
+
+	team.LeadID = leadID
+	team.MergePolicy = policy
+
+	return team, nil
+}
+
+func (s *teamService) Deactivate(ctx context.Context, teamName value_objects.TeamName, options DeactivateOptions) ([]entities.User, ReassignmentReport, error) {
+	if s.txManager == nil {
+		return nil, ReassignmentReport{}, app.ErrTransactionRequired
+	}
+
+	userIDs := uniqueUserIDs(options.UserIDs)
+
+	var deactivatedUsers []entities.User
+	var report ReassignmentReport
+
+	operation := func(ctx context.Context) error {
+		if _, err := s.teamRepository.GetByName(ctx, teamName); err != nil {
+			return err
+		}
+
+		if options.FallbackTeamName != nil {
+			if _, err := s.teamRepository.GetByName(ctx, *options.FallbackTeamName); err != nil {
+				return err
+			}
+		}
+
+		previousUsers, err := s.userRepository.GetUsersByTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		users, err := s.userRepository.SetIsActiveByTeam(ctx, teamName, userIDs, false)
+		if err != nil {
+			return err
+		}
+		if len(userIDs) > 0 && len(users) != len(userIDs) {
+			return domain.ErrNotTeamMember
+		}
+
+		for _, user := range users {
+			userReport, err := s.reassigner.reassignOpenReviews(ctx, user.ID, reassignScope{fallbackTeamName: options.FallbackTeamName})
+			if err != nil {
+				return err
+			}
+
+			report.merge(userReport)
+		}
+
+		deactivatedUsers = users
+
+		now := s.timeProvider.Now()
+		events := append(userDeactivatedEvents(users, now), reviewerReassignedEvents(report.Reassigned, now)...)
+
+		if err := s.outboxRepository.Add(ctx, events...); err != nil {
+			return err
+		}
+
+		auditEntries := append(userActivationAuditEntries(ctx, previousUsers, users, now), reviewerReassignedAuditEntries(ctx, report, now)...)
+
+		return s.auditRepository.Add(ctx, auditEntries...)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return nil, ReassignmentReport{}, err
+	}
+
+	return deactivatedUsers, report, nil
+}
+
+func (s *teamService) AddMembers(ctx context.Context, teamName value_objects.TeamName, members []entities.User) ([]entities.MembershipChange, error) {
+	if s.txManager == nil {
+		return nil, app.ErrTransactionRequired
+	}
+
+	var changes []entities.MembershipChange
+
+	operation := func(ctx context.Context) error {
+		team, err := s.teamRepository.GetByName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		if team.IsArchived() {
+			return domain.ErrTeamArchived
+		}
+
+		changedAt := s.timeProvider.Now()
+
+		for _, member := range members {
+			existing, err := s.userRepository.GetByID(ctx, member.ID)
+			if err == nil && existing.Team != "" {
+				return domain.ErrMemberExists
+			} else if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+				return err
+			}
+
+			changes = append(changes, entities.MembershipChange{
+				UserID:    member.ID,
+				Action:    entities.MembershipAdded,
+				ToTeam:    teamName,
+				ChangedAt: changedAt,
+			})
+		}
+
+		if err := s.userRepository.UpsertMembers(ctx, teamName, members); err != nil {
+			return err
+		}
+
+		return s.teamRepository.AddMembershipChanges(ctx, changes)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (s *teamService) RemoveMembers(ctx context.Context, teamName value_objects.TeamName, userIDs []value_objects.UserID, options MembershipOptions) ([]entities.MembershipChange, ReassignmentReport, error) {
+	return s.changeMembership(ctx, teamName, "", userIDs, entities.MembershipRemoved, options)
+}
+
+func (s *teamService) MoveMembers(ctx context.Context, teamName value_objects.TeamName, targetTeamName value_objects.TeamName, userIDs []value_objects.UserID, options MembershipOptions) ([]entities.MembershipChange, ReassignmentReport, error) {
+	if targetTeamName == teamName {
+		return nil, ReassignmentReport{}, domain.ErrMemberExists
+	}
+
+	return s.changeMembership(ctx, teamName, targetTeamName, userIDs, entities.MembershipMoved, options)
+}
+
+func (s *teamService) GetMembershipHistory(ctx context.Context, teamName value_objects.TeamName) ([]entities.MembershipChange, error) {
+	if _, err := s.teamRepository.GetByName(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	return s.teamRepository.GetMembershipHistory(ctx, teamName)
+}
+
+func (s *teamService) Archive(ctx context.Context, teamName value_objects.TeamName) (entities.Team, []entities.User, error) {
+	if s.txManager == nil {
+		return entities.Team{}, nil, app.ErrTransactionRequired
+	}
+
+	var resultTeam entities.Team
+	var resultTeamMembers []entities.User
+
+	operation := func(ctx context.Context) error {
+		team, err := s.teamRepository.GetByName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		if team.IsArchived() {
+			return domain.ErrTeamArchived
+		}
+
+		archivedAt := s.timeProvider.Now()
+		if err := s.teamRepository.Archive(ctx, teamName, archivedAt); err != nil {
+			return err
+		}
+
+		team.ArchivedAt = &archivedAt
+
+		resultTeamMembers, err = s.userRepository.GetUsersByTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		resultTeam = team
+
+		return nil
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return entities.Team{}, nil, err
+	}
+
+	return resultTeam, resultTeamMembers, nil
+}
+
+func (s *teamService) Delete(ctx context.Context, teamName value_objects.TeamName) error {
+	if s.txManager == nil {
+		return app.ErrTransactionRequired
+	}
+
+	operation := func(ctx context.Context) error {
+		if _, err := s.teamRepository.GetByName(ctx, teamName); err != nil {
+			return err
+		}
+
+		users, err := s.userRepository.GetUsersByTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		if len(users) > 0 {
+			return domain.ErrTeamInUse
+		}
+
+		teams, err := s.teamRepository.GetAll(ctx, true)
+		if err != nil {
+			return err
+		}
+		for _, team := range teams {
+			if team.Parent == teamName {
+				return domain.ErrTeamInUse
+			}
+		}
+
+		openCount, err := s.pullRequestRepository.CountOpenByTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		if openCount > 0 {
+			return domain.ErrTeamInUse
+		}
+
+		return s.teamRepository.Delete(ctx, teamName)
+	}
+
+	return s.txManager.Do(ctx, operation)
+}
+
+func (s *teamService) Rename(ctx context.Context, teamName value_objects.TeamName, newTeamName value_objects.TeamName) (entities.Team, []entities.User, error) {
+	if s.txManager == nil {
+		return entities.Team{}, nil, app.ErrTransactionRequired
+	}
+
+	var resultTeam entities.Team
+	var resultTeamMembers []entities.User
+
+	operation := func(ctx context.Context) error {
+		team, err := s.teamRepository.GetByName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.teamRepository.GetByName(ctx, newTeamName)
+		if err == nil {
+			return domain.ErrTeamExists
+		} else if !errors.Is(err, domain.ErrTeamNotFound) {
+			return err
+		}
+
+		if err := s.teamRepository.Rename(ctx, teamName, newTeamName); err != nil {
+			return err
+		}
+
+		resultTeamMembers, err = s.userRepository.GetUsersByTeam(ctx, newTeamName)
+		if err != nil {
+			return err
+		}
+
+		team.Name = newTeamName
+		resultTeam = team
+
+		return nil
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return entities.Team{}, nil, err
+	}
+
+	return resultTeam, resultTeamMembers, nil
+}
+
+func (s *teamService) SetParent(ctx context.Context, teamName value_objects.TeamName, parentTeamName value_objects.TeamName) (entities.Team, error) {
+	if s.txManager == nil {
+		return entities.Team{}, app.ErrTransactionRequired
+	}
+
+	if parentTeamName == teamName {
+		return entities.Team{}, domain.ErrInvalidTeamParent
+	}
+
+	var resultTeam entities.Team
+
+	operation := func(ctx context.Context) error {
+		team, err := s.teamRepository.GetByName(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		if parentTeamName != "" {
+			parentTeam, err := s.teamRepository.GetByName(ctx, parentTeamName)
+			if err != nil {
+				return err
+			}
+
+			ancestors, err := teamHierarchy(ctx, s.teamRepository, parentTeam)
+			if err != nil {
+				return err
+			}
+			for _, ancestor := range ancestors {
+				if ancestor.Name == teamName {
+					return domain.ErrInvalidTeamParent
+				}
+			}
+		}
+
+		if err := s.teamRepository.UpdateParent(ctx, teamName, parentTeamName); err != nil {
+			return err
+		}
+
+		team.Parent = parentTeamName
+		resultTeam = team
+
+		return nil
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return entities.Team{}, err
+	}
+
+	return resultTeam, nil
+}
+
+func (s *teamService) changeMembership(ctx context.Context, teamName, targetTeamName value_objects.TeamName, userIDs []value_objects.UserID, action entities.MembershipAction, options MembershipOptions) ([]entities.MembershipChange, ReassignmentReport, error) {
+	if s.txManager == nil {
+		return nil, ReassignmentReport{}, app.ErrTransactionRequired
+	}
+
+	userIDs = uniqueUserIDs(userIDs)
+
+	var changes []entities.MembershipChange
+	var report ReassignmentReport
+
+	operation := func(ctx context.Context) error {
+		if _, err := s.teamRepository.GetByName(ctx, teamName); err != nil {
+			return err
+		}
+
+		if targetTeamName != "" {
+			targetTeam, err := s.teamRepository.GetByName(ctx, targetTeamName)
+			if err != nil {
+				return err
+			}
+			if targetTeam.IsArchived() {
+				return domain.ErrTeamArchived
+			}
+		}
+
+		changedAt := s.timeProvider.Now()
+
+		for _, userID := range userIDs {
+			user, err := s.userRepository.GetByID(ctx, userID)
+			if err != nil {
+				return err
+			}
+			if user.Team != teamName {
+				return domain.ErrNotTeamMember
+			}
+
+			changes = append(changes, entities.MembershipChange{
+				UserID:    userID,
+				Action:    action,
+				FromTeam:  teamName,
+				ToTeam:    targetTeamName,
+				ChangedAt: changedAt,
+			})
+		}
+
+		if err := s.userRepository.SetTeam(ctx, userIDs, targetTeamName); err != nil {
+			return err
+		}
+
+		if err := s.teamRepository.AddMembershipChanges(ctx, changes); err != nil {
+			return err
+		}
+
+		if !options.ReassignOpenReviews {
+			return nil
+		}
+
+		for _, userID := range userIDs {
+			userReport, err := s.reassigner.reassignOpenReviews(ctx, userID, reassignScope{teamName: &teamName})
+			if err != nil {
+				return err
+			}
+
+			report.merge(userReport)
+		}
+
+		if err := s.outboxRepository.Add(ctx, reviewerReassignedEvents(report.Reassigned, changedAt)...); err != nil {
+			return err
+		}
+
+		return s.auditRepository.Add(ctx, reviewerReassignedAuditEntries(ctx, report, changedAt)...)
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return nil, ReassignmentReport{}, err
+	}
+
+	return changes, report, nil
+}
+
+func uniqueUserIDs(userIDs []value_objects.UserID) []value_objects.UserID {
+	var unique []value_objects.UserID
+	seen := make(map[value_objects.UserID]bool, len(userIDs))
+
+	for _, userID := range userIDs {
+		if !seen[userID] {
+			seen[userID] = true
+			unique = append(unique, userID)
+		}
+	}
+
+	return unique
+}
+
+func containsUser(users []entities.User, userID value_objects.UserID) bool {
+	for _, user := range users {
+		if user.ID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *teamService) SetMaxOpenReviews(ctx context.Context, teamName value_objects.TeamName, maxOpenReviews int) (entities.Team, error) {
+	if err := entities.ValidateMaxOpenReviews(maxOpenReviews); err != nil {
+		return entities.Team{}, err
+	}
+
+	team, err := s.teamRepository.GetByName(ctx, teamName)
+	if err != nil {
+		return entities.Team{}, err
+	}
+
+	if err := s.teamRepository.UpdateMaxOpenReviews(ctx, teamName, maxOpenReviews); err != nil {
+		return entities.Team{}, err
+	}
+
+	team.MaxOpenReviews = maxOpenReviews
+
+	return team, nil
+}
+
+func (s *teamService) SetReviewSLA(ctx context.Context, teamName value_objects.TeamName, sla entities.ReviewSLA) (entities.Team, error) {
+	if err := sla.Validate(); err != nil {
+		return entities.Team{}, err
+	}
+
+	team, err := s.teamRepository.GetByName(ctx, teamName)
+	if err != nil {
+		return entities.Team{}, err
+	}
+
+	if err := s.teamRepository.UpdateReviewSLA(ctx, teamName, sla); err != nil {
+		return entities.Team{}, err
+	}
+
+	team.ReviewSLA = sla
+
+	return team, nil
+}
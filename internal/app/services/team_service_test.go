@@ -4,15 +4,19 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"pr-service/internal/app"
+	"pr-service/internal/app/assignment"
 	"pr-service/internal/app/services/mocks"
 	"pr-service/internal/domain"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/memory"
 )
 
 func TestTeamService_Create(t *testing.T) {
@@ -36,8 +40,8 @@ func TestTeamService_Create(t *testing.T) {
 		teamRepository.On("GetByName", ctx, teamName).Once().
 			Return(entities.Team{}, domain.ErrTeamNotFound)
 
-		teamRepository.On("Create", ctx, entities.Team{Name: teamName}).Once().
-			Return(nil)
+		teamRepository.On("Create", ctx, entities.Team{Name: teamName, AssignmentStrategy: entities.StrategyRandom, ReviewersLimit: entities.DefaultReviewersLimit}).Once().
+			Return(entities.Team{ID: "team-1", Name: teamName, AssignmentStrategy: entities.StrategyRandom, ReviewersLimit: entities.DefaultReviewersLimit}, nil)
 
 		userRepository.On("UpsertMembers", ctx, teamName, members).Once().
 			Return(nil)
@@ -48,16 +52,39 @@ func TestTeamService_Create(t *testing.T) {
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Once().
 			Return(nil)
 
-		service := NewTeamService(userRepository, teamRepository, txManager)
-		resultTeam, resultUsers, err := service.Create(ctx, teamName, members)
+		now := time.Now()
+		timeProvider := &mocks.TimeProvider{}
+		timeProvider.On("Now").Return(now)
+		outboxRepository := &mocks.OutboxRepository{}
+		outboxRepository.On("Add", ctx, []entities.Event{{
+			Type:        entities.EventTeamCreated,
+			AggregateID: "backend",
+			OccurredAt:  now,
+			Payload:     map[string]any{"team_name": "backend", "member_ids": []string{"user1"}},
+		}}).Once().Return(nil)
+
+		auditRepository := memory.NewAuditRepository(memory.NewStore())
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), outboxRepository, auditRepository)
+		resultTeam, resultUsers, err := service.Create(ctx, entities.Team{Name: teamName}, members)
 
 		assert.NoError(t, err)
-		assert.Equal(t, entities.Team{Name: teamName}, resultTeam)
+		assert.Equal(t, entities.Team{ID: "team-1", Name: teamName, AssignmentStrategy: entities.StrategyRandom, ReviewersLimit: entities.DefaultReviewersLimit}, resultTeam)
 		assert.Equal(t, members, resultUsers)
 
+		entries, err := auditRepository.Find(ctx, entities.AuditQuery{})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, entities.SystemActor, entries[0].Actor)
+		assert.Equal(t, entities.AuditTeamCreated, entries[0].Action)
+		assert.Equal(t, "team-1", entries[0].EntityID)
+		assert.Nil(t, entries[0].Before)
+		assert.Equal(t, []string{"user1"}, entries[0].After["member_ids"])
+
 		userRepository.AssertExpectations(t)
 		teamRepository.AssertExpectations(t)
 		txManager.AssertExpectations(t)
+		outboxRepository.AssertExpectations(t)
 	})
 
 	t.Run("return error when txManager is nil", func(t *testing.T) {
@@ -74,8 +101,8 @@ func TestTeamService_Create(t *testing.T) {
 			},
 		}
 
-		service := NewTeamService(userRepository, teamRepository, nil)
-		resultTeam, resultUsers, err := service.Create(ctx, teamName, members)
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, resultUsers, err := service.Create(ctx, entities.Team{Name: teamName}, members)
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, app.ErrTransactionRequired))
@@ -106,8 +133,8 @@ func TestTeamService_Create(t *testing.T) {
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Once().
 			Return(errors.New("transaction failed"))
 
-		service := NewTeamService(userRepository, teamRepository, txManager)
-		resultTeam, resultUsers, err := service.Create(ctx, teamName, members)
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, resultUsers, err := service.Create(ctx, entities.Team{Name: teamName}, members)
 
 		assert.Error(t, err)
 		assert.Equal(t, "transaction failed", err.Error())
@@ -139,8 +166,8 @@ func TestTeamService_Create(t *testing.T) {
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Once().
 			Return(domain.ErrTeamExists)
 
-		service := NewTeamService(userRepository, teamRepository, txManager)
-		resultTeam, resultUsers, err := service.Create(ctx, teamName, members)
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, resultUsers, err := service.Create(ctx, entities.Team{Name: teamName}, members)
 
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, domain.ErrTeamExists))
@@ -175,7 +202,7 @@ func TestTeamService_GetByName(t *testing.T) {
 		userRepository.On("GetUsersByTeam", ctx, teamName).
 			Return(expectedUsers, nil)
 
-		service := NewTeamService(userRepository, teamRepository, nil)
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
 		resultTeam, resultUsers, err := service.GetByName(ctx, teamName)
 
 		assert.NoError(t, err)
@@ -195,7 +222,7 @@ func TestTeamService_GetByName(t *testing.T) {
 		teamRepository.On("GetByName", ctx, teamName).
 			Return(entities.Team{}, domain.ErrTeamNotFound)
 
-		service := NewTeamService(userRepository, teamRepository, nil)
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
 		resultTeam, resultUsers, err := service.GetByName(ctx, teamName)
 
 		assert.Error(t, err)
@@ -216,7 +243,7 @@ func TestTeamService_GetByName(t *testing.T) {
 		teamRepository.On("GetByName", ctx, teamName).
 			Return(entities.Team{}, errors.New("database error"))
 
-		service := NewTeamService(userRepository, teamRepository, nil)
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
 		resultTeam, resultUsers, err := service.GetByName(ctx, teamName)
 
 		assert.Error(t, err)
@@ -241,7 +268,7 @@ func TestTeamService_GetByName(t *testing.T) {
 		userRepository.On("GetUsersByTeam", ctx, teamName).
 			Return([]entities.User{}, nil)
 
-		service := NewTeamService(userRepository, teamRepository, nil)
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
 		resultTeam, resultUsers, err := service.GetByName(ctx, teamName)
 
 		assert.NoError(t, err)
@@ -274,8 +301,8 @@ func TestTeamService_Create_Validation(t *testing.T) {
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Once().
 			Return(domain.ErrTeamExists)
 
-		service := NewTeamService(userRepository, teamRepository, txManager)
-		resultTeam, resultUsers, err := service.Create(ctx, teamName, members)
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, resultUsers, err := service.Create(ctx, entities.Team{Name: teamName}, members)
 
 		assert.Error(t, err)
 		assert.Equal(t, entities.Team{}, resultTeam)
@@ -301,11 +328,720 @@ func TestTeamService_Create_Validation(t *testing.T) {
 		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Once().
 			Return(errors.New("any error"))
 
-		service := NewTeamService(userRepository, teamRepository, txManager)
-		resultTeam, resultUsers, err := service.Create(ctx, teamName, members)
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, resultUsers, err := service.Create(ctx, entities.Team{Name: teamName}, members)
 
 		assert.Error(t, err)
 		assert.Equal(t, entities.Team{}, resultTeam)
 		assert.Nil(t, resultUsers)
 	})
 }
+
+func TestTeamService_SetAssignmentStrategy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successfully change strategy", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+
+		teamName := value_objects.TeamName("backend")
+
+		teamRepository.On("GetByName", ctx, teamName).
+			Return(entities.Team{Name: teamName, AssignmentStrategy: entities.StrategyRandom, ReviewersLimit: entities.DefaultReviewersLimit}, nil)
+		teamRepository.On("UpdateAssignmentStrategy", ctx, teamName, entities.StrategyLeastLoaded).
+			Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, err := service.SetAssignmentStrategy(ctx, teamName, entities.StrategyLeastLoaded)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StrategyLeastLoaded, resultTeam.AssignmentStrategy)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("reject unknown strategy", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, err := service.SetAssignmentStrategy(ctx, "backend", "FASTEST")
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidStrategy))
+		assert.Equal(t, entities.Team{}, resultTeam)
+		teamRepository.AssertNotCalled(t, "UpdateAssignmentStrategy", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("reject unknown strategy on create", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, _, err := service.Create(ctx, entities.Team{Name: "backend", AssignmentStrategy: "FASTEST"}, nil)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidStrategy))
+		txManager.AssertNotCalled(t, "Do", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTeamService_Create_ReviewersLimit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keep custom reviewers limit", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		team := entities.Team{Name: "security", AssignmentStrategy: entities.StrategyRandom, ReviewersLimit: 3}
+
+		teamRepository.On("GetByName", ctx, team.Name).Return(entities.Team{}, domain.ErrTeamNotFound)
+		teamRepository.On("Create", ctx, team).Once().Return(team, nil)
+		userRepository.On("UpsertMembers", ctx, team.Name, mock.Anything).Return(nil)
+		userRepository.On("GetUsersByTeam", ctx, team.Name).Return([]entities.User{}, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+		timeProvider := &mocks.TimeProvider{}
+		timeProvider.On("Now").Return(time.Now())
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, _, err := service.Create(ctx, team, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, resultTeam.ReviewersLimit)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("reject out of range reviewers limit", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, _, err := service.Create(ctx, entities.Team{Name: "backend", ReviewersLimit: entities.MaxReviewersLimit + 1}, nil)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidReviewersCount))
+		txManager.AssertNotCalled(t, "Do", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTeamService_SetMergePolicy(t *testing.T) {
+	ctx := context.Background()
+	policy := entities.MergePolicy{MinApprovals: 1, RequireLeadApproval: true}
+
+	t.Run("successfully set policy with lead", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		userRepository.On("GetByID", ctx, value_objects.UserID("lead")).Return(entities.User{ID: "lead", Team: "backend"}, nil)
+		teamRepository.On("UpdateMergePolicy", ctx, value_objects.TeamName("backend"), value_objects.UserID("lead"), policy).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, err := service.SetMergePolicy(ctx, "backend", "lead", policy)
+
+		assert.NoError(t, err)
+		assert.Equal(t, value_objects.UserID("lead"), resultTeam.LeadID)
+		assert.Equal(t, policy, resultTeam.MergePolicy)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("reject lead from another team", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		userRepository.On("GetByID", ctx, value_objects.UserID("lead")).Return(entities.User{ID: "lead", Team: "frontend"}, nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.SetMergePolicy(ctx, "backend", "lead", policy)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidMergePolicy))
+		teamRepository.AssertNotCalled(t, "UpdateMergePolicy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("reject lead approval without lead", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+
+		service := NewTeamService(&mocks.UserRepository{}, teamRepository, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.SetMergePolicy(ctx, "backend", "", policy)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidMergePolicy))
+		teamRepository.AssertNotCalled(t, "GetByName", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reject lead outside of members on create", func(t *testing.T) {
+		txManager := &mocks.TxManager{}
+
+		service := NewTeamService(&mocks.UserRepository{}, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, _, err := service.Create(ctx, entities.Team{Name: "backend", LeadID: "lead", MergePolicy: policy}, []entities.User{{ID: "user1"}})
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidMergePolicy))
+		txManager.AssertNotCalled(t, "Do", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTeamService_Deactivate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("deactivate subset and reassign to fallback team", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		random := &mocks.RandomProvider{}
+
+		fallbackTeamName := value_objects.TeamName("platform")
+		author := entities.User{ID: "author", Team: "backend", IsActive: true}
+		deactivated := []entities.User{
+			{ID: "user1", Team: "backend", IsActive: false},
+			{ID: "user2", Team: "backend", IsActive: false},
+		}
+
+		pullRequest := entities.NewPullRequest("pullRequest1", "Feature A", author.ID, now)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2"})
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, fallbackTeamName).Return(entities.Team{Name: fallbackTeamName}, nil)
+		userRepository.On("SetIsActiveByTeam", ctx, value_objects.TeamName("backend"), []value_objects.UserID{"user1", "user2"}, false).Return(deactivated, nil)
+		pullRequestRepository.On("GetByReviewer", ctx, value_objects.UserID("user1")).Return([]entities.PullRequest{*pullRequest}, nil)
+		pullRequestRepository.On("GetByReviewer", ctx, value_objects.UserID("user2")).Return([]entities.PullRequest{}, nil)
+		userRepository.On("GetByID", ctx, author.ID).Return(author, nil)
+		userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("backend")).Return(append([]entities.User{author}, deactivated...), nil)
+		userRepository.On("GetUsersByTeam", ctx, fallbackTeamName).Return([]entities.User{{ID: "user3", Team: fallbackTeamName, IsActive: true}}, nil)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("Save", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
+		pullRequestRepository.On("ReassignReviewer", ctx, value_objects.PullRequestID("pullRequest1"), value_objects.UserID("user1"), entities.ReviewerAssignment{ReviewerID: "user3", Level: 1, AssignedAt: now}).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		timeProvider := &mocks.TimeProvider{}
+		timeProvider.On("Now").Return(now)
+		expectAllAvailable(userRepository)
+		auditRepository := memory.NewAuditRepository(memory.NewStore())
+		service := NewTeamService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), auditRepository)
+		users, report, err := service.Deactivate(ctx, "backend", DeactivateOptions{
+			UserIDs:          []value_objects.UserID{"user1", "user2", "user1"},
+			FallbackTeamName: &fallbackTeamName,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, deactivated, users)
+		assert.Equal(t, []ReviewReassignment{
+			{PullRequestID: "pullRequest1", OldReviewerID: "user1", NewReviewerID: "user3"},
+		}, report.Reassigned)
+		assert.Empty(t, report.WithoutCandidate)
+		pullRequestRepository.AssertExpectations(t)
+
+		entries, err := auditRepository.Find(ctx, entities.AuditQuery{EntityType: entities.AuditEntityPullRequest})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, entities.AuditReviewerReassigned, entries[0].Action)
+		assert.Equal(t, []string{"user1", "user2"}, entries[0].Before["reviewer_ids"])
+		assert.Equal(t, []string{"user3", "user2"}, entries[0].After["reviewer_ids"])
+	})
+
+	t.Run("fail when user is not a team member", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("backend")).Return([]entities.User{{ID: "user1", Team: "backend", IsActive: true}}, nil)
+		userRepository.On("SetIsActiveByTeam", ctx, value_objects.TeamName("backend"), []value_objects.UserID{"user1", "stranger"}, false).
+			Return([]entities.User{{ID: "user1", Team: "backend"}}, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		users, _, err := service.Deactivate(ctx, "backend", DeactivateOptions{UserIDs: []value_objects.UserID{"user1", "stranger"}})
+
+		assert.ErrorIs(t, err, domain.ErrNotTeamMember)
+		assert.Nil(t, users)
+		pullRequestRepository.AssertNotCalled(t, "GetByReviewer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("fail when fallback team does not exist", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		fallbackTeamName := value_objects.TeamName("missing")
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, fallbackTeamName).Return(entities.Team{}, domain.ErrTeamNotFound)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, _, err := service.Deactivate(ctx, "backend", DeactivateOptions{FallbackTeamName: &fallbackTeamName})
+
+		assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+		userRepository.AssertNotCalled(t, "SetIsActiveByTeam", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTeamService_AddMembers(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("add new and previously removed members", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+
+		members := []entities.User{
+			{ID: "user1", Username: "Alice", Team: "backend", IsActive: true},
+			{ID: "user2", Username: "Bob", Team: "backend", IsActive: true},
+		}
+		expectedChanges := []entities.MembershipChange{
+			{UserID: "user1", Action: entities.MembershipAdded, ToTeam: "backend", ChangedAt: fixedTime},
+			{UserID: "user2", Action: entities.MembershipAdded, ToTeam: "backend", ChangedAt: fixedTime},
+		}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		userRepository.On("GetByID", ctx, value_objects.UserID("user1")).Return(entities.User{}, domain.ErrUserNotFound)
+		userRepository.On("GetByID", ctx, value_objects.UserID("user2")).Return(entities.User{ID: "user2"}, nil)
+		userRepository.On("UpsertMembers", ctx, value_objects.TeamName("backend"), members).Return(nil)
+		teamRepository.On("AddMembershipChanges", ctx, expectedChanges).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		changes, err := service.AddMembers(ctx, "backend", members)
+
+		require.NoError(t, err)
+		assert.Equal(t, expectedChanges, changes)
+		teamRepository.AssertExpectations(t)
+		userRepository.AssertExpectations(t)
+	})
+
+	t.Run("fail when user belongs to another team", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		userRepository.On("GetByID", ctx, value_objects.UserID("user1")).Return(entities.User{ID: "user1", Team: "frontend"}, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.AddMembers(ctx, "backend", []entities.User{{ID: "user1", Team: "backend"}})
+
+		assert.ErrorIs(t, err, domain.ErrMemberExists)
+		userRepository.AssertNotCalled(t, "UpsertMembers", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTeamService_MoveMembers(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("move member and reassign within old team", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+		random := &mocks.RandomProvider{}
+
+		pullRequest := entities.NewPullRequest("pullRequest1", "Feature A", "author", fixedTime)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+		expectedChanges := []entities.MembershipChange{
+			{UserID: "user1", Action: entities.MembershipMoved, FromTeam: "backend", ToTeam: "platform", ChangedAt: fixedTime},
+		}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("platform")).Return(entities.Team{Name: "platform"}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		userRepository.On("GetByID", ctx, value_objects.UserID("user1")).Return(entities.User{ID: "user1", Team: "backend", IsActive: true}, nil)
+		userRepository.On("SetTeam", ctx, []value_objects.UserID{"user1"}, value_objects.TeamName("platform")).Return(nil)
+		teamRepository.On("AddMembershipChanges", ctx, expectedChanges).Return(nil)
+		pullRequestRepository.On("GetByReviewer", ctx, value_objects.UserID("user1")).Return([]entities.PullRequest{*pullRequest}, nil)
+		userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("backend")).Return([]entities.User{
+			{ID: "author", Team: "backend", IsActive: true},
+			{ID: "user2", Team: "backend", IsActive: true},
+		}, nil)
+		random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+		pullRequestRepository.On("Save", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
+		pullRequestRepository.On("ReassignReviewer", ctx, value_objects.PullRequestID("pullRequest1"), value_objects.UserID("user1"), entities.ReviewerAssignment{ReviewerID: "user2", AssignedAt: fixedTime}).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		expectAllAvailable(userRepository)
+		auditRepository := memory.NewAuditRepository(memory.NewStore())
+		service := NewTeamService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), auditRepository)
+		changes, report, err := service.MoveMembers(ctx, "backend", "platform", []value_objects.UserID{"user1"}, MembershipOptions{ReassignOpenReviews: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, expectedChanges, changes)
+		assert.Equal(t, []ReviewReassignment{
+			{PullRequestID: "pullRequest1", OldReviewerID: "user1", NewReviewerID: "user2"},
+		}, report.Reassigned)
+		pullRequestRepository.AssertExpectations(t)
+
+		entries, err := auditRepository.Find(ctx, entities.AuditQuery{EntityID: "pullRequest1"})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, entities.AuditReviewerReassigned, entries[0].Action)
+		assert.Equal(t, []string{"user1"}, entries[0].Before["reviewer_ids"])
+		assert.Equal(t, []string{"user2"}, entries[0].After["reviewer_ids"])
+	})
+
+	t.Run("fail when user is not a member of source team", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+		timeProvider := &mocks.TimeProvider{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("platform")).Return(entities.Team{Name: "platform"}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		userRepository.On("GetByID", ctx, value_objects.UserID("user1")).Return(entities.User{ID: "user1", Team: "frontend"}, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, _, err := service.MoveMembers(ctx, "backend", "platform", []value_objects.UserID{"user1"}, MembershipOptions{})
+
+		assert.ErrorIs(t, err, domain.ErrNotTeamMember)
+		userRepository.AssertNotCalled(t, "SetTeam", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("fail when moving into the same team", func(t *testing.T) {
+		txManager := &mocks.TxManager{}
+
+		service := NewTeamService(&mocks.UserRepository{}, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, _, err := service.MoveMembers(ctx, "backend", "backend", []value_objects.UserID{"user1"}, MembershipOptions{})
+
+		assert.ErrorIs(t, err, domain.ErrMemberExists)
+		txManager.AssertNotCalled(t, "Do", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTeamService_RemoveMembers(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	userRepository := &mocks.UserRepository{}
+	teamRepository := &mocks.TeamRepository{}
+	pullRequestRepository := &mocks.PullRequestRepository{}
+	txManager := &mocks.TxManager{}
+	timeProvider := &mocks.TimeProvider{}
+
+	expectedChanges := []entities.MembershipChange{
+		{UserID: "user1", Action: entities.MembershipRemoved, FromTeam: "backend", ChangedAt: fixedTime},
+	}
+
+	teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+	timeProvider.On("Now").Return(fixedTime)
+	userRepository.On("GetByID", ctx, value_objects.UserID("user1")).Return(entities.User{ID: "user1", Team: "backend"}, nil)
+	userRepository.On("SetTeam", ctx, []value_objects.UserID{"user1"}, value_objects.TeamName("")).Return(nil)
+	teamRepository.On("AddMembershipChanges", ctx, expectedChanges).Return(nil)
+	txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+	service := NewTeamService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+	changes, report, err := service.RemoveMembers(ctx, "backend", []value_objects.UserID{"user1", "user1"}, MembershipOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedChanges, changes)
+	assert.Empty(t, report.Reassigned)
+	pullRequestRepository.AssertNotCalled(t, "GetByReviewer", mock.Anything, mock.Anything)
+}
+
+func TestTeamService_Archive(t *testing.T) {
+	ctx := context.Background()
+	fixedTime := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("successfully archive team", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		timeProvider := &mocks.TimeProvider{}
+
+		members := []entities.User{{ID: "user1", Team: "backend", IsActive: true}}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		timeProvider.On("Now").Return(fixedTime)
+		teamRepository.On("Archive", ctx, value_objects.TeamName("backend"), fixedTime).Return(nil)
+		userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("backend")).Return(members, nil)
+		txManager := &mocks.TxManager{}
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		team, resultMembers, err := service.Archive(ctx, "backend")
+
+		require.NoError(t, err)
+		require.NotNil(t, team.ArchivedAt)
+		assert.Equal(t, fixedTime, *team.ArchivedAt)
+		assert.Equal(t, members, resultMembers)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("fail when team is already archived", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend", ArchivedAt: &fixedTime}, nil)
+		txManager := &mocks.TxManager{}
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(&mocks.UserRepository{}, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, _, err := service.Archive(ctx, "backend")
+
+		assert.ErrorIs(t, err, domain.ErrTeamArchived)
+		teamRepository.AssertNotCalled(t, "Archive", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("return error when txManager is nil", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+
+		service := NewTeamService(&mocks.UserRepository{}, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, _, err := service.Archive(ctx, "backend")
+
+		assert.ErrorIs(t, err, app.ErrTransactionRequired)
+		teamRepository.AssertNotCalled(t, "GetByName", mock.Anything, mock.Anything)
+	})
+
+	t.Run("refuse adding members to archived team", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend", ArchivedAt: &fixedTime}, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(&mocks.UserRepository{}, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.AddMembers(ctx, "backend", []entities.User{{ID: "user1", Team: "backend"}})
+
+		assert.ErrorIs(t, err, domain.ErrTeamArchived)
+	})
+}
+
+func TestTeamService_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successfully delete unused team", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("backend")).Return([]entities.User{}, nil)
+		teamRepository.On("GetAll", ctx, true).Return([]entities.Team{{Name: "backend"}, {Name: "frontend"}}, nil)
+		pullRequestRepository.On("CountOpenByTeam", ctx, value_objects.TeamName("backend")).Return(0, nil)
+		teamRepository.On("Delete", ctx, value_objects.TeamName("backend")).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		err := service.Delete(ctx, "backend")
+
+		require.NoError(t, err)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("fail when team still has members", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("backend")).Return([]entities.User{{ID: "user1", Team: "backend"}}, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		err := service.Delete(ctx, "backend")
+
+		assert.ErrorIs(t, err, domain.ErrTeamInUse)
+		teamRepository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("fail when team still has open pull requests", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		pullRequestRepository := &mocks.PullRequestRepository{}
+		txManager := &mocks.TxManager{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("backend")).Return([]entities.User{}, nil)
+		teamRepository.On("GetAll", ctx, true).Return([]entities.Team{{Name: "backend"}, {Name: "frontend"}}, nil)
+		pullRequestRepository.On("CountOpenByTeam", ctx, value_objects.TeamName("backend")).Return(2, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, pullRequestRepository, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		err := service.Delete(ctx, "backend")
+
+		assert.ErrorIs(t, err, domain.ErrTeamInUse)
+		teamRepository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	setup := func(t *testing.T) (TeamService, app.PullRequestRepository, app.TeamRepository) {
+		t.Helper()
+
+		store := memory.NewStore()
+		userRepository := memory.NewUserRepository(store)
+		teamRepository := memory.NewTeamRepository(store)
+		pullRequestRepository := memory.NewPullRequestRepository(store)
+
+		_, err := teamRepository.Create(ctx, entities.Team{Name: "backend"})
+		require.NoError(t, err)
+		_, err = teamRepository.Create(ctx, entities.Team{Name: "platform"})
+		require.NoError(t, err)
+		require.NoError(t, userRepository.UpsertMembers(ctx, "backend", []entities.User{
+			{ID: "author", Username: "Author", IsActive: true},
+			{ID: "p1", Username: "Paul", IsActive: true},
+		}))
+
+		service := NewTeamService(userRepository, teamRepository, pullRequestRepository, memory.NewTxManager(store), &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+
+		return service, pullRequestRepository, teamRepository
+	}
+
+	t.Run("fail when team still fills reviewer slots of open pull requests", func(t *testing.T) {
+		service, pullRequestRepository, _ := setup(t)
+
+		pullRequest := entities.NewPullRequest("pr-1", "Add search", "author", time.Now())
+		pullRequest.AddReviewers([]value_objects.UserID{"p1"})
+		pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: "p1", SourceTeam: "platform"})
+		require.NoError(t, pullRequestRepository.Create(ctx, pullRequest))
+
+		err := service.Delete(ctx, "platform")
+
+		assert.ErrorIs(t, err, domain.ErrTeamInUse)
+	})
+
+	t.Run("fail when drafts still borrow reviewers from team", func(t *testing.T) {
+		service, pullRequestRepository, _ := setup(t)
+
+		pullRequest := entities.NewPullRequest("pr-1", "Add search", "author", time.Now())
+		pullRequest.Status = entities.StatusDraft
+		pullRequest.BorrowedReviewers = []entities.ReviewerQuota{{TeamName: "platform", Count: 1}}
+		require.NoError(t, pullRequestRepository.Create(ctx, pullRequest))
+
+		err := service.Delete(ctx, "platform")
+
+		assert.ErrorIs(t, err, domain.ErrTeamInUse)
+	})
+
+	t.Run("fail when team has child teams", func(t *testing.T) {
+		service, _, teamRepository := setup(t)
+
+		_, err := teamRepository.Create(ctx, entities.Team{Name: "platform-infra", Parent: "platform"})
+		require.NoError(t, err)
+
+		err = service.Delete(ctx, "platform")
+
+		assert.ErrorIs(t, err, domain.ErrTeamInUse)
+	})
+}
+
+func TestTeamService_Rename(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successfully rename team", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		members := []entities.User{{ID: "user1", Team: "core", IsActive: true}}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{ID: "team-1", Name: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("core")).Return(entities.Team{}, domain.ErrTeamNotFound)
+		teamRepository.On("Rename", ctx, value_objects.TeamName("backend"), value_objects.TeamName("core")).Return(nil)
+		userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("core")).Return(members, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(userRepository, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		team, resultMembers, err := service.Rename(ctx, "backend", "core")
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.Team{ID: "team-1", Name: "core"}, team)
+		assert.Equal(t, members, resultMembers)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("fail when new name is taken", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{ID: "team-1", Name: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("frontend")).Return(entities.Team{ID: "team-2", Name: "frontend"}, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(&mocks.UserRepository{}, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, _, err := service.Rename(ctx, "backend", "frontend")
+
+		assert.ErrorIs(t, err, domain.ErrTeamExists)
+		teamRepository.AssertNotCalled(t, "Rename", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTeamService_SetParent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successfully set parent team", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("engineering")).Return(entities.Team{Name: "engineering"}, nil)
+		teamRepository.On("UpdateParent", ctx, value_objects.TeamName("backend"), value_objects.TeamName("engineering")).Return(nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(&mocks.UserRepository{}, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		team, err := service.SetParent(ctx, "backend", "engineering")
+
+		require.NoError(t, err)
+		assert.Equal(t, value_objects.TeamName("engineering"), team.Parent)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("fail when parent is the team itself", func(t *testing.T) {
+		service := NewTeamService(&mocks.UserRepository{}, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.SetParent(ctx, "backend", "backend")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidTeamParent)
+	})
+
+	t.Run("fail when parent is a descendant of the team", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+		txManager := &mocks.TxManager{}
+
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("engineering")).Return(entities.Team{Name: "engineering"}, nil)
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("payments")).Return(entities.Team{Name: "payments", Parent: "backend"}, nil)
+		teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend", Parent: "engineering"}, nil)
+		txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+		service := NewTeamService(&mocks.UserRepository{}, teamRepository, &mocks.PullRequestRepository{}, txManager, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.SetParent(ctx, "engineering", "payments")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidTeamParent)
+		teamRepository.AssertNotCalled(t, "UpdateParent", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTeamService_SetReviewSLA(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successfully change sla", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+
+		teamName := value_objects.TeamName("backend")
+		sla := entities.ReviewSLA{RemindAfter: 24 * time.Hour, EscalateAfter: 72 * time.Hour}
+
+		teamRepository.On("GetByName", ctx, teamName).Return(entities.Team{Name: teamName}, nil)
+		teamRepository.On("UpdateReviewSLA", ctx, teamName, sla).Return(nil)
+
+		service := NewTeamService(&mocks.UserRepository{}, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		resultTeam, err := service.SetReviewSLA(ctx, teamName, sla)
+
+		assert.NoError(t, err)
+		assert.Equal(t, sla, resultTeam.ReviewSLA)
+		teamRepository.AssertExpectations(t)
+	})
+
+	t.Run("reject escalation before reminder", func(t *testing.T) {
+		teamRepository := &mocks.TeamRepository{}
+
+		service := NewTeamService(&mocks.UserRepository{}, teamRepository, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.SetReviewSLA(ctx, "backend", entities.ReviewSLA{RemindAfter: 72 * time.Hour, EscalateAfter: 24 * time.Hour})
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidReviewSLA))
+		teamRepository.AssertNotCalled(t, "UpdateReviewSLA", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
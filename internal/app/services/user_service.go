@@ -2,34 +2,132 @@ package services
 
 import (
 	"context"
+	"errors"
 
 	"pr-service/internal/app"
+	"pr-service/internal/domain"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
 )
 
 type UserService interface {
-	SetActiveStatus(ctx context.Context, userID value_objects.UserID, isActive bool) (entities.User, error)
-	GetUserReviews(ctx context.Context, userID value_objects.UserID) ([]entities.PullRequest, error)
+	SetActiveStatus(ctx context.Context, userID value_objects.UserID, isActive bool, options SetActiveOptions) (StatusChange, error)
+	GetUserReviews(ctx context.Context, userID value_objects.UserID, filter ReviewsFilter) ([]entities.PullRequest, error)
+	AddAvailabilityWindow(ctx context.Context, window entities.AvailabilityWindow) (entities.AvailabilityWindow, error)
+	GetAvailability(ctx context.Context, userID value_objects.UserID) (Availability, error)
+	DeleteAvailabilityWindow(ctx context.Context, userID value_objects.UserID, windowID int64) error
+	SetMaxOpenReviews(ctx context.Context, userID value_objects.UserID, maxOpenReviews int) (entities.User, error)
+	GetReviewLoad(ctx context.Context, userID value_objects.UserID) (ReviewLoad, error)
+}
+
+type SetActiveOptions struct {
+	ReassignOpenReviews bool
+}
+
+type ReviewsFilter struct {
+	PendingOnly bool
+}
+
+type ReviewLoad struct {
+	OpenReviews    int
+	MaxOpenReviews int
+}
+
+type StatusChange struct {
+	User         entities.User
+	Load         ReviewLoad
+	Reassignment ReassignmentReport
+}
+
+type Availability struct {
+	Windows      []entities.AvailabilityWindow
+	AvailableNow bool
 }
 
 type userService struct {
 	userRepository  app.UserRepository
+	teamRepository  app.TeamRepository
 	pullRequestRepo app.PullRequestRepository
+	txManager       app.TxManager
+	timeProvider    app.TimeProvider
+	outboxRepo      app.OutboxRepository
+	auditRepo       app.AuditRepository
+	reassigner      *reviewerReassigner
 }
 
-func NewUserService(userRepository app.UserRepository, pullRequestRepo app.PullRequestRepository) UserService {
+func NewUserService(userRepository app.UserRepository, teamRepository app.TeamRepository, pullRequestRepo app.PullRequestRepository, txManager app.TxManager, timeProvider app.TimeProvider, assignmentStrategy app.ReviewerAssignmentStrategy, outboxRepo app.OutboxRepository, auditRepo app.AuditRepository) UserService {
 	return &userService{
 		userRepository:  userRepository,
+		teamRepository:  teamRepository,
 		pullRequestRepo: pullRequestRepo,
+		txManager:       txManager,
+		timeProvider:    timeProvider,
+		outboxRepo:      outboxRepo,
+		auditRepo:       auditRepo,
+		reassigner:      newReviewerReassigner(userRepository, teamRepository, pullRequestRepo, timeProvider, assignmentStrategy),
 	}
 }
 
-func (s *userService) SetActiveStatus(ctx context.Context, userID value_objects.UserID, isActive bool) (entities.User, error) {
-	return s.userRepository.SetIsActive(ctx, userID, isActive)
+func (s *userService) SetActiveStatus(ctx context.Context, userID value_objects.UserID, isActive bool, options SetActiveOptions) (StatusChange, error) {
+	if s.txManager == nil {
+		return StatusChange{}, app.ErrTransactionRequired
+	}
+
+	var statusChange StatusChange
+
+	operation := func(ctx context.Context) error {
+		previousUser, err := s.userRepository.GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		user, err := s.userRepository.SetIsActive(ctx, userID, isActive)
+		if err != nil {
+			return err
+		}
+
+		var report ReassignmentReport
+		now := s.timeProvider.Now()
+		auditEntries := userActivationAuditEntries(ctx, []entities.User{previousUser}, []entities.User{user}, now)
+
+		if !isActive {
+			if options.ReassignOpenReviews {
+				report, err = s.reassigner.reassignOpenReviews(ctx, userID, reassignScope{})
+				if err != nil {
+					return err
+				}
+			}
+
+			events := append(userDeactivatedEvents([]entities.User{user}, now), reviewerReassignedEvents(report.Reassigned, now)...)
+			if err := s.outboxRepo.Add(ctx, events...); err != nil {
+				return err
+			}
+
+			auditEntries = append(auditEntries, reviewerReassignedAuditEntries(ctx, report, now)...)
+		}
+
+		if err := s.auditRepo.Add(ctx, auditEntries...); err != nil {
+			return err
+		}
+
+		load, err := s.reviewLoad(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		statusChange = StatusChange{User: user, Load: load, Reassignment: report}
+
+		return nil
+	}
+
+	if err := s.txManager.Do(ctx, operation); err != nil {
+		return StatusChange{}, err
+	}
+
+	return statusChange, nil
 }
 
-func (s *userService) GetUserReviews(ctx context.Context, userID value_objects.UserID) ([]entities.PullRequest, error) {
+func (s *userService) GetUserReviews(ctx context.Context, userID value_objects.UserID, filter ReviewsFilter) ([]entities.PullRequest, error) {
 	_, err := s.userRepository.GetByID(ctx, userID)
 	if err != nil {
 		return nil, err
@@ -40,5 +138,98 @@ func (s *userService) GetUserReviews(ctx context.Context, userID value_objects.U
 		return nil, err
 	}
 
+	if filter.PendingOnly {
+		return filterPendingReviews(userID, pullRequests), nil
+	}
+
 	return pullRequests, nil
 }
+
+func (s *userService) AddAvailabilityWindow(ctx context.Context, window entities.AvailabilityWindow) (entities.AvailabilityWindow, error) {
+	if err := window.Validate(); err != nil {
+		return entities.AvailabilityWindow{}, err
+	}
+
+	if _, err := s.userRepository.GetByID(ctx, window.UserID); err != nil {
+		return entities.AvailabilityWindow{}, err
+	}
+
+	return s.userRepository.AddAvailabilityWindow(ctx, window)
+}
+
+func (s *userService) GetAvailability(ctx context.Context, userID value_objects.UserID) (Availability, error) {
+	if _, err := s.userRepository.GetByID(ctx, userID); err != nil {
+		return Availability{}, err
+	}
+
+	windows, err := s.userRepository.GetAvailabilityWindows(ctx, userID)
+	if err != nil {
+		return Availability{}, err
+	}
+
+	availability := Availability{Windows: windows, AvailableNow: true}
+	now := s.timeProvider.Now()
+
+	for _, window := range windows {
+		if window.Contains(now) {
+			availability.AvailableNow = false
+			break
+		}
+	}
+
+	return availability, nil
+}
+
+func (s *userService) DeleteAvailabilityWindow(ctx context.Context, userID value_objects.UserID, windowID int64) error {
+	return s.userRepository.DeleteAvailabilityWindow(ctx, userID, windowID)
+}
+
+func (s *userService) SetMaxOpenReviews(ctx context.Context, userID value_objects.UserID, maxOpenReviews int) (entities.User, error) {
+	if err := entities.ValidateMaxOpenReviews(maxOpenReviews); err != nil {
+		return entities.User{}, err
+	}
+
+	return s.userRepository.SetMaxOpenReviews(ctx, userID, maxOpenReviews)
+}
+
+func (s *userService) GetReviewLoad(ctx context.Context, userID value_objects.UserID) (ReviewLoad, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return ReviewLoad{}, err
+	}
+
+	return s.reviewLoad(ctx, user)
+}
+
+func (s *userService) reviewLoad(ctx context.Context, user entities.User) (ReviewLoad, error) {
+	var team entities.Team
+	var err error
+	if user.Team != "" {
+		team, err = s.teamRepository.GetByName(ctx, user.Team)
+		if err != nil && !errors.Is(err, domain.ErrTeamNotFound) {
+			return ReviewLoad{}, err
+		}
+	}
+
+	openReviews, err := s.pullRequestRepo.CountOpenReviews(ctx, []value_objects.UserID{user.ID})
+	if err != nil {
+		return ReviewLoad{}, err
+	}
+
+	return ReviewLoad{
+		OpenReviews:    openReviews[user.ID],
+		MaxOpenReviews: user.ReviewCapacity(team),
+	}, nil
+}
+
+func filterPendingReviews(userID value_objects.UserID, pullRequests []entities.PullRequest) []entities.PullRequest {
+	var pendingPullRequests []entities.PullRequest
+
+	for _, pullRequest := range pullRequests {
+		if pullRequest.Status == entities.StatusOpen && pullRequest.Review(userID).IsPending() {
+			pendingPullRequests = append(pendingPullRequests, pullRequest)
+		}
+	}
+
+	return pendingPullRequests
+}
@@ -8,11 +8,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"pr-service/internal/app"
+	"pr-service/internal/app/assignment"
 	"pr-service/internal/app/services/mocks"
 	"pr-service/internal/domain"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/memory"
 )
 
 func TestUserService_SetActiveStatus(t *testing.T) {
@@ -37,6 +41,9 @@ func TestUserService_SetActiveStatus(t *testing.T) {
 					Team:     value_objects.TeamName("backend"),
 					IsActive: true,
 				}
+				previousUser := expectedUser
+				previousUser.IsActive = false
+				userRepository.On("GetByID", ctx, value_objects.UserID("user1")).Return(previousUser, nil)
 				userRepository.On("SetIsActive", ctx, value_objects.UserID("user1"), true).Return(expectedUser, nil)
 			},
 			expectedUser: entities.User{
@@ -58,6 +65,9 @@ func TestUserService_SetActiveStatus(t *testing.T) {
 					Team:     value_objects.TeamName("backend"),
 					IsActive: false,
 				}
+				previousUser := expectedUser
+				previousUser.IsActive = true
+				userRepository.On("GetByID", ctx, value_objects.UserID("user2")).Return(previousUser, nil)
 				userRepository.On("SetIsActive", ctx, value_objects.UserID("user2"), false).Return(expectedUser, nil)
 			},
 			expectedUser: entities.User{
@@ -73,7 +83,7 @@ func TestUserService_SetActiveStatus(t *testing.T) {
 			userID:   value_objects.UserID("nonexistent"),
 			isActive: true,
 			setupMocks: func(userRepository *mocks.UserRepository, pullRequestRepository *mocks.PullRequestRepository) {
-				userRepository.On("SetIsActive", ctx, value_objects.UserID("nonexistent"), true).Return(entities.User{}, domain.ErrUserNotFound)
+				userRepository.On("GetByID", ctx, value_objects.UserID("nonexistent")).Return(entities.User{}, domain.ErrUserNotFound)
 			},
 			expectedUser:  entities.User{},
 			expectedError: domain.ErrUserNotFound,
@@ -85,17 +95,25 @@ func TestUserService_SetActiveStatus(t *testing.T) {
 			userRepository := &mocks.UserRepository{}
 			pullRequestRepository := &mocks.PullRequestRepository{}
 			tt.setupMocks(userRepository, pullRequestRepository)
+			timeProvider := &mocks.TimeProvider{}
+			timeProvider.On("Now").Return(time.Now()).Maybe()
+			txManager := &mocks.TxManager{}
+			txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil).Maybe()
+			teamRepository := &mocks.TeamRepository{}
+			teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil).Maybe()
+			pullRequestRepository.On("CountOpenReviews", ctx, []value_objects.UserID{tt.userID}).Return(map[value_objects.UserID]int{tt.userID: 1}, nil).Maybe()
 
-			service := NewUserService(userRepository, pullRequestRepository)
+			service := NewUserService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
 
-			resultUser, err := service.SetActiveStatus(ctx, tt.userID, tt.isActive)
+			statusChange, err := service.SetActiveStatus(ctx, tt.userID, tt.isActive, SetActiveOptions{})
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
 				assert.True(t, errors.Is(err, domain.ErrUserNotFound))
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedUser, resultUser)
+				assert.Equal(t, tt.expectedUser, statusChange.User)
+				assert.Equal(t, 1, statusChange.Load.OpenReviews)
 			}
 
 			userRepository.AssertExpectations(t)
@@ -207,9 +225,9 @@ func TestUserService_GetUserReviews(t *testing.T) {
 			pullRequestRepository := &mocks.PullRequestRepository{}
 			tt.setupMocks(userRepository, pullRequestRepository)
 
-			service := NewUserService(userRepository, pullRequestRepository)
+			service := NewUserService(userRepository, &mocks.TeamRepository{}, pullRequestRepository, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
 
-			resultPullRequests, err := service.GetUserReviews(ctx, tt.userID)
+			resultPullRequests, err := service.GetUserReviews(ctx, tt.userID, ReviewsFilter{})
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -241,9 +259,9 @@ func TestUserService_GetUserReviews_DoesNotCallPullRequestRepositoryWhenUserNotF
 
 	userRepository.On("GetByID", ctx, value_objects.UserID("nonexistent")).Return(entities.User{}, domain.ErrUserNotFound)
 
-	service := NewUserService(userRepository, pullRequestRepository)
+	service := NewUserService(userRepository, &mocks.TeamRepository{}, pullRequestRepository, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
 
-	resultPullRequests, err := service.GetUserReviews(ctx, "nonexistent")
+	resultPullRequests, err := service.GetUserReviews(ctx, "nonexistent", ReviewsFilter{})
 
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, domain.ErrUserNotFound))
@@ -252,3 +270,271 @@ func TestUserService_GetUserReviews_DoesNotCallPullRequestRepositoryWhenUserNotF
 	pullRequestRepository.AssertNotCalled(t, "GetByReviewer", mock.Anything, mock.Anything)
 	userRepository.AssertExpectations(t)
 }
+
+func TestUserService_GetUserReviews_PendingOnly(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	userRepository := &mocks.UserRepository{}
+	pullRequestRepository := &mocks.PullRequestRepository{}
+
+	pending := entities.NewPullRequest("pullRequest1", "Feature A", "user2", now)
+	pending.AddReviewers([]value_objects.UserID{"user1"})
+
+	approved := entities.NewPullRequest("pullRequest2", "Feature B", "user2", now)
+	approved.AddReviewers([]value_objects.UserID{"user1"})
+	_, err := approved.SubmitReview("user1", entities.DecisionApproved, now)
+	require.NoError(t, err)
+
+	merged := entities.NewPullRequest("pullRequest3", "Feature C", "user2", now)
+	merged.AddReviewers([]value_objects.UserID{"user1"})
+	merged.Merge(now)
+
+	userRepository.On("GetByID", ctx, value_objects.UserID("user1")).Return(entities.User{ID: "user1"}, nil)
+	pullRequestRepository.On("GetByReviewer", ctx, value_objects.UserID("user1")).Return([]entities.PullRequest{*pending, *approved, *merged}, nil)
+
+	service := NewUserService(userRepository, &mocks.TeamRepository{}, pullRequestRepository, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+
+	resultPullRequests, err := service.GetUserReviews(ctx, "user1", ReviewsFilter{PendingOnly: true})
+
+	require.NoError(t, err)
+	require.Len(t, resultPullRequests, 1)
+	assert.Equal(t, value_objects.PullRequestID("pullRequest1"), resultPullRequests[0].ID)
+}
+
+func TestUserService_SetActiveStatus_ReassignOpenReviews(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	userRepository := &mocks.UserRepository{}
+	teamRepository := &mocks.TeamRepository{}
+	pullRequestRepository := &mocks.PullRequestRepository{}
+	txManager := &mocks.TxManager{}
+	timeProvider := &mocks.TimeProvider{}
+	random := &mocks.RandomProvider{}
+
+	deactivated := entities.User{ID: "user1", Username: "Alice", Team: "backend", IsActive: false}
+	backendAuthor := entities.User{ID: "user2", Username: "Bob", Team: "backend", IsActive: true}
+	frontendAuthor := entities.User{ID: "user4", Username: "Dave", Team: "frontend", IsActive: true}
+
+	backendPullRequest := entities.NewPullRequest("pullRequest1", "Feature A", backendAuthor.ID, now)
+	backendPullRequest.AddReviewers([]value_objects.UserID{"user1"})
+
+	frontendPullRequest := entities.NewPullRequest("pullRequest2", "Feature B", frontendAuthor.ID, now)
+	frontendPullRequest.AddReviewers([]value_objects.UserID{"user1"})
+
+	mergedPullRequest := entities.NewPullRequest("pullRequest3", "Feature C", backendAuthor.ID, now)
+	mergedPullRequest.AddReviewers([]value_objects.UserID{"user1"})
+	mergedPullRequest.Merge(now)
+
+	previousUser := deactivated
+	previousUser.IsActive = true
+	userRepository.On("GetByID", ctx, deactivated.ID).Return(previousUser, nil)
+	userRepository.On("SetIsActive", ctx, deactivated.ID, false).Return(deactivated, nil)
+	pullRequestRepository.On("GetByReviewer", ctx, deactivated.ID).
+		Return([]entities.PullRequest{*backendPullRequest, *frontendPullRequest, *mergedPullRequest}, nil)
+
+	userRepository.On("GetByID", ctx, backendAuthor.ID).Return(backendAuthor, nil)
+	teamRepository.On("GetByName", ctx, value_objects.TeamName("backend")).Return(entities.Team{Name: "backend"}, nil)
+	userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("backend")).Return([]entities.User{
+		deactivated,
+		backendAuthor,
+		{ID: "user3", Username: "Carol", Team: "backend", IsActive: true},
+	}, nil)
+
+	userRepository.On("GetByID", ctx, frontendAuthor.ID).Return(frontendAuthor, nil)
+	teamRepository.On("GetByName", ctx, value_objects.TeamName("frontend")).Return(entities.Team{Name: "frontend"}, nil)
+	userRepository.On("GetUsersByTeam", ctx, value_objects.TeamName("frontend")).Return([]entities.User{frontendAuthor}, nil)
+
+	timeProvider.On("Now").Return(now)
+	expectAllAvailable(userRepository)
+	random.On("Shuffle", mock.AnythingOfType("int"), mock.AnythingOfType("func(int, int)"))
+	pullRequestRepository.On("Save", ctx, mock.AnythingOfType("*entities.PullRequest")).Return(nil)
+	pullRequestRepository.On("ReassignReviewer", ctx, value_objects.PullRequestID("pullRequest1"), deactivated.ID, entities.ReviewerAssignment{ReviewerID: "user3", AssignedAt: now}).Return(nil)
+	pullRequestRepository.On("CountOpenReviews", ctx, []value_objects.UserID{deactivated.ID}).Return(map[value_objects.UserID]int{}, nil)
+	txManager.On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).Return(nil)
+
+	auditRepository := memory.NewAuditRepository(memory.NewStore())
+	service := NewUserService(userRepository, teamRepository, pullRequestRepository, txManager, timeProvider, assignment.NewRandom(random), memory.NewOutboxRepository(memory.NewStore()), auditRepository)
+
+	statusChange, err := service.SetActiveStatus(ctx, deactivated.ID, false, SetActiveOptions{ReassignOpenReviews: true})
+
+	require.NoError(t, err)
+	report := statusChange.Reassignment
+	assert.Equal(t, deactivated, statusChange.User)
+	assert.Equal(t, 0, statusChange.Load.OpenReviews)
+	assert.Equal(t, []ReviewReassignment{
+		{PullRequestID: "pullRequest1", OldReviewerID: "user1", NewReviewerID: "user3"},
+	}, report.Reassigned)
+	assert.Equal(t, []value_objects.PullRequestID{"pullRequest2"}, report.WithoutCandidate)
+	pullRequestRepository.AssertNumberOfCalls(t, "Save", 1)
+	pullRequestRepository.AssertExpectations(t)
+
+	entries, err := auditRepository.Find(ctx, entities.AuditQuery{EntityType: entities.AuditEntityUser})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, entities.AuditUserDeactivated, entries[0].Action)
+	assert.Equal(t, true, entries[0].Before["is_active"])
+	assert.Equal(t, false, entries[0].After["is_active"])
+
+	entries, err = auditRepository.Find(ctx, entities.AuditQuery{EntityType: entities.AuditEntityPullRequest})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, entities.AuditReviewerReassigned, entries[0].Action)
+	assert.Equal(t, "pullRequest1", entries[0].EntityID)
+	assert.Equal(t, []string{"user1"}, entries[0].Before["reviewer_ids"])
+	assert.Equal(t, []string{"user3"}, entries[0].After["reviewer_ids"])
+}
+
+func TestUserService_SetActiveStatus_ReassignRequiresTransaction(t *testing.T) {
+	ctx := context.Background()
+	userRepository := &mocks.UserRepository{}
+
+	service := NewUserService(userRepository, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, nil, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+
+	_, err := service.SetActiveStatus(ctx, "user1", false, SetActiveOptions{ReassignOpenReviews: true})
+
+	assert.ErrorIs(t, err, app.ErrTransactionRequired)
+	userRepository.AssertNotCalled(t, "SetIsActive", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserService_AddAvailabilityWindow(t *testing.T) {
+	ctx := context.Background()
+	startsAt := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	window := entities.AvailabilityWindow{UserID: "user1", StartsAt: startsAt, EndsAt: startsAt.Add(72 * time.Hour), Reason: "vacation"}
+
+	t.Run("successfully add window", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		saved := window
+		saved.ID = 1
+
+		userRepository.On("GetByID", ctx, window.UserID).Return(entities.User{ID: window.UserID}, nil)
+		userRepository.On("AddAvailabilityWindow", ctx, window).Return(saved, nil)
+
+		service := NewUserService(userRepository, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.AddAvailabilityWindow(ctx, window)
+
+		require.NoError(t, err)
+		assert.Equal(t, saved, result)
+		userRepository.AssertExpectations(t)
+	})
+
+	t.Run("reject window that ends before it starts", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		invalid := window
+		invalid.EndsAt = startsAt.Add(-time.Hour)
+
+		service := NewUserService(userRepository, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.AddAvailabilityWindow(ctx, invalid)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidAvailabilityWindow)
+		userRepository.AssertNotCalled(t, "AddAvailabilityWindow", mock.Anything, mock.Anything)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+
+		userRepository.On("GetByID", ctx, window.UserID).Return(entities.User{}, domain.ErrUserNotFound)
+
+		service := NewUserService(userRepository, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.AddAvailabilityWindow(ctx, window)
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+		userRepository.AssertNotCalled(t, "AddAvailabilityWindow", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserService_GetAvailability(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 7, 2, 12, 0, 0, 0, time.UTC)
+	windows := []entities.AvailabilityWindow{
+		{ID: 1, UserID: "user1", StartsAt: now.Add(-48 * time.Hour), EndsAt: now.Add(-24 * time.Hour)},
+		{ID: 2, UserID: "user1", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+	}
+
+	tests := []struct {
+		name                 string
+		windows              []entities.AvailabilityWindow
+		expectedAvailableNow bool
+	}{
+		{name: "unavailable during active window", windows: windows, expectedAvailableNow: false},
+		{name: "available when only past windows", windows: windows[:1], expectedAvailableNow: true},
+		{name: "available without windows", windows: nil, expectedAvailableNow: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepository := &mocks.UserRepository{}
+			timeProvider := &mocks.TimeProvider{}
+
+			userRepository.On("GetByID", ctx, value_objects.UserID("user1")).Return(entities.User{ID: "user1"}, nil)
+			userRepository.On("GetAvailabilityWindows", ctx, value_objects.UserID("user1")).Return(tt.windows, nil)
+			timeProvider.On("Now").Return(now)
+
+			service := NewUserService(userRepository, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, &mocks.TxManager{}, timeProvider, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+			availability, err := service.GetAvailability(ctx, "user1")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAvailableNow, availability.AvailableNow)
+			assert.Equal(t, tt.windows, availability.Windows)
+		})
+	}
+}
+
+func TestUserService_SetMaxOpenReviews(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successfully set limit", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+		updated := entities.User{ID: "user1", MaxOpenReviews: 3}
+
+		userRepository.On("SetMaxOpenReviews", ctx, value_objects.UserID("user1"), 3).Return(updated, nil)
+
+		service := NewUserService(userRepository, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		result, err := service.SetMaxOpenReviews(ctx, "user1", 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, updated, result)
+	})
+
+	t.Run("reject negative limit", func(t *testing.T) {
+		userRepository := &mocks.UserRepository{}
+
+		service := NewUserService(userRepository, &mocks.TeamRepository{}, &mocks.PullRequestRepository{}, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+		_, err := service.SetMaxOpenReviews(ctx, "user1", -1)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidMaxOpenReviews)
+		userRepository.AssertNotCalled(t, "SetMaxOpenReviews", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserService_GetReviewLoad(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name                   string
+		user                   entities.User
+		expectedMaxOpenReviews int
+	}{
+		{name: "team default", user: entities.User{ID: "user1", Team: "backend"}, expectedMaxOpenReviews: 4},
+		{name: "personal limit wins", user: entities.User{ID: "user1", Team: "backend", MaxOpenReviews: 2}, expectedMaxOpenReviews: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepository := &mocks.UserRepository{}
+			teamRepository := &mocks.TeamRepository{}
+			pullRequestRepository := &mocks.PullRequestRepository{}
+
+			userRepository.On("GetByID", ctx, tt.user.ID).Return(tt.user, nil)
+			teamRepository.On("GetByName", ctx, tt.user.Team).Return(entities.Team{Name: "backend", MaxOpenReviews: 4}, nil)
+			pullRequestRepository.On("CountOpenReviews", ctx, []value_objects.UserID{tt.user.ID}).
+				Return(map[value_objects.UserID]int{tt.user.ID: 3}, nil)
+
+			service := NewUserService(userRepository, teamRepository, pullRequestRepository, &mocks.TxManager{}, &mocks.TimeProvider{}, assignment.NewRandom(&mocks.RandomProvider{}), memory.NewOutboxRepository(memory.NewStore()), memory.NewAuditRepository(memory.NewStore()))
+			load, err := service.GetReviewLoad(ctx, tt.user.ID)
+
+			require.NoError(t, err)
+			assert.Equal(t, ReviewLoad{OpenReviews: 3, MaxOpenReviews: tt.expectedMaxOpenReviews}, load)
+		})
+	}
+}
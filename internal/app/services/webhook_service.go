@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+type WebhookService interface {
+	Create(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error)
+	GetAll(ctx context.Context) ([]entities.Webhook, error)
+	Delete(ctx context.Context, webhookID int64) error
+	GetDeadLetters(ctx context.Context, webhookID int64) ([]entities.WebhookDeadLetter, error)
+}
+
+type webhookService struct {
+	webhookRepository app.WebhookRepository
+	timeProvider      app.TimeProvider
+}
+
+func NewWebhookService(webhookRepository app.WebhookRepository, timeProvider app.TimeProvider) WebhookService {
+	return &webhookService{
+		webhookRepository: webhookRepository,
+		timeProvider:      timeProvider,
+	}
+}
+
+func (s *webhookService) Create(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	if err := webhook.Validate(); err != nil {
+		return entities.Webhook{}, err
+	}
+
+	webhook.CreatedAt = s.timeProvider.Now()
+
+	return s.webhookRepository.Create(ctx, webhook)
+}
+
+func (s *webhookService) GetAll(ctx context.Context) ([]entities.Webhook, error) {
+	return s.webhookRepository.GetAll(ctx)
+}
+
+func (s *webhookService) Delete(ctx context.Context, webhookID int64) error {
+	return s.webhookRepository.Delete(ctx, webhookID)
+}
+
+func (s *webhookService) GetDeadLetters(ctx context.Context, webhookID int64) ([]entities.WebhookDeadLetter, error) {
+	return s.webhookRepository.GetDeadLetters(ctx, webhookID)
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/app/services/mocks"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+)
+
+func TestWebhookService_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("successfully create webhook", func(t *testing.T) {
+		webhookRepository := &mocks.WebhookRepository{}
+		timeProvider := &mocks.TimeProvider{}
+		timeProvider.On("Now").Return(now)
+
+		webhook := entities.Webhook{
+			URL:        "https://example.com/hooks",
+			Secret:     "secret",
+			EventTypes: []entities.EventType{entities.EventPullRequestMerged},
+		}
+		expected := webhook
+		expected.CreatedAt = now
+
+		created := expected
+		created.ID = 1
+		webhookRepository.On("Create", ctx, expected).Return(created, nil)
+
+		result, err := NewWebhookService(webhookRepository, timeProvider).Create(ctx, webhook)
+		require.NoError(t, err)
+
+		assert.Equal(t, created, result)
+		webhookRepository.AssertExpectations(t)
+	})
+
+	t.Run("reject invalid webhook", func(t *testing.T) {
+		webhookRepository := &mocks.WebhookRepository{}
+
+		_, err := NewWebhookService(webhookRepository, &mocks.TimeProvider{}).Create(ctx, entities.Webhook{
+			URL:    "ftp://example.com",
+			Secret: "secret",
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidWebhook)
+		webhookRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWebhookService_GetDeadLetters(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("return not found for unknown webhook", func(t *testing.T) {
+		webhookRepository := &mocks.WebhookRepository{}
+		webhookRepository.On("GetDeadLetters", ctx, int64(7)).Return([]entities.WebhookDeadLetter(nil), domain.ErrWebhookNotFound)
+
+		_, err := NewWebhookService(webhookRepository, &mocks.TimeProvider{}).GetDeadLetters(ctx, 7)
+
+		assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+	})
+}
@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"time"
+
+	"pr-service/internal/domain"
+)
+
+const (
+	SystemActor = "system"
+
+	DefaultAuditLimit = 50
+	MaxAuditLimit     = 200
+)
+
+type AuditAction string
+
+const (
+	AuditTeamCreated        AuditAction = "TEAM_CREATED"
+	AuditUserActivated      AuditAction = "USER_ACTIVATED"
+	AuditUserDeactivated    AuditAction = "USER_DEACTIVATED"
+	AuditPullRequestCreated AuditAction = "PULL_REQUEST_CREATED"
+	AuditPullRequestMerged  AuditAction = "PULL_REQUEST_MERGED"
+	AuditReviewerReassigned AuditAction = "REVIEWER_REASSIGNED"
+	AuditReviewerUnassigned AuditAction = "REVIEWER_UNASSIGNED"
+)
+
+type AuditEntityType string
+
+const (
+	AuditEntityTeam        AuditEntityType = "TEAM"
+	AuditEntityUser        AuditEntityType = "USER"
+	AuditEntityPullRequest AuditEntityType = "PULL_REQUEST"
+)
+
+func (t AuditEntityType) IsValid() bool {
+	switch t {
+	case AuditEntityTeam, AuditEntityUser, AuditEntityPullRequest:
+		return true
+	default:
+		return false
+	}
+}
+
+type AuditEntry struct {
+	ID         int64
+	Actor      string
+	Action     AuditAction
+	EntityType AuditEntityType
+	EntityID   string
+	Before     map[string]any
+	After      map[string]any
+	RequestID  string
+	CreatedAt  time.Time
+}
+
+type AuditQuery struct {
+	EntityType AuditEntityType
+	EntityID   string
+	Since      time.Time
+	AfterID    int64
+	Limit      int
+}
+
+func (q AuditQuery) Validate() error {
+	if q.EntityType != "" && !q.EntityType.IsValid() {
+		return domain.ErrInvalidAuditQuery
+	}
+	if q.AfterID < 0 || q.Limit < 0 || q.Limit > MaxAuditLimit {
+		return domain.ErrInvalidAuditQuery
+	}
+
+	return nil
+}
+
+func (q AuditQuery) Matches(entry AuditEntry) bool {
+	if q.EntityType != "" && entry.EntityType != q.EntityType {
+		return false
+	}
+	if q.EntityID != "" && entry.EntityID != q.EntityID {
+		return false
+	}
+	if !q.Since.IsZero() && entry.CreatedAt.Before(q.Since) {
+		return false
+	}
+
+	return entry.ID > q.AfterID
+}
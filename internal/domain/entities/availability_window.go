@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"time"
+
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/value_objects"
+)
+
+type AvailabilityWindow struct {
+	ID       int64
+	UserID   value_objects.UserID
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+}
+
+func (w AvailabilityWindow) Validate() error {
+	if w.StartsAt.IsZero() || !w.EndsAt.After(w.StartsAt) {
+		return domain.ErrInvalidAvailabilityWindow
+	}
+
+	return nil
+}
+
+func (w AvailabilityWindow) Contains(at time.Time) bool {
+	return !at.Before(w.StartsAt) && at.Before(w.EndsAt)
+}
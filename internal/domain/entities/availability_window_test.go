@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"pr-service/internal/domain"
+)
+
+func TestAvailabilityWindow_Validate(t *testing.T) {
+	startsAt := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, AvailabilityWindow{StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}.Validate())
+	assert.Equal(t, domain.ErrInvalidAvailabilityWindow, AvailabilityWindow{StartsAt: startsAt, EndsAt: startsAt}.Validate())
+	assert.Equal(t, domain.ErrInvalidAvailabilityWindow, AvailabilityWindow{EndsAt: startsAt}.Validate())
+}
+
+func TestAvailabilityWindow_Contains(t *testing.T) {
+	startsAt := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	window := AvailabilityWindow{StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)}
+
+	assert.True(t, window.Contains(startsAt))
+	assert.True(t, window.Contains(startsAt.Add(30*time.Minute)))
+	assert.False(t, window.Contains(startsAt.Add(time.Hour)))
+	assert.False(t, window.Contains(startsAt.Add(-time.Second)))
+}
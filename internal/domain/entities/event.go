@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"time"
+)
+
+type EventType string
+
+const (
+	EventPullRequestCreated EventType = "PullRequestCreated"
+	EventReviewerAssigned   EventType = "ReviewerAssigned"
+	EventReviewerReassigned EventType = "ReviewerReassigned"
+	EventReviewerUnassigned EventType = "ReviewerUnassigned"
+	EventPullRequestMerged  EventType = "PullRequestMerged"
+	EventUserDeactivated    EventType = "UserDeactivated"
+	EventTeamCreated        EventType = "TeamCreated"
+)
+
+func (t EventType) IsValid() bool {
+	switch t {
+	case EventPullRequestCreated, EventReviewerAssigned, EventReviewerReassigned, EventReviewerUnassigned, EventPullRequestMerged, EventUserDeactivated, EventTeamCreated:
+		return true
+	default:
+		return false
+	}
+}
+
+type Event struct {
+	Type        EventType
+	AggregateID string
+	OccurredAt  time.Time
+	Payload     map[string]any
+}
+
+type OutboxEntry struct {
+	ID            int64
+	Event         Event
+	DeliveredAt   *time.Time
+	NextAttemptAt *time.Time
+}
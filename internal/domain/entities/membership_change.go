@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"time"
+
+	"pr-service/internal/domain/value_objects"
+)
+
+type MembershipAction string
+
+const (
+	MembershipAdded   MembershipAction = "ADDED"
+	MembershipRemoved MembershipAction = "REMOVED"
+	MembershipMoved   MembershipAction = "MOVED"
+)
+
+type MembershipChange struct {
+	UserID    value_objects.UserID
+	Action    MembershipAction
+	FromTeam  value_objects.TeamName
+	ToTeam    value_objects.TeamName
+	ChangedAt time.Time
+}
@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/value_objects"
+)
+
+type MergeCondition string
+
+const (
+	ConditionMinApprovals       MergeCondition = "MIN_APPROVALS"
+	ConditionNoChangesRequested MergeCondition = "NO_CHANGES_REQUESTED"
+	ConditionLeadApproval       MergeCondition = "LEAD_APPROVAL"
+)
+
+type MergePolicy struct {
+	MinApprovals        int
+	RequireLeadApproval bool
+}
+
+func (p MergePolicy) Validate(leadID value_objects.UserID) error {
+	if p.MinApprovals < 0 || p.MinApprovals > MaxReviewersLimit {
+		return domain.ErrInvalidMergePolicy
+	}
+	if p.RequireLeadApproval && leadID == "" {
+		return domain.ErrInvalidMergePolicy
+	}
+
+	return nil
+}
+
+func (p MergePolicy) UnmetConditions(pullRequest *PullRequest, leadID value_objects.UserID) []MergeCondition {
+	var unmet []MergeCondition
+
+	approvals := 0
+	changesRequested := false
+
+	for _, review := range pullRequest.Reviews() {
+		switch review.Decision {
+		case DecisionApproved:
+			approvals++
+		case DecisionChangesRequested:
+			changesRequested = true
+		}
+	}
+
+	if approvals < p.MinApprovals {
+		unmet = append(unmet, ConditionMinApprovals)
+	}
+	if changesRequested {
+		unmet = append(unmet, ConditionNoChangesRequested)
+	}
+	if p.RequireLeadApproval && (leadID == "" || (leadID != pullRequest.AuthorID && (!pullRequest.IsReviewer(leadID) || pullRequest.Review(leadID).Decision != DecisionApproved))) {
+		unmet = append(unmet, ConditionLeadApproval)
+	}
+
+	return unmet
+}
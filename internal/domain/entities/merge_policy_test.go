@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/value_objects"
+)
+
+func TestMergePolicy_Validate(t *testing.T) {
+	assert.NoError(t, MergePolicy{}.Validate(""))
+	assert.NoError(t, MergePolicy{MinApprovals: 2, RequireLeadApproval: true}.Validate("lead"))
+	assert.Equal(t, domain.ErrInvalidMergePolicy, MergePolicy{MinApprovals: -1}.Validate(""))
+	assert.Equal(t, domain.ErrInvalidMergePolicy, MergePolicy{MinApprovals: MaxReviewersLimit + 1}.Validate(""))
+	assert.Equal(t, domain.ErrInvalidMergePolicy, MergePolicy{RequireLeadApproval: true}.Validate(""))
+}
+
+func TestMergePolicy_UnmetConditions(t *testing.T) {
+	now := time.Now()
+
+	t.Run("empty policy only blocks on requested changes", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		assert.Empty(t, MergePolicy{}.UnmetConditions(pullRequest, ""))
+
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+		_, _ = pullRequest.SubmitReview("user1", DecisionChangesRequested, now)
+
+		assert.Equal(t, []MergeCondition{ConditionNoChangesRequested}, MergePolicy{}.UnmetConditions(pullRequest, ""))
+	})
+
+	t.Run("count approvals", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2"})
+		policy := MergePolicy{MinApprovals: 2}
+
+		_, _ = pullRequest.SubmitReview("user1", DecisionApproved, now)
+		_, _ = pullRequest.SubmitReview("user2", DecisionCommented, now)
+		assert.Equal(t, []MergeCondition{ConditionMinApprovals}, policy.UnmetConditions(pullRequest, ""))
+
+		_, _ = pullRequest.SubmitReview("user2", DecisionApproved, now)
+		assert.Empty(t, policy.UnmetConditions(pullRequest, ""))
+	})
+
+	t.Run("require lead approval", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1", "lead"})
+		policy := MergePolicy{RequireLeadApproval: true}
+
+		_, _ = pullRequest.SubmitReview("user1", DecisionApproved, now)
+		assert.Equal(t, []MergeCondition{ConditionLeadApproval}, policy.UnmetConditions(pullRequest, "lead"))
+
+		_, _ = pullRequest.SubmitReview("lead", DecisionApproved, now)
+		assert.Empty(t, policy.UnmetConditions(pullRequest, "lead"))
+		assert.Equal(t, []MergeCondition{ConditionLeadApproval}, policy.UnmetConditions(pullRequest, ""))
+	})
+
+	t.Run("skip lead approval when lead is the author", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "lead", now)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+		policy := MergePolicy{MinApprovals: 1, RequireLeadApproval: true}
+
+		assert.Equal(t, []MergeCondition{ConditionMinApprovals}, policy.UnmetConditions(pullRequest, "lead"))
+
+		_, _ = pullRequest.SubmitReview("user1", DecisionApproved, now)
+		assert.Empty(t, policy.UnmetConditions(pullRequest, "lead"))
+	})
+}
@@ -10,31 +10,56 @@ import (
 type PullRequestStatus string
 
 const (
+	StatusDraft  PullRequestStatus = "DRAFT"
 	StatusOpen   PullRequestStatus = "OPEN"
 	StatusMerged PullRequestStatus = "MERGED"
+	StatusClosed PullRequestStatus = "CLOSED"
 )
 
-const maxReviewers = 2
+const (
+	DefaultReviewersLimit = 2
+	MaxReviewersLimit     = 10
+)
+
+func ValidateReviewersLimit(limit int) error {
+	if limit < 1 || limit > MaxReviewersLimit {
+		return domain.ErrInvalidReviewersCount
+	}
+
+	return nil
+}
 
 type PullRequest struct {
 	ID       value_objects.PullRequestID
 	Name     string
 	AuthorID value_objects.UserID
 	Status   PullRequestStatus
+	Version  int
 
-	reviewers []value_objects.UserID
+	ReviewersLimit    int
+	BorrowedReviewers []ReviewerQuota
+	reviewers         []value_objects.UserID
+	reviews           map[value_objects.UserID]Review
+	assignments       map[value_objects.UserID]ReviewerAssignment
 
-	CreatedAt time.Time
-	MergedAt  *time.Time
+	CreatedAt       time.Time
+	MergedAt        *time.Time
+	ClosedAt        *time.Time
+	ClosedFromDraft bool
+	ForceMerged     bool
 }
 
 func NewPullRequest(id value_objects.PullRequestID, name string, authorID value_objects.UserID, createdAt time.Time) *PullRequest {
 	return &PullRequest{
-		ID:        id,
-		Name:      name,
-		AuthorID:  authorID,
-		Status:    StatusOpen,
-		reviewers: make([]value_objects.UserID, 0, maxReviewers),
+		ID:       id,
+		Name:     name,
+		AuthorID: authorID,
+		Status:   StatusOpen,
+		Version:  1,
+
+		ReviewersLimit: DefaultReviewersLimit,
+		reviewers:      make([]value_objects.UserID, 0, DefaultReviewersLimit),
+
 		CreatedAt: createdAt,
 	}
 }
@@ -60,16 +85,36 @@ func (pr *PullRequest) IsMerged() bool {
 	return pr.Status == StatusMerged
 }
 
+func (pr *PullRequest) IsOpen() bool {
+	return pr.Status == StatusOpen
+}
+
+func (pr *PullRequest) MaxReviewers() int {
+	if pr.ReviewersLimit <= 0 {
+		return DefaultReviewersLimit
+	}
+
+	return pr.ReviewersLimit
+}
+
 func (pr *PullRequest) IsFullyAssigned() bool {
-	return len(pr.reviewers) == maxReviewers
+	return len(pr.reviewers) >= pr.MaxReviewers()
+}
+
+func (pr *PullRequest) AvailableReviewerSlots() int {
+	if !pr.IsOpen() || pr.IsFullyAssigned() {
+		return 0
+	}
+
+	return pr.MaxReviewers() - len(pr.reviewers)
 }
 
 func (pr *PullRequest) AddReviewers(candidates []value_objects.UserID) []value_objects.UserID {
-	if pr.IsMerged() || pr.IsFullyAssigned() || len(candidates) == 0 {
+	if !pr.IsOpen() || pr.IsFullyAssigned() || len(candidates) == 0 {
 		return nil
 	}
 
-	reviewersLimit := maxReviewers - len(pr.reviewers)
+	reviewersLimit := pr.MaxReviewers() - len(pr.reviewers)
 	addedReviewers := make([]value_objects.UserID, 0, reviewersLimit)
 
 	for _, candidate := range candidates {
@@ -95,10 +140,148 @@ func (pr *PullRequest) SetReviewers(reviewers []value_objects.UserID) {
 	pr.reviewers = reviewers
 }
 
+func (pr *PullRequest) Review(reviewerID value_objects.UserID) Review {
+	if review, ok := pr.reviews[reviewerID]; ok {
+		return review
+	}
+
+	return Review{ReviewerID: reviewerID, Decision: DecisionPending}
+}
+
+func (pr *PullRequest) Reviews() []Review {
+	reviews := make([]Review, 0, len(pr.reviewers))
+
+	for _, reviewerID := range pr.reviewers {
+		reviews = append(reviews, pr.Review(reviewerID))
+	}
+
+	return reviews
+}
+
+func (pr *PullRequest) SetReviews(reviews []Review) {
+	pr.reviews = make(map[value_objects.UserID]Review, len(reviews))
+
+	for _, review := range reviews {
+		pr.reviews[review.ReviewerID] = review
+	}
+}
+
+func (pr *PullRequest) Assignment(reviewerID value_objects.UserID) ReviewerAssignment {
+	if assignment, ok := pr.assignments[reviewerID]; ok {
+		return assignment
+	}
+
+	return ReviewerAssignment{ReviewerID: reviewerID}
+}
+
+func (pr *PullRequest) Assignments() []ReviewerAssignment {
+	assignments := make([]ReviewerAssignment, 0, len(pr.reviewers))
+
+	for _, reviewerID := range pr.reviewers {
+		assignments = append(assignments, pr.Assignment(reviewerID))
+	}
+
+	return assignments
+}
+
+func (pr *PullRequest) SetAssignments(assignments []ReviewerAssignment) {
+	pr.assignments = make(map[value_objects.UserID]ReviewerAssignment, len(assignments))
+
+	for _, assignment := range assignments {
+		pr.assignments[assignment.ReviewerID] = assignment
+	}
+}
+
+func (pr *PullRequest) SetAssignment(assignment ReviewerAssignment) {
+	if !pr.IsReviewer(assignment.ReviewerID) {
+		return
+	}
+
+	if pr.assignments == nil {
+		pr.assignments = make(map[value_objects.UserID]ReviewerAssignment)
+	}
+	pr.assignments[assignment.ReviewerID] = assignment
+}
+
+func (pr *PullRequest) OverdueReviewers(reassignAfter time.Duration, now time.Time) []value_objects.UserID {
+	var overdue []value_objects.UserID
+
+	for _, reviewerID := range pr.reviewers {
+		if !pr.Review(reviewerID).IsPending() {
+			continue
+		}
+
+		assignedAt := pr.Assignment(reviewerID).AssignedAt
+		if assignedAt.IsZero() {
+			assignedAt = pr.CreatedAt
+		}
+
+		if !now.Before(assignedAt.Add(reassignAfter)) {
+			overdue = append(overdue, reviewerID)
+		}
+	}
+
+	return overdue
+}
+
+func (pr *PullRequest) SubmitReview(reviewerID value_objects.UserID, decision ReviewDecision, decidedAt time.Time) (Review, error) {
+	if !decision.IsValid() || decision == DecisionPending {
+		return Review{}, domain.ErrInvalidDecision
+	}
+	if pr.IsMerged() {
+		return Review{}, domain.ErrPRMerged
+	}
+	if !pr.IsOpen() {
+		return Review{}, domain.ErrPRNotOpen
+	}
+	if !pr.IsReviewer(reviewerID) {
+		return Review{}, domain.ErrNotAssigned
+	}
+
+	review := Review{
+		ReviewerID: reviewerID,
+		Decision:   decision,
+		DecidedAt:  &decidedAt,
+	}
+
+	if pr.reviews == nil {
+		pr.reviews = make(map[value_objects.UserID]Review)
+	}
+	pr.reviews[reviewerID] = review
+
+	return review, nil
+}
+
+func (pr *PullRequest) ReviewDecision() ReviewDecision {
+	if len(pr.reviewers) == 0 {
+		return DecisionPending
+	}
+
+	approved := 0
+
+	for _, review := range pr.Reviews() {
+		switch review.Decision {
+		case DecisionChangesRequested:
+			return DecisionChangesRequested
+		case DecisionApproved:
+			approved++
+		}
+	}
+
+	if approved == len(pr.reviewers) {
+		return DecisionApproved
+	}
+
+	return DecisionPending
+}
+
 func (pr *PullRequest) ReassignReviewer(oldID, newID value_objects.UserID) error {
 	if pr.IsMerged() {
 		return domain.ErrPRMerged
 	}
+	if !pr.IsOpen() {
+		return domain.ErrPRNotOpen
+	}
 	if !pr.IsReviewer(oldID) {
 		return domain.ErrNotAssigned
 	}
@@ -114,6 +297,8 @@ func (pr *PullRequest) ReassignReviewer(oldID, newID value_objects.UserID) error
 	for i, reviewerID := range pr.reviewers {
 		if reviewerID == oldID {
 			pr.reviewers[i] = newID
+			delete(pr.reviews, oldID)
+			delete(pr.assignments, oldID)
 
 			return nil
 		}
@@ -122,6 +307,36 @@ func (pr *PullRequest) ReassignReviewer(oldID, newID value_objects.UserID) error
 	return domain.ErrNotAssigned
 }
 
+func (pr *PullRequest) RemoveReviewer(id value_objects.UserID) error {
+	if pr.IsMerged() {
+		return domain.ErrPRMerged
+	}
+	if !pr.IsOpen() {
+		return domain.ErrPRNotOpen
+	}
+
+	for i, reviewerID := range pr.reviewers {
+		if reviewerID == id {
+			pr.reviewers = append(pr.reviewers[:i:i], pr.reviewers[i+1:]...)
+			delete(pr.reviews, id)
+			delete(pr.assignments, id)
+
+			return nil
+		}
+	}
+
+	return domain.ErrNotAssigned
+}
+
+func (pr *PullRequest) ForceMerge(mergedAt time.Time) {
+	if pr.Status == StatusMerged {
+		return
+	}
+
+	pr.ForceMerged = true
+	pr.Merge(mergedAt)
+}
+
 func (pr *PullRequest) Merge(mergedAt time.Time) {
 	if pr.Status == StatusMerged {
 		return
@@ -130,3 +345,43 @@ func (pr *PullRequest) Merge(mergedAt time.Time) {
 	pr.Status = StatusMerged
 	pr.MergedAt = &mergedAt
 }
+
+func (pr *PullRequest) MarkReady() error {
+	if pr.Status != StatusDraft {
+		return domain.ErrInvalidTransition
+	}
+
+	pr.Status = StatusOpen
+
+	return nil
+}
+
+func (pr *PullRequest) Close(closedAt time.Time) error {
+	if pr.IsMerged() {
+		return domain.ErrPRMerged
+	}
+	if pr.Status != StatusOpen && pr.Status != StatusDraft {
+		return domain.ErrInvalidTransition
+	}
+
+	pr.ClosedFromDraft = pr.Status == StatusDraft
+	pr.Status = StatusClosed
+	pr.ClosedAt = &closedAt
+
+	return nil
+}
+
+func (pr *PullRequest) Reopen() error {
+	if pr.Status != StatusClosed {
+		return domain.ErrInvalidTransition
+	}
+
+	pr.Status = StatusOpen
+	if pr.ClosedFromDraft {
+		pr.Status = StatusDraft
+	}
+	pr.ClosedAt = nil
+	pr.ClosedFromDraft = false
+
+	return nil
+}
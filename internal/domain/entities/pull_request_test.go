@@ -164,6 +164,33 @@ func TestPullRequest_ReassignReviewer(t *testing.T) {
 	})
 }
 
+func TestPullRequest_AssignmentLevels(t *testing.T) {
+	pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", time.Now())
+	pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2"})
+
+	pullRequest.SetAssignment(ReviewerAssignment{ReviewerID: "user2", Level: 1})
+	pullRequest.SetAssignment(ReviewerAssignment{ReviewerID: "user9", Level: 2})
+
+	assert.Equal(t, []ReviewerAssignment{
+		{ReviewerID: "user1", Level: 0},
+		{ReviewerID: "user2", Level: 1},
+	}, pullRequest.Assignments())
+
+	err := pullRequest.ReassignReviewer("user2", "user3")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, pullRequest.Assignment("user3").Level)
+}
+
+func TestValidateReviewerQuotas(t *testing.T) {
+	assert.NoError(t, ValidateReviewerQuotas(nil))
+	assert.NoError(t, ValidateReviewerQuotas([]ReviewerQuota{{TeamName: "platform", Count: 1}, {TeamName: "security", Count: 2}}))
+	assert.Equal(t, domain.ErrInvalidReviewerQuota, ValidateReviewerQuotas([]ReviewerQuota{{TeamName: "platform", Count: 0}}))
+	assert.Equal(t, domain.ErrInvalidReviewerQuota, ValidateReviewerQuotas([]ReviewerQuota{{Count: 1}}))
+	assert.Equal(t, domain.ErrInvalidReviewerQuota, ValidateReviewerQuotas([]ReviewerQuota{{TeamName: "platform", Count: 1}, {TeamName: "platform", Count: 1}}))
+	assert.Equal(t, 3, TotalReviewerQuota([]ReviewerQuota{{TeamName: "platform", Count: 1}, {TeamName: "security", Count: 2}}))
+}
+
 func TestPullRequest_Merge(t *testing.T) {
 	t.Run("successfully merge open pullRequest", func(t *testing.T) {
 		now := time.Now()
@@ -226,3 +253,251 @@ func TestPullRequest_IsFullyAssigned(t *testing.T) {
 	pullRequest.AddReviewers([]value_objects.UserID{"user2"})
 	assert.True(t, pullRequest.IsFullyAssigned())
 }
+
+func TestPullRequest_ReviewersLimit(t *testing.T) {
+	t.Run("respect custom limit", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", time.Now())
+		pullRequest.ReviewersLimit = 3
+
+		pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2", "user3", "user4"})
+
+		assert.Equal(t, []value_objects.UserID{"user1", "user2", "user3"}, pullRequest.Reviewers())
+		assert.True(t, pullRequest.IsFullyAssigned())
+		assert.Equal(t, 0, pullRequest.AvailableReviewerSlots())
+	})
+
+	t.Run("single reviewer", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", time.Now())
+		pullRequest.ReviewersLimit = 1
+
+		pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2"})
+
+		assert.Equal(t, []value_objects.UserID{"user1"}, pullRequest.Reviewers())
+		assert.True(t, pullRequest.IsFullyAssigned())
+	})
+
+	t.Run("unset limit falls back to default", func(t *testing.T) {
+		pullRequest := &PullRequest{ID: "pull-request-1", AuthorID: "Artem", Status: StatusOpen}
+
+		assert.Equal(t, DefaultReviewersLimit, pullRequest.MaxReviewers())
+	})
+}
+
+func TestValidateReviewersLimit(t *testing.T) {
+	assert.NoError(t, ValidateReviewersLimit(1))
+	assert.NoError(t, ValidateReviewersLimit(MaxReviewersLimit))
+	assert.ErrorIs(t, ValidateReviewersLimit(0), domain.ErrInvalidReviewersCount)
+	assert.ErrorIs(t, ValidateReviewersLimit(MaxReviewersLimit+1), domain.ErrInvalidReviewersCount)
+}
+
+func TestPullRequest_SubmitReview(t *testing.T) {
+	now := time.Now()
+
+	t.Run("record decision with timestamp", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2"})
+
+		review, err := pullRequest.SubmitReview("user1", DecisionChangesRequested, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, DecisionChangesRequested, review.Decision)
+		assert.Equal(t, &now, review.DecidedAt)
+		assert.Equal(t, review, pullRequest.Review("user1"))
+		assert.True(t, pullRequest.Review("user2").IsPending())
+	})
+
+	t.Run("fail when reviewer not assigned", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+
+		_, err := pullRequest.SubmitReview("user1", DecisionApproved, now)
+
+		assert.Equal(t, domain.ErrNotAssigned, err)
+	})
+
+	t.Run("fail when pull request is merged", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+		pullRequest.Merge(now)
+
+		_, err := pullRequest.SubmitReview("user1", DecisionApproved, now)
+
+		assert.Equal(t, domain.ErrPRMerged, err)
+	})
+
+	t.Run("fail on invalid decision", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+
+		_, err := pullRequest.SubmitReview("user1", DecisionPending, now)
+		assert.Equal(t, domain.ErrInvalidDecision, err)
+
+		_, err = pullRequest.SubmitReview("user1", "LGTM", now)
+		assert.Equal(t, domain.ErrInvalidDecision, err)
+	})
+
+	t.Run("reassign drops previous decision", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+		_, err := pullRequest.SubmitReview("user1", DecisionApproved, now)
+		assert.NoError(t, err)
+
+		err = pullRequest.ReassignReviewer("user1", "user2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Review{{ReviewerID: "user2", Decision: DecisionPending}}, pullRequest.Reviews())
+	})
+}
+
+func TestPullRequest_ReviewDecision(t *testing.T) {
+	now := time.Now()
+	pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+
+	assert.Equal(t, DecisionPending, pullRequest.ReviewDecision())
+
+	pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2"})
+	_, _ = pullRequest.SubmitReview("user1", DecisionApproved, now)
+	assert.Equal(t, DecisionPending, pullRequest.ReviewDecision())
+
+	_, _ = pullRequest.SubmitReview("user2", DecisionApproved, now)
+	assert.Equal(t, DecisionApproved, pullRequest.ReviewDecision())
+
+	_, _ = pullRequest.SubmitReview("user2", DecisionChangesRequested, now)
+	assert.Equal(t, DecisionChangesRequested, pullRequest.ReviewDecision())
+}
+
+func TestPullRequest_StatusTransitions(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("draft pull request does not accept reviewers", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.Status = StatusDraft
+
+		added := pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+
+		assert.Empty(t, added)
+		assert.Equal(t, 0, pullRequest.AvailableReviewerSlots())
+	})
+
+	t.Run("mark draft as ready", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.Status = StatusDraft
+
+		err := pullRequest.MarkReady()
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusOpen, pullRequest.Status)
+		assert.Equal(t, DefaultReviewersLimit, pullRequest.AvailableReviewerSlots())
+	})
+
+	t.Run("fail to mark open pull request as ready", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+
+		err := pullRequest.MarkReady()
+
+		assert.ErrorIs(t, err, domain.ErrInvalidTransition)
+	})
+
+	t.Run("close and reopen", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+
+		err := pullRequest.Close(now)
+		assert.NoError(t, err)
+		assert.Equal(t, StatusClosed, pullRequest.Status)
+		assert.Equal(t, &now, pullRequest.ClosedAt)
+
+		err = pullRequest.Reopen()
+		assert.NoError(t, err)
+		assert.Equal(t, StatusOpen, pullRequest.Status)
+		assert.Nil(t, pullRequest.ClosedAt)
+	})
+
+	t.Run("reopen closed draft as draft", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.Status = StatusDraft
+
+		assert.NoError(t, pullRequest.Close(now))
+		assert.True(t, pullRequest.ClosedFromDraft)
+
+		err := pullRequest.Reopen()
+		assert.NoError(t, err)
+		assert.Equal(t, StatusDraft, pullRequest.Status)
+		assert.False(t, pullRequest.ClosedFromDraft)
+		assert.NoError(t, pullRequest.MarkReady())
+	})
+
+	t.Run("fail to close merged pull request", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.Merge(now)
+
+		err := pullRequest.Close(now)
+
+		assert.ErrorIs(t, err, domain.ErrPRMerged)
+	})
+
+	t.Run("fail to reopen open pull request", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+
+		err := pullRequest.Reopen()
+
+		assert.ErrorIs(t, err, domain.ErrInvalidTransition)
+	})
+
+	t.Run("fail to reassign reviewer on closed pull request", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", now)
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+		assert.NoError(t, pullRequest.Close(now))
+
+		err := pullRequest.ReassignReviewer("user1", "user2")
+
+		assert.ErrorIs(t, err, domain.ErrPRNotOpen)
+	})
+}
+
+func TestPullRequest_RemoveReviewer(t *testing.T) {
+	t.Run("successfully remove reviewer with review", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", time.Now())
+		pullRequest.ReviewersLimit = 3
+		pullRequest.AddReviewers([]value_objects.UserID{"user1", "user2", "user3"})
+		pullRequest.SetAssignment(ReviewerAssignment{ReviewerID: "user2", Level: 1})
+		_, err := pullRequest.SubmitReview("user2", DecisionApproved, time.Now())
+		assert.NoError(t, err)
+
+		err = pullRequest.RemoveReviewer("user2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []value_objects.UserID{"user1", "user3"}, pullRequest.Reviewers())
+		assert.Equal(t, DecisionPending, pullRequest.Review("user2").Decision)
+		assert.Equal(t, ReviewerAssignment{ReviewerID: "user2"}, pullRequest.Assignment("user2"))
+		assert.Equal(t, 1, pullRequest.AvailableReviewerSlots())
+	})
+
+	t.Run("fail when reviewer not assigned", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", time.Now())
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+
+		assert.Equal(t, domain.ErrNotAssigned, pullRequest.RemoveReviewer("user2"))
+	})
+
+	t.Run("fail when pullRequest is merged", func(t *testing.T) {
+		pullRequest := NewPullRequest("pull-request-1", "feature_111", "Artem", time.Now())
+		pullRequest.AddReviewers([]value_objects.UserID{"user1"})
+		pullRequest.Merge(time.Now())
+
+		assert.Equal(t, domain.ErrPRMerged, pullRequest.RemoveReviewer("user1"))
+	})
+}
+
+func TestPullRequest_OverdueReviewers(t *testing.T) {
+	createdAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	pullRequest := NewPullRequest("pull-request-1", "Test PR", "author-1", createdAt)
+	pullRequest.ReviewersLimit = 3
+	pullRequest.AddReviewers([]value_objects.UserID{"user-1", "user-2", "user-3"})
+	pullRequest.SetAssignment(ReviewerAssignment{ReviewerID: "user-2", AssignedAt: createdAt.Add(24 * time.Hour)})
+	_, err := pullRequest.SubmitReview("user-3", DecisionApproved, createdAt.Add(time.Hour))
+	assert.NoError(t, err)
+
+	assert.Empty(t, pullRequest.OverdueReviewers(48*time.Hour, createdAt.Add(47*time.Hour)))
+	assert.Equal(t, []value_objects.UserID{"user-1"}, pullRequest.OverdueReviewers(48*time.Hour, createdAt.Add(48*time.Hour)))
+	assert.Equal(t, []value_objects.UserID{"user-1", "user-2"}, pullRequest.OverdueReviewers(48*time.Hour, createdAt.Add(72*time.Hour)))
+}
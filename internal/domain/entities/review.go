@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"pr-service/internal/domain/value_objects"
+)
+
+type ReviewDecision string
+
+const (
+	DecisionPending          ReviewDecision = "PENDING"
+	DecisionApproved         ReviewDecision = "APPROVED"
+	DecisionChangesRequested ReviewDecision = "CHANGES_REQUESTED"
+	DecisionCommented        ReviewDecision = "COMMENTED"
+)
+
+func (d ReviewDecision) IsValid() bool {
+	switch d {
+	case DecisionPending, DecisionApproved, DecisionChangesRequested, DecisionCommented:
+		return true
+	default:
+		return false
+	}
+}
+
+type Review struct {
+	ReviewerID value_objects.UserID
+	Decision   ReviewDecision
+	DecidedAt  *time.Time
+}
+
+func (r Review) IsPending() bool {
+	return r.Decision == DecisionPending || r.Decision == ""
+}
@@ -0,0 +1,21 @@
+package entities
+
+import (
+	"time"
+
+	"pr-service/internal/domain/value_objects"
+)
+
+type ReminderKind string
+
+const (
+	ReminderKindReminder   ReminderKind = "REMINDER"
+	ReminderKindEscalation ReminderKind = "ESCALATION"
+)
+
+type ReviewReminder struct {
+	PullRequestID value_objects.PullRequestID
+	RecipientID   value_objects.UserID
+	Kind          ReminderKind
+	SentAt        time.Time
+}
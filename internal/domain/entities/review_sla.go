@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"time"
+
+	"pr-service/internal/domain"
+)
+
+type ReviewSLA struct {
+	RemindAfter   time.Duration
+	EscalateAfter time.Duration
+	ReassignAfter time.Duration
+}
+
+func (s ReviewSLA) Validate() error {
+	if s.RemindAfter < 0 || s.EscalateAfter < 0 || s.ReassignAfter < 0 {
+		return domain.ErrInvalidReviewSLA
+	}
+	if s.RemindAfter > 0 && s.EscalateAfter > 0 && s.EscalateAfter <= s.RemindAfter {
+		return domain.ErrInvalidReviewSLA
+	}
+
+	return nil
+}
+
+func (s ReviewSLA) RemindersEnabled() bool {
+	return s.RemindAfter > 0 || s.EscalateAfter > 0
+}
+
+func (s ReviewSLA) ShortestThreshold() time.Duration {
+	if s.RemindAfter > 0 {
+		return s.RemindAfter
+	}
+
+	return s.EscalateAfter
+}
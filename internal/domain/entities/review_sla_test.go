@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"pr-service/internal/domain"
+)
+
+func TestReviewSLA_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sla     ReviewSLA
+		wantErr error
+	}{
+		{name: "disabled", sla: ReviewSLA{}},
+		{name: "reminder only", sla: ReviewSLA{RemindAfter: 24 * time.Hour}},
+		{name: "escalation only", sla: ReviewSLA{EscalateAfter: 72 * time.Hour}},
+		{name: "reminder and escalation", sla: ReviewSLA{RemindAfter: 24 * time.Hour, EscalateAfter: 72 * time.Hour}},
+		{name: "reassignment only", sla: ReviewSLA{ReassignAfter: 48 * time.Hour}},
+		{name: "negative reminder", sla: ReviewSLA{RemindAfter: -time.Hour}, wantErr: domain.ErrInvalidReviewSLA},
+		{name: "negative escalation", sla: ReviewSLA{EscalateAfter: -time.Hour}, wantErr: domain.ErrInvalidReviewSLA},
+		{name: "negative reassignment", sla: ReviewSLA{ReassignAfter: -time.Hour}, wantErr: domain.ErrInvalidReviewSLA},
+		{name: "escalation before reminder", sla: ReviewSLA{RemindAfter: 72 * time.Hour, EscalateAfter: 24 * time.Hour}, wantErr: domain.ErrInvalidReviewSLA},
+		{name: "escalation equal to reminder", sla: ReviewSLA{RemindAfter: 24 * time.Hour, EscalateAfter: 24 * time.Hour}, wantErr: domain.ErrInvalidReviewSLA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.sla.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestReviewSLA_ShortestThreshold(t *testing.T) {
+	assert.Equal(t, 24*time.Hour, ReviewSLA{RemindAfter: 24 * time.Hour, EscalateAfter: 72 * time.Hour}.ShortestThreshold())
+	assert.Equal(t, 72*time.Hour, ReviewSLA{EscalateAfter: 72 * time.Hour}.ShortestThreshold())
+	assert.False(t, ReviewSLA{}.RemindersEnabled())
+	assert.False(t, ReviewSLA{ReassignAfter: 48 * time.Hour}.RemindersEnabled())
+}
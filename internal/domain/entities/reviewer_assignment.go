@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"time"
+
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/value_objects"
+)
+
+type ReviewerAssignment struct {
+	ReviewerID value_objects.UserID
+	Level      int
+	SourceTeam value_objects.TeamName
+	AssignedAt time.Time
+}
+
+func (a ReviewerAssignment) IsBorrowed() bool {
+	return a.SourceTeam != ""
+}
+
+type ReviewerQuota struct {
+	TeamName value_objects.TeamName
+	Count    int
+}
+
+func ValidateReviewerQuotas(quotas []ReviewerQuota) error {
+	seen := make(map[value_objects.TeamName]bool, len(quotas))
+
+	for _, quota := range quotas {
+		if quota.TeamName == "" || quota.Count < 1 || seen[quota.TeamName] {
+			return domain.ErrInvalidReviewerQuota
+		}
+		seen[quota.TeamName] = true
+	}
+
+	return nil
+}
+
+func TotalReviewerQuota(quotas []ReviewerQuota) int {
+	total := 0
+
+	for _, quota := range quotas {
+		total += quota.Count
+	}
+
+	return total
+}
@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"time"
+
+	"pr-service/internal/domain/value_objects"
+)
+
+type ReviewerChangeReason string
+
+const (
+	ReviewerChangeSLAExceeded ReviewerChangeReason = "SLA_EXCEEDED"
+	ReviewerChangeUnassigned  ReviewerChangeReason = "UNASSIGNED"
+	ReviewerChangeManual      ReviewerChangeReason = "MANUAL"
+)
+
+type ReviewerChange struct {
+	PullRequestID value_objects.PullRequestID
+	OldReviewerID value_objects.UserID
+	NewReviewerID value_objects.UserID
+	Level         int
+	SourceTeam    value_objects.TeamName
+	AssignedAt    time.Time
+	Reason        ReviewerChangeReason
+	ChangedAt     time.Time
+}
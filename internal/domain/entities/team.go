@@ -1,9 +1,60 @@
 package entities
 
 import (
+	"time"
+
+	"pr-service/internal/domain"
 	"pr-service/internal/domain/value_objects"
 )
 
+type AssignmentStrategy string
+
+const (
+	StrategyRandom         AssignmentStrategy = "RANDOM"
+	StrategyLeastLoaded    AssignmentStrategy = "LEAST_LOADED"
+	StrategyRoundRobin     AssignmentStrategy = "ROUND_ROBIN"
+	StrategyWeightedRandom AssignmentStrategy = "WEIGHTED_RANDOM"
+)
+
+func (s AssignmentStrategy) IsValid() bool {
+	switch s {
+	case StrategyRandom, StrategyLeastLoaded, StrategyRoundRobin, StrategyWeightedRandom:
+		return true
+	default:
+		return false
+	}
+}
+
 type Team struct {
-	Name value_objects.TeamName
+	ID                 value_objects.TeamID
+	Name               value_objects.TeamName
+	Parent             value_objects.TeamName
+	AssignmentStrategy AssignmentStrategy
+	RoundRobinCursor   value_objects.UserID
+	ReviewersLimit     int
+	LeadID             value_objects.UserID
+	MergePolicy        MergePolicy
+	ArchivedAt         *time.Time
+	MaxOpenReviews     int
+	ReviewSLA          ReviewSLA
+}
+
+func (t Team) IsArchived() bool {
+	return t.ArchivedAt != nil
+}
+
+func ValidateMaxOpenReviews(maxOpenReviews int) error {
+	if maxOpenReviews < 0 {
+		return domain.ErrInvalidMaxOpenReviews
+	}
+
+	return nil
+}
+
+func (t Team) DefaultReviewersLimit() int {
+	if t.ReviewersLimit <= 0 {
+		return DefaultReviewersLimit
+	}
+
+	return t.ReviewersLimit
 }
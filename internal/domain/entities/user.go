@@ -9,4 +9,14 @@ type User struct {
 	Username string
 	Team     value_objects.TeamName
 	IsActive bool
+
+	MaxOpenReviews int
+}
+
+func (u User) ReviewCapacity(team Team) int {
+	if u.MaxOpenReviews > 0 {
+		return u.MaxOpenReviews
+	}
+
+	return team.MaxOpenReviews
 }
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"pr-service/internal/domain"
+)
+
+func TestUser_ReviewCapacity(t *testing.T) {
+	team := Team{MaxOpenReviews: 5}
+
+	assert.Equal(t, 5, User{}.ReviewCapacity(team))
+	assert.Equal(t, 2, User{MaxOpenReviews: 2}.ReviewCapacity(team))
+	assert.Equal(t, 0, User{}.ReviewCapacity(Team{}))
+}
+
+func TestValidateMaxOpenReviews(t *testing.T) {
+	assert.NoError(t, ValidateMaxOpenReviews(0))
+	assert.NoError(t, ValidateMaxOpenReviews(30))
+	assert.Equal(t, domain.ErrInvalidMaxOpenReviews, ValidateMaxOpenReviews(-1))
+}
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"net/url"
+	"slices"
+	"time"
+
+	"pr-service/internal/domain"
+)
+
+type Webhook struct {
+	ID         int64
+	URL        string
+	Secret     string
+	EventTypes []EventType
+	CreatedAt  time.Time
+}
+
+func (w Webhook) Validate() error {
+	parsedURL, err := url.Parse(w.URL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return domain.ErrInvalidWebhook
+	}
+
+	if w.Secret == "" {
+		return domain.ErrInvalidWebhook
+	}
+
+	for _, eventType := range w.EventTypes {
+		if !eventType.IsValid() {
+			return domain.ErrInvalidWebhook
+		}
+	}
+
+	return nil
+}
+
+func (w Webhook) Subscribes(eventType EventType) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType)
+}
+
+type WebhookDeadLetter struct {
+	ID        int64
+	WebhookID int64
+	Event     Event
+	Attempts  int
+	LastError string
+	FailedAt  time.Time
+}
+
+type WebhookDelivery struct {
+	WebhookID     int64
+	OutboxEntryID int64
+	Attempts      int
+	NextAttemptAt time.Time
+	Done          bool
+}
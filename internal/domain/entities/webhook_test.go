@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"pr-service/internal/domain"
+)
+
+func TestWebhook_Validate(t *testing.T) {
+	valid := Webhook{URL: "https://hooks.example.com/pr", Secret: "s3cret", EventTypes: []EventType{EventPullRequestMerged}}
+
+	assert.NoError(t, valid.Validate())
+	assert.NoError(t, Webhook{URL: "http://localhost:9000", Secret: "s3cret"}.Validate())
+	assert.Equal(t, domain.ErrInvalidWebhook, Webhook{URL: "ftp://hooks.example.com", Secret: "s3cret"}.Validate())
+	assert.Equal(t, domain.ErrInvalidWebhook, Webhook{URL: "/relative", Secret: "s3cret"}.Validate())
+	assert.Equal(t, domain.ErrInvalidWebhook, Webhook{URL: "https://hooks.example.com"}.Validate())
+	assert.Equal(t, domain.ErrInvalidWebhook, Webhook{URL: "https://hooks.example.com", Secret: "s3cret", EventTypes: []EventType{"PullRequestDeleted"}}.Validate())
+}
+
+func TestWebhook_Subscribes(t *testing.T) {
+	filtered := Webhook{EventTypes: []EventType{EventPullRequestMerged, EventTeamCreated}}
+
+	assert.True(t, filtered.Subscribes(EventTeamCreated))
+	assert.False(t, filtered.Subscribes(EventReviewerAssigned))
+	assert.True(t, Webhook{}.Subscribes(EventReviewerAssigned))
+}
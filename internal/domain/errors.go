@@ -1,6 +1,9 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
 	ErrTeamExists      = errors.New("TEAM_EXISTS")
@@ -12,4 +15,42 @@ var (
 	ErrTeamNotFound    = errors.New("TEAM_NOT_FOUND")
 	ErrPRNotFound      = errors.New("PR_NOT_FOUND")
 	ErrAuthorNotActive = errors.New("AUTHOR_NOT_ACTIVE")
+
+	ErrConcurrentModification = errors.New("CONCURRENT_MODIFICATION")
+	ErrInvalidStrategy        = errors.New("INVALID_ASSIGNMENT_STRATEGY")
+	ErrInvalidReviewersCount  = errors.New("INVALID_REVIEWERS_COUNT")
+	ErrInvalidDecision        = errors.New("INVALID_REVIEW_DECISION")
+	ErrInvalidMergePolicy     = errors.New("INVALID_MERGE_POLICY")
+	ErrMergeBlocked           = errors.New("MERGE_BLOCKED")
+	ErrPRNotOpen              = errors.New("PR_NOT_OPEN")
+	ErrInvalidTransition      = errors.New("INVALID_STATUS_TRANSITION")
+	ErrNotTeamMember          = errors.New("NOT_TEAM_MEMBER")
+	ErrMemberExists           = errors.New("MEMBER_EXISTS")
+	ErrTeamArchived           = errors.New("TEAM_ARCHIVED")
+	ErrTeamInUse              = errors.New("TEAM_IN_USE")
+	ErrInvalidTeamParent      = errors.New("INVALID_TEAM_PARENT")
+	ErrInvalidReviewerQuota   = errors.New("INVALID_REVIEWER_QUOTA")
+	ErrInvalidMaxOpenReviews  = errors.New("INVALID_MAX_OPEN_REVIEWS")
+	ErrReviewerNotEligible    = errors.New("REVIEWER_NOT_ELIGIBLE")
+	ErrInvalidReviewSLA       = errors.New("INVALID_REVIEW_SLA")
+
+	ErrInvalidAvailabilityWindow  = errors.New("INVALID_AVAILABILITY_WINDOW")
+	ErrAvailabilityWindowNotFound = errors.New("AVAILABILITY_WINDOW_NOT_FOUND")
+
+	ErrInvalidWebhook  = errors.New("INVALID_WEBHOOK")
+	ErrWebhookNotFound = errors.New("WEBHOOK_NOT_FOUND")
+
+	ErrInvalidAuditQuery = errors.New("INVALID_AUDIT_QUERY")
 )
+
+type MergeBlockedError struct {
+	Conditions []string
+}
+
+func (e *MergeBlockedError) Error() string {
+	return ErrMergeBlocked.Error() + ": " + strings.Join(e.Conditions, ", ")
+}
+
+func (e *MergeBlockedError) Unwrap() error {
+	return ErrMergeBlocked
+}
@@ -2,4 +2,5 @@ package value_objects
 
 type UserID string
 type TeamName string
+type TeamID string
 type PullRequestID string
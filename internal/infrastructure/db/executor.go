@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type QueryExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+func withTx(ctx context.Context, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+func txFromContext(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+
+	return tx, ok
+}
+
+func GetQueryExecutor(ctx context.Context, db *sql.DB) QueryExecutor {
+	if tx, ok := txFromContext(ctx); ok {
+		return tx
+	}
+
+	return db
+}
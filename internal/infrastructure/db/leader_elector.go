@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"pr-service/internal/app"
+)
+
+type advisoryLockElector struct {
+	db     *sql.DB
+	lockID int64
+}
+
+func NewLeaderElector(db *sql.DB, lockID int64) app.LeaderElector {
+	return &advisoryLockElector{db: db, lockID: lockID}
+}
+
+func (e *advisoryLockElector) RunIfLeader(ctx context.Context, job func(ctx context.Context) error) (bool, error) {
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", e.lockID).Scan(&acquired); err != nil {
+		return false, fmt.Errorf("failed to acquire advisory lock: %w", err)
+	}
+	if !acquired {
+		return false, nil
+	}
+
+	defer func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", e.lockID); err != nil {
+			log.Printf("failed to release advisory lock %d: %v", e.lockID, err)
+		}
+	}()
+
+	return true, job(ctx)
+}
@@ -17,12 +17,23 @@ func NewTxManager(db *sql.DB) app.TxManager {
 }
 
 func (tm *txManager) Do(ctx context.Context, operation func(ctx context.Context) error) error {
+	if _, ok := txFromContext(ctx); ok {
+		return operation(ctx)
+	}
+
 	tx, err := tm.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
-	operationErr := operation(ctx)
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	operationErr := operation(withTx(ctx, tx))
 	if operationErr != nil {
 		rollbackErr := tx.Rollback()
 		if rollbackErr != nil {
@@ -0,0 +1,79 @@
+package db_mappers
+
+import (
+	"encoding/json"
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+func ToAuditEntryDBModel(entry entities.AuditEntry) (db_models.AuditEntry, error) {
+	beforeState, err := encodeAuditState(entry.Before)
+	if err != nil {
+		return db_models.AuditEntry{}, err
+	}
+
+	afterState, err := encodeAuditState(entry.After)
+	if err != nil {
+		return db_models.AuditEntry{}, err
+	}
+
+	return db_models.AuditEntry{
+		Actor:       entry.Actor,
+		Action:      string(entry.Action),
+		EntityType:  string(entry.EntityType),
+		EntityID:    entry.EntityID,
+		BeforeState: beforeState,
+		AfterState:  afterState,
+		RequestID:   entry.RequestID,
+		CreatedAt:   entry.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func FromAuditEntryDBModel(dbEntry db_models.AuditEntry) entities.AuditEntry {
+	createdAt, err := time.Parse(time.RFC3339, dbEntry.CreatedAt)
+	if err != nil {
+		createdAt = time.Time{}
+	}
+
+	return entities.AuditEntry{
+		ID:         dbEntry.ID,
+		Actor:      dbEntry.Actor,
+		Action:     entities.AuditAction(dbEntry.Action),
+		EntityType: entities.AuditEntityType(dbEntry.EntityType),
+		EntityID:   dbEntry.EntityID,
+		Before:     decodeAuditState(dbEntry.BeforeState),
+		After:      decodeAuditState(dbEntry.AfterState),
+		RequestID:  dbEntry.RequestID,
+		CreatedAt:  createdAt,
+	}
+}
+
+func encodeAuditState(state map[string]any) (*string, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+
+	result := string(encoded)
+
+	return &result, nil
+}
+
+func decodeAuditState(state *string) map[string]any {
+	if state == nil {
+		return nil
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(*state), &decoded); err != nil {
+		return nil
+	}
+
+	return decoded
+}
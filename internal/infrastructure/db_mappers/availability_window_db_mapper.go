@@ -0,0 +1,39 @@
+package db_mappers
+
+import (
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+func ToAvailabilityWindowDBModel(window entities.AvailabilityWindow) db_models.AvailabilityWindow {
+	return db_models.AvailabilityWindow{
+		ID:       window.ID,
+		UserID:   string(window.UserID),
+		StartsAt: window.StartsAt.Format(time.RFC3339),
+		EndsAt:   window.EndsAt.Format(time.RFC3339),
+		Reason:   window.Reason,
+	}
+}
+
+func FromAvailabilityWindowDBModel(dbWindow db_models.AvailabilityWindow) entities.AvailabilityWindow {
+	startsAt, err := time.Parse(time.RFC3339, dbWindow.StartsAt)
+	if err != nil {
+		startsAt = time.Time{}
+	}
+
+	endsAt, err := time.Parse(time.RFC3339, dbWindow.EndsAt)
+	if err != nil {
+		endsAt = time.Time{}
+	}
+
+	return entities.AvailabilityWindow{
+		ID:       dbWindow.ID,
+		UserID:   value_objects.UserID(dbWindow.UserID),
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Reason:   dbWindow.Reason,
+	}
+}
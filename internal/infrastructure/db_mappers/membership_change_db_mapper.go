@@ -0,0 +1,50 @@
+package db_mappers
+
+import (
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+func ToMembershipChangeDBModel(change entities.MembershipChange) db_models.MembershipChange {
+	return db_models.MembershipChange{
+		UserID:    string(change.UserID),
+		Action:    string(change.Action),
+		FromTeam:  optionalTeamName(change.FromTeam),
+		ToTeam:    optionalTeamName(change.ToTeam),
+		ChangedAt: change.ChangedAt.Format(time.RFC3339),
+	}
+}
+
+func FromMembershipChangeDBModel(dbChange db_models.MembershipChange) entities.MembershipChange {
+	changedAt, err := time.Parse(time.RFC3339, dbChange.ChangedAt)
+	if err != nil {
+		changedAt = time.Time{}
+	}
+
+	change := entities.MembershipChange{
+		UserID:    value_objects.UserID(dbChange.UserID),
+		Action:    entities.MembershipAction(dbChange.Action),
+		ChangedAt: changedAt,
+	}
+	if dbChange.FromTeam != nil {
+		change.FromTeam = value_objects.TeamName(*dbChange.FromTeam)
+	}
+	if dbChange.ToTeam != nil {
+		change.ToTeam = value_objects.TeamName(*dbChange.ToTeam)
+	}
+
+	return change
+}
+
+func optionalTeamName(teamName value_objects.TeamName) *string {
+	if teamName == "" {
+		return nil
+	}
+
+	name := string(teamName)
+
+	return &name
+}
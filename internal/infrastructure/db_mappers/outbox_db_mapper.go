@@ -0,0 +1,61 @@
+package db_mappers
+
+import (
+	"encoding/json"
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+func ToOutboxEntryDBModel(event entities.Event) (db_models.OutboxEntry, error) {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return db_models.OutboxEntry{}, err
+	}
+
+	return db_models.OutboxEntry{
+		AggregateID: event.AggregateID,
+		EventType:   string(event.Type),
+		Payload:     string(payload),
+		OccurredAt:  event.OccurredAt.Format(time.RFC3339),
+	}, nil
+}
+
+func FromOutboxEntryDBModel(dbEntry db_models.OutboxEntry) entities.OutboxEntry {
+	occurredAt, err := time.Parse(time.RFC3339, dbEntry.OccurredAt)
+	if err != nil {
+		occurredAt = time.Time{}
+	}
+
+	var deliveredAt *time.Time
+	if dbEntry.DeliveredAt != nil {
+		if t, err := time.Parse(time.RFC3339, *dbEntry.DeliveredAt); err == nil {
+			deliveredAt = &t
+		}
+	}
+
+	var nextAttemptAt *time.Time
+	if dbEntry.NextAttemptAt != nil {
+		if t, err := time.Parse(time.RFC3339, *dbEntry.NextAttemptAt); err == nil {
+			nextAttemptAt = &t
+		}
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(dbEntry.Payload), &payload); err != nil {
+		payload = nil
+	}
+
+	return entities.OutboxEntry{
+		ID: dbEntry.ID,
+		Event: entities.Event{
+			Type:        entities.EventType(dbEntry.EventType),
+			AggregateID: dbEntry.AggregateID,
+			OccurredAt:  occurredAt,
+			Payload:     payload,
+		},
+		DeliveredAt:   deliveredAt,
+		NextAttemptAt: nextAttemptAt,
+	}
+}
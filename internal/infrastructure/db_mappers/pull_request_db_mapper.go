@@ -15,6 +15,12 @@ func ToPullRequestDBModel(pullRequest entities.PullRequest) db_models.PullReques
 		mergedAt = &s
 	}
 
+	var closedAt *string
+	if pullRequest.ClosedAt != nil {
+		s := pullRequest.ClosedAt.Format(time.RFC3339)
+		closedAt = &s
+	}
+
 	return db_models.PullRequest{
 		ID:        string(pullRequest.ID),
 		Name:      pullRequest.Name,
@@ -22,6 +28,12 @@ func ToPullRequestDBModel(pullRequest entities.PullRequest) db_models.PullReques
 		Status:    string(pullRequest.Status),
 		CreatedAt: pullRequest.CreatedAt.Format(time.RFC3339),
 		MergedAt:  mergedAt,
+		ClosedAt:  closedAt,
+		Version:   pullRequest.Version,
+
+		ReviewersLimit:  pullRequest.MaxReviewers(),
+		ForceMerged:     pullRequest.ForceMerged,
+		ClosedFromDraft: pullRequest.ClosedFromDraft,
 	}
 }
 
@@ -41,6 +53,16 @@ func FromPullRequestDBModel(dbPullRequest db_models.PullRequest) entities.PullRe
 		mergedAt = &t
 	}
 
+	var closedAt *time.Time
+	if dbPullRequest.ClosedAt != nil {
+		t, err := time.Parse(time.RFC3339, *dbPullRequest.ClosedAt)
+		if err != nil {
+			return entities.PullRequest{}
+		}
+
+		closedAt = &t
+	}
+
 	return entities.PullRequest{
 		ID:        value_objects.PullRequestID(dbPullRequest.ID),
 		Name:      dbPullRequest.Name,
@@ -48,5 +70,11 @@ func FromPullRequestDBModel(dbPullRequest db_models.PullRequest) entities.PullRe
 		Status:    entities.PullRequestStatus(dbPullRequest.Status),
 		CreatedAt: createdAt,
 		MergedAt:  mergedAt,
+		ClosedAt:  closedAt,
+		Version:   dbPullRequest.Version,
+
+		ReviewersLimit:  dbPullRequest.ReviewersLimit,
+		ForceMerged:     dbPullRequest.ForceMerged,
+		ClosedFromDraft: dbPullRequest.ClosedFromDraft,
 	}
 }
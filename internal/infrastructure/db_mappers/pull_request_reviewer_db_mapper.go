@@ -0,0 +1,54 @@
+package db_mappers
+
+import (
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+func ToPullRequestReviewerDBModel(pullRequestID value_objects.PullRequestID, review entities.Review) db_models.PullRequestReviewer {
+	var decidedAt *string
+	if review.DecidedAt != nil {
+		s := review.DecidedAt.Format(time.RFC3339)
+		decidedAt = &s
+	}
+
+	return db_models.PullRequestReviewer{
+		PullRequestID: string(pullRequestID),
+		UserID:        string(review.ReviewerID),
+		Decision:      string(review.Decision),
+		DecidedAt:     decidedAt,
+	}
+}
+
+func FromPullRequestReviewerDBModel(dbReviewer db_models.PullRequestReviewer) entities.Review {
+	var decidedAt *time.Time
+	if dbReviewer.DecidedAt != nil {
+		t, err := time.Parse(time.RFC3339, *dbReviewer.DecidedAt)
+		if err == nil {
+			decidedAt = &t
+		}
+	}
+
+	return entities.Review{
+		ReviewerID: value_objects.UserID(dbReviewer.UserID),
+		Decision:   entities.ReviewDecision(dbReviewer.Decision),
+		DecidedAt:  decidedAt,
+	}
+}
+
+func FromReviewerAssignmentDBModel(dbReviewer db_models.PullRequestReviewer) entities.ReviewerAssignment {
+	assignedAt, err := time.Parse(time.RFC3339, dbReviewer.AssignedAt)
+	if err != nil {
+		assignedAt = time.Time{}
+	}
+
+	return entities.ReviewerAssignment{
+		ReviewerID: value_objects.UserID(dbReviewer.UserID),
+		Level:      dbReviewer.AssignmentLevel,
+		SourceTeam: value_objects.TeamName(dbReviewer.SourceTeamName),
+		AssignedAt: assignedAt,
+	}
+}
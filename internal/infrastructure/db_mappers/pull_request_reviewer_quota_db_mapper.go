@@ -0,0 +1,14 @@
+package db_mappers
+
+import (
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+func FromReviewerQuotaDBModel(dbQuota db_models.PullRequestReviewerQuota) entities.ReviewerQuota {
+	return entities.ReviewerQuota{
+		TeamName: value_objects.TeamName(dbQuota.SourceTeamName),
+		Count:    dbQuota.Count,
+	}
+}
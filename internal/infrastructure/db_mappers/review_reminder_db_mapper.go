@@ -0,0 +1,32 @@
+package db_mappers
+
+import (
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+func ToReviewReminderDBModel(reminder entities.ReviewReminder) db_models.ReviewReminder {
+	return db_models.ReviewReminder{
+		PullRequestID: string(reminder.PullRequestID),
+		RecipientID:   string(reminder.RecipientID),
+		Kind:          string(reminder.Kind),
+		SentAt:        reminder.SentAt.Format(time.RFC3339),
+	}
+}
+
+func FromReviewReminderDBModel(dbReminder db_models.ReviewReminder) entities.ReviewReminder {
+	sentAt, err := time.Parse(time.RFC3339, dbReminder.SentAt)
+	if err != nil {
+		sentAt = time.Time{}
+	}
+
+	return entities.ReviewReminder{
+		PullRequestID: value_objects.PullRequestID(dbReminder.PullRequestID),
+		RecipientID:   value_objects.UserID(dbReminder.RecipientID),
+		Kind:          entities.ReminderKind(dbReminder.Kind),
+		SentAt:        sentAt,
+	}
+}
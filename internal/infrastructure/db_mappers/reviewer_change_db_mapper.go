@@ -0,0 +1,56 @@
+package db_mappers
+
+import (
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+func ToReviewerChangeDBModel(change entities.ReviewerChange) db_models.ReviewerChange {
+	var newReviewerID *string
+	if change.NewReviewerID != "" {
+		s := string(change.NewReviewerID)
+		newReviewerID = &s
+	}
+
+	return db_models.ReviewerChange{
+		PullRequestID:  string(change.PullRequestID),
+		OldReviewerID:  string(change.OldReviewerID),
+		NewReviewerID:  newReviewerID,
+		Level:          change.Level,
+		SourceTeamName: string(change.SourceTeam),
+		AssignedAt:     change.AssignedAt.Format(time.RFC3339),
+		Reason:         string(change.Reason),
+		ChangedAt:      change.ChangedAt.Format(time.RFC3339),
+	}
+}
+
+func FromReviewerChangeDBModel(dbChange db_models.ReviewerChange) entities.ReviewerChange {
+	assignedAt, err := time.Parse(time.RFC3339, dbChange.AssignedAt)
+	if err != nil {
+		assignedAt = time.Time{}
+	}
+
+	changedAt, err := time.Parse(time.RFC3339, dbChange.ChangedAt)
+	if err != nil {
+		changedAt = time.Time{}
+	}
+
+	var newReviewerID value_objects.UserID
+	if dbChange.NewReviewerID != nil {
+		newReviewerID = value_objects.UserID(*dbChange.NewReviewerID)
+	}
+
+	return entities.ReviewerChange{
+		PullRequestID: value_objects.PullRequestID(dbChange.PullRequestID),
+		OldReviewerID: value_objects.UserID(dbChange.OldReviewerID),
+		NewReviewerID: newReviewerID,
+		Level:         dbChange.Level,
+		SourceTeam:    value_objects.TeamName(dbChange.SourceTeamName),
+		AssignedAt:    assignedAt,
+		Reason:        entities.ReviewerChangeReason(dbChange.Reason),
+		ChangedAt:     changedAt,
+	}
+}
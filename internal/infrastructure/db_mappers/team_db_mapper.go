@@ -1,13 +1,91 @@
 package db_mappers
 
 import (
+	"time"
+
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
 	"pr-service/internal/infrastructure/db_models"
 )
 
+func ToTeamDBModel(team entities.Team) db_models.Team {
+	var roundRobinCursor *string
+	if team.RoundRobinCursor != "" {
+		cursor := string(team.RoundRobinCursor)
+		roundRobinCursor = &cursor
+	}
+
+	var leadID *string
+	if team.LeadID != "" {
+		lead := string(team.LeadID)
+		leadID = &lead
+	}
+
+	var archivedAt *string
+	if team.ArchivedAt != nil {
+		s := team.ArchivedAt.Format(time.RFC3339)
+		archivedAt = &s
+	}
+
+	return db_models.Team{
+		ID:                 string(team.ID),
+		Name:               string(team.Name),
+		AssignmentStrategy: string(team.AssignmentStrategy),
+		RoundRobinCursor:   roundRobinCursor,
+		ReviewersLimit:     team.DefaultReviewersLimit(),
+		MaxOpenReviews:     team.MaxOpenReviews,
+
+		RemindAfterSeconds:   int64(team.ReviewSLA.RemindAfter / time.Second),
+		EscalateAfterSeconds: int64(team.ReviewSLA.EscalateAfter / time.Second),
+		ReassignAfterSeconds: int64(team.ReviewSLA.ReassignAfter / time.Second),
+
+		LeadID:              leadID,
+		MinApprovals:        team.MergePolicy.MinApprovals,
+		RequireLeadApproval: team.MergePolicy.RequireLeadApproval,
+
+		ArchivedAt: archivedAt,
+
+		ParentName: string(team.Parent),
+	}
+}
+
 func FromTeamDBModel(dbTeam db_models.Team) entities.Team {
+	var roundRobinCursor value_objects.UserID
+	if dbTeam.RoundRobinCursor != nil {
+		roundRobinCursor = value_objects.UserID(*dbTeam.RoundRobinCursor)
+	}
+
+	var leadID value_objects.UserID
+	if dbTeam.LeadID != nil {
+		leadID = value_objects.UserID(*dbTeam.LeadID)
+	}
+
+	var archivedAt *time.Time
+	if dbTeam.ArchivedAt != nil {
+		t, err := time.Parse(time.RFC3339, *dbTeam.ArchivedAt)
+		if err == nil {
+			archivedAt = &t
+		}
+	}
+
 	return entities.Team{
-		Name: value_objects.TeamName(dbTeam.Name),
+		ID:                 value_objects.TeamID(dbTeam.ID),
+		Name:               value_objects.TeamName(dbTeam.Name),
+		AssignmentStrategy: entities.AssignmentStrategy(dbTeam.AssignmentStrategy),
+		RoundRobinCursor:   roundRobinCursor,
+		ReviewersLimit:     dbTeam.ReviewersLimit,
+		MaxOpenReviews:     dbTeam.MaxOpenReviews,
+		LeadID:             leadID,
+		MergePolicy: entities.MergePolicy{
+			MinApprovals:        dbTeam.MinApprovals,
+			RequireLeadApproval: dbTeam.RequireLeadApproval,
+		},
+		ReviewSLA: entities.ReviewSLA{
+			RemindAfter:   time.Duration(dbTeam.RemindAfterSeconds) * time.Second,
+			EscalateAfter: time.Duration(dbTeam.EscalateAfterSeconds) * time.Second,
+			ReassignAfter: time.Duration(dbTeam.ReassignAfterSeconds) * time.Second,
+		},
+		ArchivedAt: archivedAt,
+		Parent:     value_objects.TeamName(dbTeam.ParentName),
 	}
 }
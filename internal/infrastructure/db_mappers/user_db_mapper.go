@@ -12,6 +12,8 @@ func ToUserDBModel(user entities.User) db_models.User {
 		Username: user.Username,
 		Team:     string(user.Team),
 		IsActive: user.IsActive,
+
+		MaxOpenReviews: user.MaxOpenReviews,
 	}
 }
 
@@ -21,5 +23,7 @@ func FromUserDBModel(dbUser db_models.User) entities.User {
 		Username: dbUser.Username,
 		Team:     value_objects.TeamName(dbUser.Team),
 		IsActive: dbUser.IsActive,
+
+		MaxOpenReviews: dbUser.MaxOpenReviews,
 	}
 }
@@ -0,0 +1,126 @@
+package db_mappers
+
+import (
+	"encoding/json"
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+func ToWebhookDBModel(webhook entities.Webhook) db_models.Webhook {
+	eventTypes := make([]string, len(webhook.EventTypes))
+	for i, eventType := range webhook.EventTypes {
+		eventTypes[i] = string(eventType)
+	}
+
+	return db_models.Webhook{
+		ID:         webhook.ID,
+		URL:        webhook.URL,
+		Secret:     webhook.Secret,
+		EventTypes: eventTypes,
+		CreatedAt:  webhook.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func FromWebhookDBModel(dbWebhook db_models.Webhook) entities.Webhook {
+	createdAt, err := time.Parse(time.RFC3339, dbWebhook.CreatedAt)
+	if err != nil {
+		createdAt = time.Time{}
+	}
+
+	var eventTypes []entities.EventType
+	for _, eventType := range dbWebhook.EventTypes {
+		eventTypes = append(eventTypes, entities.EventType(eventType))
+	}
+
+	return entities.Webhook{
+		ID:         dbWebhook.ID,
+		URL:        dbWebhook.URL,
+		Secret:     dbWebhook.Secret,
+		EventTypes: eventTypes,
+		CreatedAt:  createdAt,
+	}
+}
+
+func ToWebhookDeadLetterDBModel(deadLetter entities.WebhookDeadLetter) (db_models.WebhookDeadLetter, error) {
+	payload, err := json.Marshal(deadLetter.Event.Payload)
+	if err != nil {
+		return db_models.WebhookDeadLetter{}, err
+	}
+
+	return db_models.WebhookDeadLetter{
+		ID:         deadLetter.ID,
+		WebhookID:  deadLetter.WebhookID,
+		EventType:  string(deadLetter.Event.Type),
+		Payload:    string(payload),
+		OccurredAt: deadLetter.Event.OccurredAt.Format(time.RFC3339),
+		Attempts:   deadLetter.Attempts,
+		LastError:  deadLetter.LastError,
+		FailedAt:   deadLetter.FailedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func FromWebhookDeadLetterDBModel(dbDeadLetter db_models.WebhookDeadLetter) entities.WebhookDeadLetter {
+	occurredAt, err := time.Parse(time.RFC3339, dbDeadLetter.OccurredAt)
+	if err != nil {
+		occurredAt = time.Time{}
+	}
+
+	failedAt, err := time.Parse(time.RFC3339, dbDeadLetter.FailedAt)
+	if err != nil {
+		failedAt = time.Time{}
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(dbDeadLetter.Payload), &payload); err != nil {
+		payload = nil
+	}
+
+	return entities.WebhookDeadLetter{
+		ID:        dbDeadLetter.ID,
+		WebhookID: dbDeadLetter.WebhookID,
+		Event: entities.Event{
+			Type:       entities.EventType(dbDeadLetter.EventType),
+			OccurredAt: occurredAt,
+			Payload:    payload,
+		},
+		Attempts:  dbDeadLetter.Attempts,
+		LastError: dbDeadLetter.LastError,
+		FailedAt:  failedAt,
+	}
+}
+
+func ToWebhookDeliveryDBModel(delivery entities.WebhookDelivery) db_models.WebhookDelivery {
+	var nextAttemptAt *string
+	if !delivery.NextAttemptAt.IsZero() {
+		formatted := delivery.NextAttemptAt.Format(time.RFC3339)
+		nextAttemptAt = &formatted
+	}
+
+	return db_models.WebhookDelivery{
+		WebhookID:     delivery.WebhookID,
+		OutboxID:      delivery.OutboxEntryID,
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: nextAttemptAt,
+		Done:          delivery.Done,
+	}
+}
+
+func FromWebhookDeliveryDBModel(dbDelivery db_models.WebhookDelivery) entities.WebhookDelivery {
+	var nextAttemptAt time.Time
+	if dbDelivery.NextAttemptAt != nil {
+		parsed, err := time.Parse(time.RFC3339, *dbDelivery.NextAttemptAt)
+		if err == nil {
+			nextAttemptAt = parsed
+		}
+	}
+
+	return entities.WebhookDelivery{
+		WebhookID:     dbDelivery.WebhookID,
+		OutboxEntryID: dbDelivery.OutboxID,
+		Attempts:      dbDelivery.Attempts,
+		NextAttemptAt: nextAttemptAt,
+		Done:          dbDelivery.Done,
+	}
+}
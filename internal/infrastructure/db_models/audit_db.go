@@ -0,0 +1,13 @@
+package db_models
+
+type AuditEntry struct {
+	ID          int64   `db:"id"`
+	Actor       string  `db:"actor"`
+	Action      string  `db:"action"`
+	EntityType  string  `db:"entity_type"`
+	EntityID    string  `db:"entity_id"`
+	BeforeState *string `db:"before_state"`
+	AfterState  *string `db:"after_state"`
+	RequestID   string  `db:"request_id"`
+	CreatedAt   string  `db:"created_at"`
+}
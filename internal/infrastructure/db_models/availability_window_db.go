@@ -0,0 +1,9 @@
+package db_models
+
+type AvailabilityWindow struct {
+	ID       int64  `db:"id"`
+	UserID   string `db:"user_id"`
+	StartsAt string `db:"starts_at"`
+	EndsAt   string `db:"ends_at"`
+	Reason   string `db:"reason"`
+}
@@ -0,0 +1,9 @@
+package db_models
+
+type MembershipChange struct {
+	UserID    string  `db:"user_id"`
+	Action    string  `db:"action"`
+	FromTeam  *string `db:"from_team_name"`
+	ToTeam    *string `db:"to_team_name"`
+	ChangedAt string  `db:"changed_at"`
+}
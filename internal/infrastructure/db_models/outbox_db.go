@@ -0,0 +1,11 @@
+package db_models
+
+type OutboxEntry struct {
+	ID            int64   `db:"id"`
+	AggregateID   string  `db:"aggregate_id"`
+	EventType     string  `db:"event_type"`
+	Payload       string  `db:"payload"`
+	OccurredAt    string  `db:"occurred_at"`
+	DeliveredAt   *string `db:"delivered_at"`
+	NextAttemptAt *string `db:"next_attempt_at"`
+}
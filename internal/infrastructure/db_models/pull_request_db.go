@@ -7,4 +7,10 @@ type PullRequest struct {
 	Status    string  `db:"status"`
 	CreatedAt string  `db:"created_at"`
 	MergedAt  *string `db:"merged_at"`
+	ClosedAt  *string `db:"closed_at"`
+	Version   int     `db:"version"`
+
+	ReviewersLimit  int  `db:"reviewers_limit"`
+	ForceMerged     bool `db:"force_merged"`
+	ClosedFromDraft bool `db:"closed_from_draft"`
 }
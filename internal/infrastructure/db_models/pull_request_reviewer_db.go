@@ -0,0 +1,12 @@
+package db_models
+
+type PullRequestReviewer struct {
+	PullRequestID string  `db:"pull_request_id"`
+	UserID        string  `db:"user_id"`
+	Decision      string  `db:"decision"`
+	DecidedAt     *string `db:"decided_at"`
+
+	AssignmentLevel int    `db:"assignment_level"`
+	AssignedAt      string `db:"assigned_at"`
+	SourceTeamName  string `db:"source_team_name"`
+}
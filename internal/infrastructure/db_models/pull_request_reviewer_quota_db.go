@@ -0,0 +1,7 @@
+package db_models
+
+type PullRequestReviewerQuota struct {
+	PullRequestID  string `db:"pull_request_id"`
+	SourceTeamName string `db:"source_team_name"`
+	Count          int    `db:"reviewers_count"`
+}
@@ -0,0 +1,8 @@
+package db_models
+
+type ReviewReminder struct {
+	PullRequestID string `db:"pull_request_id"`
+	RecipientID   string `db:"recipient_id"`
+	Kind          string `db:"kind"`
+	SentAt        string `db:"sent_at"`
+}
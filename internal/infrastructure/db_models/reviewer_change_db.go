@@ -0,0 +1,12 @@
+package db_models
+
+type ReviewerChange struct {
+	PullRequestID  string  `db:"pull_request_id"`
+	OldReviewerID  string  `db:"old_reviewer_id"`
+	NewReviewerID  *string `db:"new_reviewer_id"`
+	Level          int     `db:"assignment_level"`
+	SourceTeamName string  `db:"source_team_name"`
+	AssignedAt     string  `db:"assigned_at"`
+	Reason         string  `db:"reason"`
+	ChangedAt      string  `db:"changed_at"`
+}
@@ -1,6 +1,22 @@
 package db_models
 
 type Team struct {
-	ID   string `db:"id"`
-	Name string `db:"team_name"`
+	ID                 string  `db:"id"`
+	Name               string  `db:"team_name"`
+	AssignmentStrategy string  `db:"assignment_strategy"`
+	RoundRobinCursor   *string `db:"round_robin_cursor"`
+	ReviewersLimit     int     `db:"reviewers_limit"`
+	MaxOpenReviews     int     `db:"max_open_reviews"`
+
+	RemindAfterSeconds   int64 `db:"remind_after_seconds"`
+	EscalateAfterSeconds int64 `db:"escalate_after_seconds"`
+	ReassignAfterSeconds int64 `db:"reassign_after_seconds"`
+
+	LeadID              *string `db:"lead_id"`
+	MinApprovals        int     `db:"min_approvals"`
+	RequireLeadApproval bool    `db:"require_lead_approval"`
+
+	ArchivedAt *string `db:"archived_at"`
+
+	ParentName string `db:"parent_team_name"`
 }
@@ -5,4 +5,6 @@ type User struct {
 	Username string `db:"username"`
 	Team     string `db:"team_name"`
 	IsActive bool   `db:"is_active"`
+
+	MaxOpenReviews int `db:"max_open_reviews"`
 }
@@ -0,0 +1,28 @@
+package db_models
+
+type Webhook struct {
+	ID         int64    `db:"id"`
+	URL        string   `db:"url"`
+	Secret     string   `db:"secret"`
+	EventTypes []string `db:"event_types"`
+	CreatedAt  string   `db:"created_at"`
+}
+
+type WebhookDeadLetter struct {
+	ID         int64  `db:"id"`
+	WebhookID  int64  `db:"webhook_id"`
+	EventType  string `db:"event_type"`
+	Payload    string `db:"payload"`
+	OccurredAt string `db:"occurred_at"`
+	Attempts   int    `db:"attempts"`
+	LastError  string `db:"last_error"`
+	FailedAt   string `db:"failed_at"`
+}
+
+type WebhookDelivery struct {
+	WebhookID     int64   `db:"webhook_id"`
+	OutboxID      int64   `db:"outbox_id"`
+	Attempts      int     `db:"attempts"`
+	NextAttemptAt *string `db:"next_attempt_at"`
+	Done          bool    `db:"done"`
+}
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+type auditRepository struct {
+	store *Store
+}
+
+func NewAuditRepository(store *Store) app.AuditRepository {
+	return &auditRepository{store: store}
+}
+
+func (r *auditRepository) Add(ctx context.Context, entries ...entities.AuditEntry) error {
+	defer r.store.lock(ctx)()
+
+	for _, entry := range entries {
+		r.store.auditSequence++
+		entry.ID = r.store.auditSequence
+		r.store.auditLog = append(r.store.auditLog, entry)
+	}
+
+	return nil
+}
+
+func (r *auditRepository) Find(ctx context.Context, query entities.AuditQuery) ([]entities.AuditEntry, error) {
+	defer r.store.lock(ctx)()
+
+	var entries []entities.AuditEntry
+
+	for _, entry := range r.store.auditLog {
+		if query.Limit > 0 && len(entries) == query.Limit {
+			break
+		}
+		if query.Matches(entry) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"pr-service/internal/domain/entities"
+)
+
+type EventSink struct {
+	mu        sync.Mutex
+	delivered []entities.OutboxEntry
+}
+
+func NewEventSink() *EventSink {
+	return &EventSink{}
+}
+
+func (s *EventSink) Deliver(_ context.Context, entry entities.OutboxEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delivered = append(s.delivered, entry)
+
+	return nil
+}
+
+func (s *EventSink) Delivered() []entities.OutboxEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]entities.OutboxEntry(nil), s.delivered...)
+}
@@ -0,0 +1,25 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"pr-service/internal/app"
+)
+
+type leaderElector struct {
+	mu sync.Mutex
+}
+
+func NewLeaderElector() app.LeaderElector {
+	return &leaderElector{}
+}
+
+func (e *leaderElector) RunIfLeader(ctx context.Context, job func(ctx context.Context) error) (bool, error) {
+	if !e.mu.TryLock() {
+		return false, nil
+	}
+	defer e.mu.Unlock()
+
+	return true, job(ctx)
+}
@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaderElector_RunIfLeader(t *testing.T) {
+	elector := NewLeaderElector()
+	ctx := context.Background()
+
+	leader, err := elector.RunIfLeader(ctx, func(ctx context.Context) error {
+		nestedLeader, err := elector.RunIfLeader(ctx, func(ctx context.Context) error {
+			t.Fatal("job must not run while another run holds leadership")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.False(t, nestedLeader)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, leader)
+
+	leader, err = elector.RunIfLeader(ctx, func(ctx context.Context) error { return errOperationFailed })
+	assert.ErrorIs(t, err, errOperationFailed)
+	assert.True(t, leader)
+}
@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"pr-service/internal/domain/entities"
+)
+
+type Notifier struct {
+	mu   sync.Mutex
+	sent []entities.ReviewReminder
+}
+
+func NewNotifier() *Notifier {
+	return &Notifier{}
+}
+
+func (n *Notifier) Notify(_ context.Context, reminder entities.ReviewReminder) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.sent = append(n.sent, reminder)
+
+	return nil
+}
+
+func (n *Notifier) Sent() []entities.ReviewReminder {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return append([]entities.ReviewReminder(nil), n.sent...)
+}
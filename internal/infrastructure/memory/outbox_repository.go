@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+type outboxRepository struct {
+	store *Store
+}
+
+func NewOutboxRepository(store *Store) app.OutboxRepository {
+	return &outboxRepository{store: store}
+}
+
+func (r *outboxRepository) Add(ctx context.Context, events ...entities.Event) error {
+	defer r.store.lock(ctx)()
+
+	for _, event := range events {
+		r.store.outboxSequence++
+		r.store.outbox = append(r.store.outbox, entities.OutboxEntry{ID: r.store.outboxSequence, Event: event})
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) GetPending(ctx context.Context, limit int) ([]entities.OutboxEntry, error) {
+	defer r.store.lock(ctx)()
+
+	var entries []entities.OutboxEntry
+
+	for _, entry := range r.store.outbox {
+		if len(entries) == limit {
+			break
+		}
+		if entry.DeliveredAt == nil {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+func (r *outboxRepository) MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error {
+	defer r.store.lock(ctx)()
+
+	for i := range r.store.outbox {
+		if r.store.outbox[i].ID == id {
+			r.store.outbox[i].DeliveredAt = &deliveredAt
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) ScheduleRetry(ctx context.Context, id int64, nextAttemptAt time.Time) error {
+	defer r.store.lock(ctx)()
+
+	for i := range r.store.outbox {
+		if r.store.outbox[i].ID == id {
+			r.store.outbox[i].NextAttemptAt = &nextAttemptAt
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,360 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type pullRequestRepository struct {
+	store *Store
+}
+
+func NewPullRequestRepository(store *Store) app.PullRequestRepository {
+	return &pullRequestRepository{store: store}
+}
+
+func (r *pullRequestRepository) Create(ctx context.Context, pullRequest *entities.PullRequest) error {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.pullRequests[pullRequest.ID]; ok {
+		return fmt.Errorf("failed to insert pull request: pull request %q already exists", pullRequest.ID)
+	}
+	if _, ok := r.store.users[pullRequest.AuthorID]; !ok {
+		return fmt.Errorf("failed to insert pull request: author %q does not exist", pullRequest.AuthorID)
+	}
+	for _, reviewerID := range pullRequest.Reviewers() {
+		if _, ok := r.store.users[reviewerID]; !ok {
+			return fmt.Errorf("failed to insert reviewer: user %q does not exist", reviewerID)
+		}
+	}
+
+	r.store.pullRequests[pullRequest.ID] = clonePullRequest(*pullRequest)
+
+	return nil
+}
+
+func (r *pullRequestRepository) Save(ctx context.Context, pullRequest *entities.PullRequest) error {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.pullRequests[pullRequest.ID]
+	if !ok || stored.Version != pullRequest.Version {
+		return domain.ErrConcurrentModification
+	}
+
+	stored.Status = pullRequest.Status
+	stored.MergedAt = pullRequest.MergedAt
+	stored.ForceMerged = pullRequest.ForceMerged
+	stored.ClosedAt = pullRequest.ClosedAt
+	stored.ClosedFromDraft = pullRequest.ClosedFromDraft
+	stored.Version++
+	r.store.pullRequests[pullRequest.ID] = clonePullRequest(stored)
+
+	pullRequest.Version++
+
+	return nil
+}
+
+func (r *pullRequestRepository) GetByID(ctx context.Context, id value_objects.PullRequestID) (*entities.PullRequest, error) {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.pullRequests[id]
+	if !ok {
+		return nil, domain.ErrPRNotFound
+	}
+
+	pullRequest := clonePullRequest(stored)
+
+	return &pullRequest, nil
+}
+
+func (r *pullRequestRepository) GetByReviewer(ctx context.Context, reviewerID value_objects.UserID) ([]entities.PullRequest, error) {
+	defer r.store.lock(ctx)()
+
+	var pullRequests []entities.PullRequest
+
+	for _, id := range sortedKeys(r.store.pullRequests) {
+		if stored := r.store.pullRequests[id]; stored.IsReviewer(reviewerID) {
+			pullRequests = append(pullRequests, clonePullRequest(stored))
+		}
+	}
+
+	return pullRequests, nil
+}
+
+func (r *pullRequestRepository) GetAll(ctx context.Context) ([]entities.PullRequest, error) {
+	defer r.store.lock(ctx)()
+
+	var pullRequests []entities.PullRequest
+
+	for _, id := range sortedKeys(r.store.pullRequests) {
+		pullRequests = append(pullRequests, clonePullRequest(r.store.pullRequests[id]))
+	}
+
+	return pullRequests, nil
+}
+
+func (r *pullRequestRepository) ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID, assignment entities.ReviewerAssignment) error {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.pullRequests[pullRequestID]
+	if !ok {
+		return nil
+	}
+	if _, ok := r.store.users[assignment.ReviewerID]; !ok {
+		return fmt.Errorf("failed to reassign reviewer: user %q does not exist", assignment.ReviewerID)
+	}
+
+	reviewers := stored.Reviewers()
+	for i, reviewerID := range reviewers {
+		if reviewerID == oldReviewerID {
+			reviewers[i] = assignment.ReviewerID
+		}
+	}
+
+	var reviews []entities.Review
+	for _, review := range stored.Reviews() {
+		if review.ReviewerID != oldReviewerID {
+			reviews = append(reviews, review)
+		}
+	}
+
+	var assignments []entities.ReviewerAssignment
+	for _, storedAssignment := range stored.Assignments() {
+		if storedAssignment.ReviewerID != oldReviewerID {
+			assignments = append(assignments, storedAssignment)
+		}
+	}
+
+	stored.SetReviewers(reviewers)
+	stored.SetReviews(reviews)
+	stored.SetAssignments(append(assignments, assignment))
+	r.store.pullRequests[pullRequestID] = stored
+
+	return nil
+}
+
+func (r *pullRequestRepository) AddReviewers(ctx context.Context, pullRequestID value_objects.PullRequestID, assignments []entities.ReviewerAssignment) error {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.pullRequests[pullRequestID]
+	if !ok {
+		return fmt.Errorf("failed to insert reviewers: pull request %q does not exist", pullRequestID)
+	}
+
+	reviewers := stored.Reviewers()
+	for _, assignment := range assignments {
+		if _, ok := r.store.users[assignment.ReviewerID]; !ok {
+			return fmt.Errorf("failed to insert reviewers: user %q does not exist", assignment.ReviewerID)
+		}
+		if stored.IsReviewer(assignment.ReviewerID) {
+			return fmt.Errorf("failed to insert reviewers: user %q is already a reviewer", assignment.ReviewerID)
+		}
+
+		reviewers = append(reviewers, assignment.ReviewerID)
+	}
+
+	stored.SetReviewers(reviewers)
+	stored.SetAssignments(append(stored.Assignments(), assignments...))
+	r.store.pullRequests[pullRequestID] = stored
+
+	return nil
+}
+
+func (r *pullRequestRepository) RemoveReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID) error {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.pullRequests[pullRequestID]
+	if !ok || !stored.IsReviewer(reviewerID) {
+		return domain.ErrNotAssigned
+	}
+
+	var reviewers []value_objects.UserID
+	for _, id := range stored.Reviewers() {
+		if id != reviewerID {
+			reviewers = append(reviewers, id)
+		}
+	}
+
+	var reviews []entities.Review
+	for _, review := range stored.Reviews() {
+		if review.ReviewerID != reviewerID {
+			reviews = append(reviews, review)
+		}
+	}
+
+	var assignments []entities.ReviewerAssignment
+	for _, assignment := range stored.Assignments() {
+		if assignment.ReviewerID != reviewerID {
+			assignments = append(assignments, assignment)
+		}
+	}
+
+	stored.SetReviewers(reviewers)
+	stored.SetReviews(reviews)
+	stored.SetAssignments(assignments)
+	r.store.pullRequests[pullRequestID] = stored
+
+	return nil
+}
+
+func (r *pullRequestRepository) RemoveReviewerQuotas(ctx context.Context, pullRequestID value_objects.PullRequestID) error {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.pullRequests[pullRequestID]
+	if !ok {
+		return nil
+	}
+
+	stored.BorrowedReviewers = nil
+	r.store.pullRequests[pullRequestID] = stored
+
+	return nil
+}
+
+func (r *pullRequestRepository) SaveReview(ctx context.Context, pullRequestID value_objects.PullRequestID, review entities.Review) error {
+	defer r.store.lock(ctx)()
+
+	stored, ok := r.store.pullRequests[pullRequestID]
+	if !ok || !stored.IsReviewer(review.ReviewerID) {
+		return domain.ErrNotAssigned
+	}
+
+	reviews := stored.Reviews()
+	for i := range reviews {
+		if reviews[i].ReviewerID == review.ReviewerID {
+			reviews[i] = review
+		}
+	}
+
+	stored.SetReviews(reviews)
+	r.store.pullRequests[pullRequestID] = stored
+
+	return nil
+}
+
+func (r *pullRequestRepository) CountOpenReviews(ctx context.Context, reviewerIDs []value_objects.UserID) (map[value_objects.UserID]int, error) {
+	defer r.store.lock(ctx)()
+
+	openReviews := make(map[value_objects.UserID]int, len(reviewerIDs))
+
+	for _, pullRequest := range r.store.pullRequests {
+		if pullRequest.Status != entities.StatusOpen {
+			continue
+		}
+
+		for _, reviewerID := range reviewerIDs {
+			if pullRequest.IsReviewer(reviewerID) {
+				openReviews[reviewerID]++
+			}
+		}
+	}
+
+	return openReviews, nil
+}
+
+func (r *pullRequestRepository) CountOpenByTeam(ctx context.Context, teamName value_objects.TeamName) (int, error) {
+	defer r.store.lock(ctx)()
+
+	count := 0
+
+	for _, pullRequest := range r.store.pullRequests {
+		if r.usesTeam(pullRequest, teamName) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *pullRequestRepository) usesTeam(pullRequest entities.PullRequest, teamName value_objects.TeamName) bool {
+	for _, quota := range pullRequest.BorrowedReviewers {
+		if quota.TeamName == teamName {
+			return true
+		}
+	}
+
+	if pullRequest.Status != entities.StatusOpen && pullRequest.Status != entities.StatusDraft {
+		return false
+	}
+
+	if author, ok := r.store.users[pullRequest.AuthorID]; ok && author.Team == teamName {
+		return true
+	}
+
+	for _, assignment := range pullRequest.Assignments() {
+		if assignment.SourceTeam == teamName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *pullRequestRepository) LockUnderAssigned(ctx context.Context, afterID value_objects.PullRequestID, limit int) ([]value_objects.PullRequestID, error) {
+	defer r.store.lock(ctx)()
+
+	var pullRequestIDs []value_objects.PullRequestID
+
+	for id, pullRequest := range r.store.pullRequests {
+		if id > afterID && pullRequest.AvailableReviewerSlots() > 0 {
+			pullRequestIDs = append(pullRequestIDs, id)
+		}
+	}
+
+	sort.Slice(pullRequestIDs, func(i, j int) bool {
+		return pullRequestIDs[i] < pullRequestIDs[j]
+	})
+
+	if len(pullRequestIDs) > limit {
+		pullRequestIDs = pullRequestIDs[:limit]
+	}
+
+	return pullRequestIDs, nil
+}
+
+func (r *pullRequestRepository) GetOpenCreatedBefore(ctx context.Context, createdBefore time.Time) ([]entities.PullRequest, error) {
+	defer r.store.lock(ctx)()
+
+	var pullRequests []entities.PullRequest
+
+	for _, id := range sortedKeys(r.store.pullRequests) {
+		if pullRequest := r.store.pullRequests[id]; pullRequest.IsOpen() && pullRequest.CreatedAt.Before(createdBefore) {
+			pullRequests = append(pullRequests, clonePullRequest(pullRequest))
+		}
+	}
+
+	return pullRequests, nil
+}
+
+func (r *pullRequestRepository) AddReviewerChange(ctx context.Context, change entities.ReviewerChange) error {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.pullRequests[change.PullRequestID]; !ok {
+		return fmt.Errorf("failed to insert reviewer change: pull request %q does not exist", change.PullRequestID)
+	}
+
+	r.store.reviewerChanges = append(r.store.reviewerChanges, change)
+
+	return nil
+}
+
+func (r *pullRequestRepository) GetReviewerChanges(ctx context.Context, pullRequestID value_objects.PullRequestID) ([]entities.ReviewerChange, error) {
+	defer r.store.lock(ctx)()
+
+	var changes []entities.ReviewerChange
+
+	for _, change := range r.store.reviewerChanges {
+		if change.PullRequestID == pullRequestID {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+}
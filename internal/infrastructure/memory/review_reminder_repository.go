@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+type reviewReminderRepository struct {
+	store *Store
+}
+
+func NewReviewReminderRepository(store *Store) app.ReviewReminderRepository {
+	return &reviewReminderRepository{store: store}
+}
+
+func (r *reviewReminderRepository) Record(ctx context.Context, reminder entities.ReviewReminder) (bool, error) {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.pullRequests[reminder.PullRequestID]; !ok {
+		return false, fmt.Errorf("failed to record reminder: pull request %q does not exist", reminder.PullRequestID)
+	}
+	if _, ok := r.store.users[reminder.RecipientID]; !ok {
+		return false, fmt.Errorf("failed to record reminder: user %q does not exist", reminder.RecipientID)
+	}
+
+	key := reviewReminderKeyOf(reminder)
+	if _, ok := r.store.reviewReminders[key]; ok {
+		return false, nil
+	}
+
+	r.store.reviewReminders[key] = reviewReminderRecord{reminder: reminder}
+
+	return true, nil
+}
+
+func (r *reviewReminderRepository) GetUndelivered(ctx context.Context) ([]entities.ReviewReminder, error) {
+	defer r.store.lock(ctx)()
+
+	var reminders []entities.ReviewReminder
+
+	for _, record := range r.store.reviewReminders {
+		if record.deliveredAt == nil {
+			reminders = append(reminders, record.reminder)
+		}
+	}
+
+	sort.Slice(reminders, func(i, j int) bool {
+		if !reminders[i].SentAt.Equal(reminders[j].SentAt) {
+			return reminders[i].SentAt.Before(reminders[j].SentAt)
+		}
+		if reminders[i].PullRequestID != reminders[j].PullRequestID {
+			return reminders[i].PullRequestID < reminders[j].PullRequestID
+		}
+		if reminders[i].RecipientID != reminders[j].RecipientID {
+			return reminders[i].RecipientID < reminders[j].RecipientID
+		}
+
+		return reminders[i].Kind < reminders[j].Kind
+	})
+
+	return reminders, nil
+}
+
+func (r *reviewReminderRepository) MarkDelivered(ctx context.Context, reminder entities.ReviewReminder, deliveredAt time.Time) error {
+	defer r.store.lock(ctx)()
+
+	key := reviewReminderKeyOf(reminder)
+
+	record, ok := r.store.reviewReminders[key]
+	if !ok {
+		return nil
+	}
+
+	record.deliveredAt = &deliveredAt
+	r.store.reviewReminders[key] = record
+
+	return nil
+}
+
+func reviewReminderKeyOf(reminder entities.ReviewReminder) reviewReminderKey {
+	return reviewReminderKey{
+		pullRequestID: reminder.PullRequestID,
+		recipientID:   reminder.RecipientID,
+		kind:          reminder.Kind,
+	}
+}
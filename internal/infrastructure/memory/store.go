@@ -0,0 +1,204 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type Store struct {
+	mu sync.Mutex
+
+	users        map[value_objects.UserID]entities.User
+	teams        map[value_objects.TeamName]entities.Team
+	pullRequests map[value_objects.PullRequestID]entities.PullRequest
+
+	membershipHistory []entities.MembershipChange
+	teamSequence      int
+
+	availabilityWindows  []entities.AvailabilityWindow
+	availabilitySequence int64
+
+	reviewReminders map[reviewReminderKey]reviewReminderRecord
+	reviewerChanges []entities.ReviewerChange
+
+	webhooks           []entities.Webhook
+	webhookSequence    int64
+	deadLetters        []entities.WebhookDeadLetter
+	deadLetterSequence int64
+	webhookDeliveries  []entities.WebhookDelivery
+
+	outbox         []entities.OutboxEntry
+	outboxSequence int64
+
+	auditLog      []entities.AuditEntry
+	auditSequence int64
+}
+
+type reviewReminderKey struct {
+	pullRequestID value_objects.PullRequestID
+	recipientID   value_objects.UserID
+	kind          entities.ReminderKind
+}
+
+type reviewReminderRecord struct {
+	reminder    entities.ReviewReminder
+	deliveredAt *time.Time
+}
+
+func NewStore() *Store {
+	return &Store{
+		users:           make(map[value_objects.UserID]entities.User),
+		teams:           make(map[value_objects.TeamName]entities.Team),
+		pullRequests:    make(map[value_objects.PullRequestID]entities.PullRequest),
+		reviewReminders: make(map[reviewReminderKey]reviewReminderRecord),
+	}
+}
+
+type txKey struct{}
+
+func (s *Store) inTx(ctx context.Context) bool {
+	store, ok := ctx.Value(txKey{}).(*Store)
+
+	return ok && store == s
+}
+
+func (s *Store) withTx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, txKey{}, s)
+}
+
+func (s *Store) lock(ctx context.Context) func() {
+	if s.inTx(ctx) {
+		return func() {}
+	}
+
+	s.mu.Lock()
+
+	return s.mu.Unlock
+}
+
+type snapshot struct {
+	users        map[value_objects.UserID]entities.User
+	teams        map[value_objects.TeamName]entities.Team
+	pullRequests map[value_objects.PullRequestID]entities.PullRequest
+
+	membershipHistory []entities.MembershipChange
+	teamSequence      int
+
+	availabilityWindows  []entities.AvailabilityWindow
+	availabilitySequence int64
+
+	reviewReminders map[reviewReminderKey]reviewReminderRecord
+	reviewerChanges []entities.ReviewerChange
+
+	webhooks           []entities.Webhook
+	webhookSequence    int64
+	deadLetters        []entities.WebhookDeadLetter
+	deadLetterSequence int64
+	webhookDeliveries  []entities.WebhookDelivery
+
+	outbox         []entities.OutboxEntry
+	outboxSequence int64
+
+	auditLog      []entities.AuditEntry
+	auditSequence int64
+}
+
+func (s *Store) snapshot() snapshot {
+	snap := snapshot{
+		users:        make(map[value_objects.UserID]entities.User, len(s.users)),
+		teams:        make(map[value_objects.TeamName]entities.Team, len(s.teams)),
+		pullRequests: make(map[value_objects.PullRequestID]entities.PullRequest, len(s.pullRequests)),
+
+		membershipHistory: append([]entities.MembershipChange(nil), s.membershipHistory...),
+		teamSequence:      s.teamSequence,
+
+		availabilityWindows:  append([]entities.AvailabilityWindow(nil), s.availabilityWindows...),
+		availabilitySequence: s.availabilitySequence,
+
+		reviewReminders: make(map[reviewReminderKey]reviewReminderRecord, len(s.reviewReminders)),
+		reviewerChanges: append([]entities.ReviewerChange(nil), s.reviewerChanges...),
+
+		webhooks:           append([]entities.Webhook(nil), s.webhooks...),
+		webhookSequence:    s.webhookSequence,
+		deadLetters:        append([]entities.WebhookDeadLetter(nil), s.deadLetters...),
+		deadLetterSequence: s.deadLetterSequence,
+		webhookDeliveries:  append([]entities.WebhookDelivery(nil), s.webhookDeliveries...),
+
+		outbox:         append([]entities.OutboxEntry(nil), s.outbox...),
+		outboxSequence: s.outboxSequence,
+
+		auditLog:      append([]entities.AuditEntry(nil), s.auditLog...),
+		auditSequence: s.auditSequence,
+	}
+
+	for id, user := range s.users {
+		snap.users[id] = user
+	}
+	for name, team := range s.teams {
+		snap.teams[name] = team
+	}
+	for id, pullRequest := range s.pullRequests {
+		snap.pullRequests[id] = clonePullRequest(pullRequest)
+	}
+	for key, record := range s.reviewReminders {
+		snap.reviewReminders[key] = record
+	}
+
+	return snap
+}
+
+func (s *Store) restore(snap snapshot) {
+	s.users = snap.users
+	s.teams = snap.teams
+	s.pullRequests = snap.pullRequests
+	s.membershipHistory = snap.membershipHistory
+	s.teamSequence = snap.teamSequence
+	s.availabilityWindows = snap.availabilityWindows
+	s.availabilitySequence = snap.availabilitySequence
+	s.reviewReminders = snap.reviewReminders
+	s.reviewerChanges = snap.reviewerChanges
+	s.webhooks = snap.webhooks
+	s.webhookSequence = snap.webhookSequence
+	s.deadLetters = snap.deadLetters
+	s.deadLetterSequence = snap.deadLetterSequence
+	s.webhookDeliveries = snap.webhookDeliveries
+	s.outbox = snap.outbox
+	s.outboxSequence = snap.outboxSequence
+	s.auditLog = snap.auditLog
+	s.auditSequence = snap.auditSequence
+}
+
+func clonePullRequest(pullRequest entities.PullRequest) entities.PullRequest {
+	clone := pullRequest
+	clone.SetReviewers(pullRequest.Reviewers())
+	clone.SetReviews(pullRequest.Reviews())
+	clone.SetAssignments(pullRequest.Assignments())
+	clone.BorrowedReviewers = append([]entities.ReviewerQuota(nil), pullRequest.BorrowedReviewers...)
+
+	if pullRequest.MergedAt != nil {
+		mergedAt := *pullRequest.MergedAt
+		clone.MergedAt = &mergedAt
+	}
+	if pullRequest.ClosedAt != nil {
+		closedAt := *pullRequest.ClosedAt
+		clone.ClosedAt = &closedAt
+	}
+
+	return clone
+}
+
+func sortedKeys[K ~string, V any](values map[K]V) []K {
+	keys := make([]K, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
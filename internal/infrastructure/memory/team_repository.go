@@ -0,0 +1,287 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type teamRepository struct {
+	store *Store
+}
+
+func NewTeamRepository(store *Store) app.TeamRepository {
+	return &teamRepository{store: store}
+}
+
+func (r *teamRepository) Create(ctx context.Context, team entities.Team) (entities.Team, error) {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.teams[team.Name]; ok {
+		return entities.Team{}, fmt.Errorf("failed to create team: team %q already exists", team.Name)
+	}
+
+	r.store.teamSequence++
+	team.ID = value_objects.TeamID(fmt.Sprintf("team-%d", r.store.teamSequence))
+	r.store.teams[team.Name] = team
+
+	return team, nil
+}
+
+func (r *teamRepository) GetByName(ctx context.Context, name value_objects.TeamName) (entities.Team, error) {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return entities.Team{}, domain.ErrTeamNotFound
+	}
+
+	return team, nil
+}
+
+func (r *teamRepository) GetAll(ctx context.Context, includeArchived bool) ([]entities.Team, error) {
+	defer r.store.lock(ctx)()
+
+	var teams []entities.Team
+
+	for _, name := range sortedKeys(r.store.teams) {
+		if team := r.store.teams[name]; includeArchived || !team.IsArchived() {
+			teams = append(teams, team)
+		}
+	}
+
+	return teams, nil
+}
+
+func (r *teamRepository) UpdateAssignmentStrategy(ctx context.Context, name value_objects.TeamName, strategy entities.AssignmentStrategy) error {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return domain.ErrTeamNotFound
+	}
+
+	team.AssignmentStrategy = strategy
+	r.store.teams[name] = team
+
+	return nil
+}
+
+func (r *teamRepository) UpdateMergePolicy(ctx context.Context, name value_objects.TeamName, leadID value_objects.UserID, policy entities.MergePolicy) error {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return domain.ErrTeamNotFound
+	}
+
+	team.LeadID = leadID
+	team.MergePolicy = policy
+	r.store.teams[name] = team
+
+	return nil
+}
+
+func (r *teamRepository) LockRoundRobinCursor(ctx context.Context, name value_objects.TeamName) (value_objects.UserID, error) {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return "", domain.ErrTeamNotFound
+	}
+
+	return team.RoundRobinCursor, nil
+}
+
+func (r *teamRepository) UpdateRoundRobinCursor(ctx context.Context, name value_objects.TeamName, cursor value_objects.UserID) error {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return nil
+	}
+
+	team.RoundRobinCursor = cursor
+	r.store.teams[name] = team
+
+	return nil
+}
+
+func (r *teamRepository) AddMembershipChanges(ctx context.Context, changes []entities.MembershipChange) error {
+	defer r.store.lock(ctx)()
+
+	r.store.membershipHistory = append(r.store.membershipHistory, changes...)
+
+	return nil
+}
+
+func (r *teamRepository) GetMembershipHistory(ctx context.Context, name value_objects.TeamName) ([]entities.MembershipChange, error) {
+	defer r.store.lock(ctx)()
+
+	var history []entities.MembershipChange
+
+	for _, change := range r.store.membershipHistory {
+		if change.FromTeam == name || change.ToTeam == name {
+			history = append(history, change)
+		}
+	}
+
+	return history, nil
+}
+
+func (r *teamRepository) Archive(ctx context.Context, name value_objects.TeamName, archivedAt time.Time) error {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return domain.ErrTeamNotFound
+	}
+
+	team.ArchivedAt = &archivedAt
+	r.store.teams[name] = team
+
+	return nil
+}
+
+func (r *teamRepository) Delete(ctx context.Context, name value_objects.TeamName) error {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.teams[name]; !ok {
+		return domain.ErrTeamNotFound
+	}
+
+	delete(r.store.teams, name)
+	r.renameParent(name, "")
+	r.renameSourceTeam(name, "")
+	r.renameInHistory(name, "")
+
+	return nil
+}
+
+func (r *teamRepository) Rename(ctx context.Context, name value_objects.TeamName, newName value_objects.TeamName) error {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return domain.ErrTeamNotFound
+	}
+	if _, ok := r.store.teams[newName]; ok {
+		return fmt.Errorf("failed to rename team: team %q already exists", newName)
+	}
+
+	team.Name = newName
+	delete(r.store.teams, name)
+	r.store.teams[newName] = team
+
+	for id, user := range r.store.users {
+		if user.Team == name {
+			user.Team = newName
+			r.store.users[id] = user
+		}
+	}
+
+	r.renameParent(name, newName)
+	r.renameSourceTeam(name, newName)
+	r.renameInHistory(name, newName)
+
+	return nil
+}
+
+func (r *teamRepository) UpdateParent(ctx context.Context, name value_objects.TeamName, parentName value_objects.TeamName) error {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return domain.ErrTeamNotFound
+	}
+
+	team.Parent = parentName
+	r.store.teams[name] = team
+
+	return nil
+}
+
+func (r *teamRepository) UpdateMaxOpenReviews(ctx context.Context, name value_objects.TeamName, maxOpenReviews int) error {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return domain.ErrTeamNotFound
+	}
+
+	team.MaxOpenReviews = maxOpenReviews
+	r.store.teams[name] = team
+
+	return nil
+}
+
+func (r *teamRepository) UpdateReviewSLA(ctx context.Context, name value_objects.TeamName, sla entities.ReviewSLA) error {
+	defer r.store.lock(ctx)()
+
+	team, ok := r.store.teams[name]
+	if !ok {
+		return domain.ErrTeamNotFound
+	}
+
+	team.ReviewSLA = sla
+	r.store.teams[name] = team
+
+	return nil
+}
+
+func (r *teamRepository) renameParent(name value_objects.TeamName, newName value_objects.TeamName) {
+	for teamName, team := range r.store.teams {
+		if team.Parent == name {
+			team.Parent = newName
+			r.store.teams[teamName] = team
+		}
+	}
+}
+
+func (r *teamRepository) renameSourceTeam(name value_objects.TeamName, newName value_objects.TeamName) {
+	for id, pullRequest := range r.store.pullRequests {
+		assignments := pullRequest.Assignments()
+		renamed := false
+
+		for i, assignment := range assignments {
+			if assignment.SourceTeam == name {
+				assignments[i].SourceTeam = newName
+				renamed = true
+			}
+		}
+
+		var quotas []entities.ReviewerQuota
+		for _, quota := range pullRequest.BorrowedReviewers {
+			if quota.TeamName == name {
+				renamed = true
+				if newName == "" {
+					continue
+				}
+				quota.TeamName = newName
+			}
+			quotas = append(quotas, quota)
+		}
+
+		if renamed {
+			pullRequest.SetAssignments(assignments)
+			pullRequest.BorrowedReviewers = quotas
+			r.store.pullRequests[id] = pullRequest
+		}
+	}
+}
+
+func (r *teamRepository) renameInHistory(name value_objects.TeamName, newName value_objects.TeamName) {
+	for i, change := range r.store.membershipHistory {
+		if change.FromTeam == name {
+			r.store.membershipHistory[i].FromTeam = newName
+		}
+		if change.ToTeam == name {
+			r.store.membershipHistory[i].ToTeam = newName
+		}
+	}
+}
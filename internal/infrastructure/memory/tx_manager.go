@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"pr-service/internal/app"
+)
+
+type txManager struct {
+	store *Store
+}
+
+func NewTxManager(store *Store) app.TxManager {
+	return &txManager{store: store}
+}
+
+func (tm *txManager) Do(ctx context.Context, operation func(ctx context.Context) error) error {
+	if tm.store.inTx(ctx) {
+		return operation(ctx)
+	}
+
+	tm.store.mu.Lock()
+	defer tm.store.mu.Unlock()
+
+	snap := tm.store.snapshot()
+
+	defer func() {
+		if p := recover(); p != nil {
+			tm.store.restore(snap)
+			panic(p)
+		}
+	}()
+
+	if err := operation(tm.store.withTx(ctx)); err != nil {
+		tm.store.restore(snap)
+		return fmt.Errorf("operation failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+var errOperationFailed = errors.New("operation failed")
+
+func TestTxManager_Do_CommitsOnSuccess(t *testing.T) {
+	store := NewStore()
+	txManager := NewTxManager(store)
+	teamRepository := NewTeamRepository(store)
+	ctx := context.Background()
+
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		_, err := teamRepository.Create(ctx, entities.Team{Name: "backend"})
+
+		return err
+	})
+
+	assert.NoError(t, err)
+
+	team, err := teamRepository.GetByName(ctx, "backend")
+	assert.NoError(t, err)
+	assert.Equal(t, value_objects.TeamName("backend"), team.Name)
+}
+
+func TestTxManager_Do_RollsBackOnError(t *testing.T) {
+	store := NewStore()
+	txManager := NewTxManager(store)
+	userRepository := NewUserRepository(store)
+	pullRequestRepository := NewPullRequestRepository(store)
+	ctx := context.Background()
+
+	members := []entities.User{
+		{ID: "author-1", Username: "Author", IsActive: true},
+		{ID: "reviewer-1", Username: "Reviewer", IsActive: true},
+	}
+	require.NoError(t, userRepository.UpsertMembers(ctx, "backend", members))
+
+	pullRequest := entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now())
+	pullRequest.AddReviewers([]value_objects.UserID{"reviewer-1"})
+	require.NoError(t, pullRequestRepository.Create(ctx, pullRequest))
+
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		if _, err := userRepository.SetIsActive(ctx, "reviewer-1", false); err != nil {
+			return err
+		}
+
+		stored, err := pullRequestRepository.GetByID(ctx, "pull-request-1")
+		if err != nil {
+			return err
+		}
+
+		stored.Merge(time.Now())
+		if err := pullRequestRepository.Save(ctx, stored); err != nil {
+			return err
+		}
+
+		return errOperationFailed
+	})
+
+	assert.ErrorIs(t, err, errOperationFailed)
+
+	reviewer, err := userRepository.GetByID(ctx, "reviewer-1")
+	assert.NoError(t, err)
+	assert.True(t, reviewer.IsActive)
+
+	stored, err := pullRequestRepository.GetByID(ctx, "pull-request-1")
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StatusOpen, stored.Status)
+	assert.Equal(t, 1, stored.Version)
+}
+
+func TestTxManager_Do_RollsBackPullRequestWhenReviewerIsUnknown(t *testing.T) {
+	store := NewStore()
+	txManager := NewTxManager(store)
+	userRepository := NewUserRepository(store)
+	pullRequestRepository := NewPullRequestRepository(store)
+	ctx := context.Background()
+
+	require.NoError(t, userRepository.UpsertMembers(ctx, "backend", []entities.User{{ID: "author-1", IsActive: true}}))
+
+	pullRequest := entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now())
+	pullRequest.AddReviewers([]value_objects.UserID{"non-existent-user"})
+
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		return pullRequestRepository.Create(ctx, pullRequest)
+	})
+
+	assert.Error(t, err)
+
+	allPullRequests, err := pullRequestRepository.GetAll(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, allPullRequests)
+}
+
+func TestTxManager_Do_NestedCallJoinsOuterTransaction(t *testing.T) {
+	store := NewStore()
+	txManager := NewTxManager(store)
+	teamRepository := NewTeamRepository(store)
+	ctx := context.Background()
+
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		innerErr := txManager.Do(ctx, func(ctx context.Context) error {
+			_, err := teamRepository.Create(ctx, entities.Team{Name: "backend"})
+
+			return err
+		})
+		if innerErr != nil {
+			return innerErr
+		}
+
+		return errOperationFailed
+	})
+
+	assert.ErrorIs(t, err, errOperationFailed)
+
+	_, err = teamRepository.GetByName(ctx, "backend")
+	assert.Error(t, err)
+}
+
+func TestTxManager_Do_RollsBackOutboxEntries(t *testing.T) {
+	store := NewStore()
+	txManager := NewTxManager(store)
+	outboxRepository := NewOutboxRepository(store)
+	ctx := context.Background()
+
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		if err := outboxRepository.Add(ctx, entities.Event{Type: entities.EventPullRequestCreated, AggregateID: "pull-request-1"}); err != nil {
+			return err
+		}
+
+		return errOperationFailed
+	})
+
+	assert.ErrorIs(t, err, errOperationFailed)
+
+	entries, err := outboxRepository.GetPending(ctx, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestPullRequestRepository_Save_StaleVersion(t *testing.T) {
+	store := NewStore()
+	userRepository := NewUserRepository(store)
+	pullRequestRepository := NewPullRequestRepository(store)
+	ctx := context.Background()
+
+	require.NoError(t, userRepository.UpsertMembers(ctx, "backend", []entities.User{{ID: "author-1", IsActive: true}}))
+	require.NoError(t, pullRequestRepository.Create(ctx, entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now())))
+
+	firstCopy, err := pullRequestRepository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	secondCopy, err := pullRequestRepository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+
+	firstCopy.Merge(time.Now())
+	require.NoError(t, pullRequestRepository.Save(ctx, firstCopy))
+
+	err = pullRequestRepository.Save(ctx, secondCopy)
+
+	assert.Error(t, err)
+	assert.Equal(t, 2, firstCopy.Version)
+}
+
+func TestPullRequestRepository_RemoveReviewerQuotas(t *testing.T) {
+	store := NewStore()
+	userRepository := NewUserRepository(store)
+	pullRequestRepository := NewPullRequestRepository(store)
+	ctx := context.Background()
+
+	require.NoError(t, userRepository.UpsertMembers(ctx, "backend", []entities.User{{ID: "author-1", IsActive: true}}))
+
+	pullRequest := entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now())
+	pullRequest.Status = entities.StatusDraft
+	pullRequest.BorrowedReviewers = []entities.ReviewerQuota{{TeamName: "platform", Count: 1}}
+	require.NoError(t, pullRequestRepository.Create(ctx, pullRequest))
+
+	stored, err := pullRequestRepository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Equal(t, []entities.ReviewerQuota{{TeamName: "platform", Count: 1}}, stored.BorrowedReviewers)
+
+	require.NoError(t, pullRequestRepository.RemoveReviewerQuotas(ctx, "pull-request-1"))
+
+	stored, err = pullRequestRepository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Empty(t, stored.BorrowedReviewers)
+}
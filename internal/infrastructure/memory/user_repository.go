@@ -0,0 +1,199 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+)
+
+type userRepository struct {
+	store *Store
+}
+
+func NewUserRepository(store *Store) app.UserRepository {
+	return &userRepository{store: store}
+}
+
+func (r *userRepository) GetByID(ctx context.Context, id value_objects.UserID) (entities.User, error) {
+	defer r.store.lock(ctx)()
+
+	user, ok := r.store.users[id]
+	if !ok {
+		return entities.User{}, domain.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) GetUsersByTeam(ctx context.Context, teamName value_objects.TeamName) ([]entities.User, error) {
+	defer r.store.lock(ctx)()
+
+	var users []entities.User
+
+	for _, id := range sortedKeys(r.store.users) {
+		if user := r.store.users[id]; user.Team == teamName {
+			users = append(users, user)
+		}
+	}
+
+	return users, nil
+}
+
+func (r *userRepository) GetAll(ctx context.Context) ([]entities.User, error) {
+	defer r.store.lock(ctx)()
+
+	var users []entities.User
+
+	for _, id := range sortedKeys(r.store.users) {
+		users = append(users, r.store.users[id])
+	}
+
+	return users, nil
+}
+
+func (r *userRepository) UpsertMembers(ctx context.Context, teamName value_objects.TeamName, members []entities.User) error {
+	defer r.store.lock(ctx)()
+
+	for _, member := range members {
+		member.Team = teamName
+		if existing, ok := r.store.users[member.ID]; ok {
+			member.MaxOpenReviews = existing.MaxOpenReviews
+		}
+		r.store.users[member.ID] = member
+	}
+
+	return nil
+}
+
+func (r *userRepository) SetIsActive(ctx context.Context, id value_objects.UserID, isActive bool) (entities.User, error) {
+	defer r.store.lock(ctx)()
+
+	user, ok := r.store.users[id]
+	if !ok {
+		return entities.User{}, domain.ErrUserNotFound
+	}
+
+	user.IsActive = isActive
+	r.store.users[id] = user
+
+	return user, nil
+}
+
+func (r *userRepository) SetIsActiveByTeam(ctx context.Context, teamName value_objects.TeamName, userIDs []value_objects.UserID, isActive bool) ([]entities.User, error) {
+	defer r.store.lock(ctx)()
+
+	selected := make(map[value_objects.UserID]bool, len(userIDs))
+	for _, id := range userIDs {
+		selected[id] = true
+	}
+
+	var users []entities.User
+
+	for _, id := range sortedKeys(r.store.users) {
+		user := r.store.users[id]
+		if user.Team != teamName || (len(selected) > 0 && !selected[id]) {
+			continue
+		}
+
+		user.IsActive = isActive
+		r.store.users[id] = user
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+func (r *userRepository) SetTeam(ctx context.Context, userIDs []value_objects.UserID, teamName value_objects.TeamName) error {
+	defer r.store.lock(ctx)()
+
+	for _, id := range userIDs {
+		user, ok := r.store.users[id]
+		if !ok {
+			return domain.ErrUserNotFound
+		}
+
+		user.Team = teamName
+		r.store.users[id] = user
+	}
+
+	return nil
+}
+
+func (r *userRepository) SetMaxOpenReviews(ctx context.Context, id value_objects.UserID, maxOpenReviews int) (entities.User, error) {
+	defer r.store.lock(ctx)()
+
+	user, ok := r.store.users[id]
+	if !ok {
+		return entities.User{}, domain.ErrUserNotFound
+	}
+
+	user.MaxOpenReviews = maxOpenReviews
+	r.store.users[id] = user
+
+	return user, nil
+}
+
+func (r *userRepository) AddAvailabilityWindow(ctx context.Context, window entities.AvailabilityWindow) (entities.AvailabilityWindow, error) {
+	defer r.store.lock(ctx)()
+
+	if _, ok := r.store.users[window.UserID]; !ok {
+		return entities.AvailabilityWindow{}, domain.ErrUserNotFound
+	}
+
+	r.store.availabilitySequence++
+	window.ID = r.store.availabilitySequence
+	r.store.availabilityWindows = append(r.store.availabilityWindows, window)
+
+	return window, nil
+}
+
+func (r *userRepository) GetAvailabilityWindows(ctx context.Context, id value_objects.UserID) ([]entities.AvailabilityWindow, error) {
+	defer r.store.lock(ctx)()
+
+	var windows []entities.AvailabilityWindow
+
+	for _, window := range r.store.availabilityWindows {
+		if window.UserID == id {
+			windows = append(windows, window)
+		}
+	}
+
+	return windows, nil
+}
+
+func (r *userRepository) DeleteAvailabilityWindow(ctx context.Context, id value_objects.UserID, windowID int64) error {
+	defer r.store.lock(ctx)()
+
+	for i, window := range r.store.availabilityWindows {
+		if window.ID == windowID && window.UserID == id {
+			r.store.availabilityWindows = append(r.store.availabilityWindows[:i:i], r.store.availabilityWindows[i+1:]...)
+
+			return nil
+		}
+	}
+
+	return domain.ErrAvailabilityWindowNotFound
+}
+
+func (r *userRepository) GetUnavailableUserIDs(ctx context.Context, ids []value_objects.UserID, at time.Time) (map[value_objects.UserID]bool, error) {
+	defer r.store.lock(ctx)()
+
+	requested := make(map[value_objects.UserID]bool, len(ids))
+	for _, id := range ids {
+		requested[id] = true
+	}
+
+	unavailable := make(map[value_objects.UserID]bool)
+
+	for _, window := range r.store.availabilityWindows {
+		if requested[window.UserID] && window.Contains(at) {
+			unavailable[window.UserID] = true
+		}
+	}
+
+	return unavailable, nil
+}
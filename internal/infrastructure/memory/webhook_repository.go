@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+)
+
+type webhookRepository struct {
+	store *Store
+}
+
+func NewWebhookRepository(store *Store) app.WebhookRepository {
+	return &webhookRepository{store: store}
+}
+
+func (r *webhookRepository) Create(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	defer r.store.lock(ctx)()
+
+	r.store.webhookSequence++
+	webhook.ID = r.store.webhookSequence
+	webhook.EventTypes = append([]entities.EventType(nil), webhook.EventTypes...)
+	r.store.webhooks = append(r.store.webhooks, webhook)
+
+	return webhook, nil
+}
+
+func (r *webhookRepository) GetAll(ctx context.Context) ([]entities.Webhook, error) {
+	defer r.store.lock(ctx)()
+
+	webhooks := make([]entities.Webhook, 0, len(r.store.webhooks))
+
+	for _, webhook := range r.store.webhooks {
+		webhook.EventTypes = append([]entities.EventType(nil), webhook.EventTypes...)
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+func (r *webhookRepository) Delete(ctx context.Context, id int64) error {
+	defer r.store.lock(ctx)()
+
+	for i, webhook := range r.store.webhooks {
+		if webhook.ID != id {
+			continue
+		}
+
+		r.store.webhooks = append(r.store.webhooks[:i:i], r.store.webhooks[i+1:]...)
+
+		var deadLetters []entities.WebhookDeadLetter
+		for _, deadLetter := range r.store.deadLetters {
+			if deadLetter.WebhookID != id {
+				deadLetters = append(deadLetters, deadLetter)
+			}
+		}
+		r.store.deadLetters = deadLetters
+
+		var deliveries []entities.WebhookDelivery
+		for _, delivery := range r.store.webhookDeliveries {
+			if delivery.WebhookID != id {
+				deliveries = append(deliveries, delivery)
+			}
+		}
+		r.store.webhookDeliveries = deliveries
+
+		return nil
+	}
+
+	return domain.ErrWebhookNotFound
+}
+
+func (r *webhookRepository) AddDeadLetter(ctx context.Context, deadLetter entities.WebhookDeadLetter) error {
+	defer r.store.lock(ctx)()
+
+	if !r.exists(deadLetter.WebhookID) {
+		return domain.ErrWebhookNotFound
+	}
+
+	r.store.deadLetterSequence++
+	deadLetter.ID = r.store.deadLetterSequence
+	r.store.deadLetters = append(r.store.deadLetters, deadLetter)
+
+	return nil
+}
+
+func (r *webhookRepository) GetDeadLetters(ctx context.Context, webhookID int64) ([]entities.WebhookDeadLetter, error) {
+	defer r.store.lock(ctx)()
+
+	if !r.exists(webhookID) {
+		return nil, domain.ErrWebhookNotFound
+	}
+
+	var deadLetters []entities.WebhookDeadLetter
+
+	for _, deadLetter := range r.store.deadLetters {
+		if deadLetter.WebhookID == webhookID {
+			deadLetters = append(deadLetters, deadLetter)
+		}
+	}
+
+	return deadLetters, nil
+}
+
+func (r *webhookRepository) GetDeliveries(ctx context.Context, outboxEntryID int64) ([]entities.WebhookDelivery, error) {
+	defer r.store.lock(ctx)()
+
+	var deliveries []entities.WebhookDelivery
+
+	for _, delivery := range r.store.webhookDeliveries {
+		if delivery.OutboxEntryID == outboxEntryID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return deliveries, nil
+}
+
+func (r *webhookRepository) SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error {
+	defer r.store.lock(ctx)()
+
+	if !r.exists(delivery.WebhookID) {
+		return domain.ErrWebhookNotFound
+	}
+
+	for i, stored := range r.store.webhookDeliveries {
+		if stored.WebhookID == delivery.WebhookID && stored.OutboxEntryID == delivery.OutboxEntryID {
+			r.store.webhookDeliveries[i] = delivery
+			return nil
+		}
+	}
+
+	r.store.webhookDeliveries = append(r.store.webhookDeliveries, delivery)
+
+	return nil
+}
+
+func (r *webhookRepository) exists(id int64) bool {
+	for _, webhook := range r.store.webhooks {
+		if webhook.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
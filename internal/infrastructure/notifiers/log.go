@@ -0,0 +1,26 @@
+package notifiers
+
+import (
+	"context"
+	"log"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+type logNotifier struct{}
+
+func NewLog() app.Notifier {
+	return &logNotifier{}
+}
+
+func (n *logNotifier) Notify(_ context.Context, reminder entities.ReviewReminder) error {
+	switch reminder.Kind {
+	case entities.ReminderKindEscalation:
+		log.Printf("Escalating stale review of pull request %s to team lead %s", reminder.PullRequestID, reminder.RecipientID)
+	default:
+		log.Printf("Reminding %s to review pull request %s", reminder.RecipientID, reminder.PullRequestID)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/db"
+	"pr-service/internal/infrastructure/db_mappers"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+type auditRepository struct {
+	db *sql.DB
+	sb squirrel.StatementBuilderType
+}
+
+func NewAuditRepository(db *sql.DB) app.AuditRepository {
+	return &auditRepository{
+		db: db,
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+func (r *auditRepository) executor(ctx context.Context) db.QueryExecutor {
+	return db.GetQueryExecutor(ctx, r.db)
+}
+
+func (r *auditRepository) Add(ctx context.Context, entries ...entities.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	insert := r.sb.Insert("audit_log").
+		Columns("actor", "action", "entity_type", "entity_id", "before_state", "after_state", "request_id", "created_at")
+
+	for _, entry := range entries {
+		dbEntry, err := db_mappers.ToAuditEntryDBModel(entry)
+		if err != nil {
+			return fmt.Errorf("failed to encode audit state: %v", err)
+		}
+
+		insert = insert.Values(dbEntry.Actor, dbEntry.Action, dbEntry.EntityType, dbEntry.EntityID, dbEntry.BeforeState, dbEntry.AfterState, dbEntry.RequestID, dbEntry.CreatedAt)
+	}
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert audit entries: %v", err)
+	}
+
+	return nil
+}
+
+func (r *auditRepository) Find(ctx context.Context, auditQuery entities.AuditQuery) ([]entities.AuditEntry, error) {
+	selectQuery := r.sb.Select("id", "actor", "action", "entity_type", "entity_id", "before_state", "after_state", "request_id", "created_at").
+		From("audit_log").
+		Where(squirrel.Gt{"id": auditQuery.AfterID}).
+		OrderBy("id")
+
+	if auditQuery.EntityType != "" {
+		selectQuery = selectQuery.Where(squirrel.Eq{"entity_type": string(auditQuery.EntityType)})
+	}
+	if auditQuery.EntityID != "" {
+		selectQuery = selectQuery.Where(squirrel.Eq{"entity_id": auditQuery.EntityID})
+	}
+	if !auditQuery.Since.IsZero() {
+		selectQuery = selectQuery.Where(squirrel.GtOrEq{"created_at": auditQuery.Since.Format(time.RFC3339)})
+	}
+	if auditQuery.Limit > 0 {
+		selectQuery = selectQuery.Limit(uint64(auditQuery.Limit))
+	}
+
+	query, args, err := selectQuery.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch audit entries: %v", err)
+	}
+	defer rows.Close()
+
+	var entries []entities.AuditEntry
+
+	for rows.Next() {
+		var dbEntry db_models.AuditEntry
+		if err := rows.Scan(&dbEntry.ID, &dbEntry.Actor, &dbEntry.Action, &dbEntry.EntityType, &dbEntry.EntityID, &dbEntry.BeforeState, &dbEntry.AfterState, &dbEntry.RequestID, &dbEntry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %v", err)
+		}
+
+		entries = append(entries, db_mappers.FromAuditEntryDBModel(dbEntry))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/db"
+	"pr-service/internal/infrastructure/db_mappers"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+type outboxRepository struct {
+	db *sql.DB
+	sb squirrel.StatementBuilderType
+}
+
+func NewOutboxRepository(db *sql.DB) app.OutboxRepository {
+	return &outboxRepository{
+		db: db,
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+func (r *outboxRepository) executor(ctx context.Context) db.QueryExecutor {
+	return db.GetQueryExecutor(ctx, r.db)
+}
+
+func (r *outboxRepository) Add(ctx context.Context, events ...entities.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	insert := r.sb.Insert("outbox").
+		Columns("aggregate_id", "event_type", "payload", "occurred_at")
+
+	for _, event := range events {
+		dbEntry, err := db_mappers.ToOutboxEntryDBModel(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event payload: %v", err)
+		}
+
+		insert = insert.Values(dbEntry.AggregateID, dbEntry.EventType, dbEntry.Payload, dbEntry.OccurredAt)
+	}
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert outbox entries: %v", err)
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) GetPending(ctx context.Context, limit int) ([]entities.OutboxEntry, error) {
+	query, args, err := r.sb.Select("id", "aggregate_id", "event_type", "payload", "occurred_at", "delivered_at", "next_attempt_at").
+		From("outbox").
+		Where(squirrel.Eq{"delivered_at": nil}).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox entries: %v", err)
+	}
+	defer rows.Close()
+
+	var entries []entities.OutboxEntry
+
+	for rows.Next() {
+		var dbEntry db_models.OutboxEntry
+		if err := rows.Scan(&dbEntry.ID, &dbEntry.AggregateID, &dbEntry.EventType, &dbEntry.Payload, &dbEntry.OccurredAt, &dbEntry.DeliveredAt, &dbEntry.NextAttemptAt); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox entry: %v", err)
+		}
+
+		entries = append(entries, db_mappers.FromOutboxEntryDBModel(dbEntry))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return entries, nil
+}
+
+func (r *outboxRepository) MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error {
+	query, args, err := r.sb.Update("outbox").
+		Set("delivered_at", deliveredAt.Format(time.RFC3339)).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox entry delivered: %v", err)
+	}
+
+	return nil
+}
+
+func (r *outboxRepository) ScheduleRetry(ctx context.Context, id int64, nextAttemptAt time.Time) error {
+	query, args, err := r.sb.Update("outbox").
+		Set("next_attempt_at", nextAttemptAt.Format(time.RFC3339)).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to schedule outbox entry retry: %v", err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 
@@ -12,6 +13,7 @@ import (
 	"pr-service/internal/domain"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/db"
 	"pr-service/internal/infrastructure/db_mappers"
 	"pr-service/internal/infrastructure/db_models"
 )
@@ -28,39 +30,61 @@ func NewPullRequestRepository(db *sql.DB) app.PullRequestRepository {
 	}
 }
 
+func (r *pullRequestRepository) executor(ctx context.Context) db.QueryExecutor {
+	return db.GetQueryExecutor(ctx, r.db)
+}
+
 func (r *pullRequestRepository) Create(ctx context.Context, pullRequest *entities.PullRequest) error {
 	dbPullRequest := db_mappers.ToPullRequestDBModel(*pullRequest)
 
 	query, args, err := r.sb.Insert("pull_requests").
-		Columns("id", "pull_request_name", "author_id", "status", "created_at", "merged_at").
-		Values(dbPullRequest.ID, dbPullRequest.Name, dbPullRequest.AuthorID, dbPullRequest.Status, dbPullRequest.CreatedAt, dbPullRequest.MergedAt).
+		Columns("id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "version", "reviewers_limit", "force_merged", "closed_at", "closed_from_draft").
+		Values(dbPullRequest.ID, dbPullRequest.Name, dbPullRequest.AuthorID, dbPullRequest.Status, dbPullRequest.CreatedAt, dbPullRequest.MergedAt, dbPullRequest.Version, dbPullRequest.ReviewersLimit, dbPullRequest.ForceMerged, dbPullRequest.ClosedAt, dbPullRequest.ClosedFromDraft).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build insert query: %v", err)
 	}
 
-	_, err = r.db.ExecContext(ctx, query, args...)
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to insert pull request: %v", err)
 	}
 
 	if len(pullRequest.Reviewers()) > 0 {
-		for _, reviewerID := range pullRequest.Reviewers() {
+		for _, assignment := range pullRequest.Assignments() {
 			reviewerQuery, reviewerArgs, err := r.sb.Insert("pull_request_reviewers").
-				Columns("pull_request_id", "user_id").
-				Values(dbPullRequest.ID, reviewerID).
+				Columns("pull_request_id", "user_id", "assignment_level", "source_team_id", "assigned_at").
+				Values(dbPullRequest.ID, assignment.ReviewerID, assignment.Level, teamIDByName(assignment.SourceTeam), assignedAtValue(assignment)).
 				ToSql()
 			if err != nil {
 				return fmt.Errorf("failed to build insert query for reviewers: %v", err)
 			}
 
-			_, err = r.db.ExecContext(ctx, reviewerQuery, reviewerArgs...)
+			_, err = r.executor(ctx).ExecContext(ctx, reviewerQuery, reviewerArgs...)
 			if err != nil {
 				return fmt.Errorf("failed to insert reviewer: %v", err)
 			}
 		}
 	}
 
+	if len(pullRequest.BorrowedReviewers) > 0 {
+		insert := r.sb.Insert("pull_request_reviewer_quotas").
+			Columns("pull_request_id", "source_team_id", "reviewers_count")
+		for _, quota := range pullRequest.BorrowedReviewers {
+			insert = insert.Values(dbPullRequest.ID, teamIDByName(quota.TeamName), quota.Count)
+		}
+
+		quotaQuery, quotaArgs, err := insert.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build insert query for reviewer quotas: %v", err)
+		}
+
+		_, err = r.executor(ctx).ExecContext(ctx, quotaQuery, quotaArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to insert reviewer quotas: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -70,24 +94,39 @@ func (r *pullRequestRepository) Save(ctx context.Context, pullRequest *entities.
 	query, args, err := r.sb.Update("pull_requests").
 		Set("status", dbPullRequest.Status).
 		Set("merged_at", dbPullRequest.MergedAt).
+		Set("force_merged", dbPullRequest.ForceMerged).
+		Set("closed_at", dbPullRequest.ClosedAt).
+		Set("closed_from_draft", dbPullRequest.ClosedFromDraft).
+		Set("version", squirrel.Expr("version + 1")).
 		Where(squirrel.Eq{"id": dbPullRequest.ID}).
+		Where(squirrel.Eq{"version": dbPullRequest.Version}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build update query: %v", err)
 	}
 
-	_, err = r.db.ExecContext(ctx, query, args...)
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update pull request: %v", err)
 	}
 
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrConcurrentModification
+	}
+
+	pullRequest.Version++
+
 	return nil
 }
 
 func (r *pullRequestRepository) GetByID(ctx context.Context, id value_objects.PullRequestID) (*entities.PullRequest, error) {
 	var dbPullRequest db_models.PullRequest
 
-	query, args, err := r.sb.Select("id", "pull_request_name", "author_id", "status", "created_at", "merged_at").
+	query, args, err := r.sb.Select("id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "version", "reviewers_limit", "force_merged", "closed_at", "closed_from_draft").
 		From("pull_requests").
 		Where(squirrel.Eq{"id": id}).
 		ToSql()
@@ -95,7 +134,7 @@ func (r *pullRequestRepository) GetByID(ctx context.Context, id value_objects.Pu
 		return nil, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	err = r.db.QueryRowContext(ctx, query, args...).Scan(&dbPullRequest.ID, &dbPullRequest.Name, &dbPullRequest.AuthorID, &dbPullRequest.Status, &dbPullRequest.CreatedAt, &dbPullRequest.MergedAt)
+	err = r.executor(ctx).QueryRowContext(ctx, query, args...).Scan(&dbPullRequest.ID, &dbPullRequest.Name, &dbPullRequest.AuthorID, &dbPullRequest.Status, &dbPullRequest.CreatedAt, &dbPullRequest.MergedAt, &dbPullRequest.Version, &dbPullRequest.ReviewersLimit, &dbPullRequest.ForceMerged, &dbPullRequest.ClosedAt, &dbPullRequest.ClosedFromDraft)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, domain.ErrPRNotFound
 	}
@@ -103,33 +142,13 @@ func (r *pullRequestRepository) GetByID(ctx context.Context, id value_objects.Pu
 		return nil, fmt.Errorf("failed to fetch pull request: %v", err)
 	}
 
-	pullRequest := db_mappers.FromPullRequestDBModel(dbPullRequest)
+	pullRequests := []entities.PullRequest{db_mappers.FromPullRequestDBModel(dbPullRequest)}
 
-	var reviewers []value_objects.UserID
-	reviewersQuery, reviewersArgs, err := r.sb.Select("user_id").
-		From("pull_request_reviewers").
-		Where(squirrel.Eq{"pull_request_id": id}).
-		ToSql()
-	if err != nil {
-		return nil, fmt.Errorf("failed to build reviewers query: %v", err)
+	if err := r.loadReviewers(ctx, pullRequests); err != nil {
+		return nil, err
 	}
 
-	rows, err := r.db.QueryContext(ctx, reviewersQuery, reviewersArgs...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch reviewers: %v", err)
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var reviewerID value_objects.UserID
-		if err := rows.Scan(&reviewerID); err != nil {
-			return nil, fmt.Errorf("failed to scan reviewer: %v", err)
-		}
-
-		reviewers = append(reviewers, reviewerID)
-	}
-
-	pullRequest.SetReviewers(reviewers)
+	pullRequest := pullRequests[0]
 
 	return &pullRequest, nil
 }
@@ -137,7 +156,7 @@ func (r *pullRequestRepository) GetByID(ctx context.Context, id value_objects.Pu
 func (r *pullRequestRepository) GetByReviewer(ctx context.Context, reviewerID value_objects.UserID) ([]entities.PullRequest, error) {
 	var pullRequests []entities.PullRequest
 
-	query, args, err := r.sb.Select("pr.id", "pr.pull_request_name", "pr.author_id", "pr.status", "pr.created_at", "pr.merged_at").
+	query, args, err := r.sb.Select("pr.id", "pr.pull_request_name", "pr.author_id", "pr.status", "pr.created_at", "pr.merged_at", "pr.version", "pr.reviewers_limit", "pr.force_merged", "pr.closed_at", "pr.closed_from_draft").
 		From("pull_requests AS pr").
 		Join("pull_request_reviewers AS prr ON pr.id = prr.pull_request_id").
 		Where(squirrel.Eq{"prr.user_id": reviewerID}).
@@ -146,7 +165,7 @@ func (r *pullRequestRepository) GetByReviewer(ctx context.Context, reviewerID va
 		return nil, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch pull requests: %v", err)
 	}
@@ -155,7 +174,7 @@ func (r *pullRequestRepository) GetByReviewer(ctx context.Context, reviewerID va
 	for rows.Next() {
 		var dbPullRequest db_models.PullRequest
 
-		if err := rows.Scan(&dbPullRequest.ID, &dbPullRequest.Name, &dbPullRequest.AuthorID, &dbPullRequest.Status, &dbPullRequest.CreatedAt, &dbPullRequest.MergedAt); err != nil {
+		if err := rows.Scan(&dbPullRequest.ID, &dbPullRequest.Name, &dbPullRequest.AuthorID, &dbPullRequest.Status, &dbPullRequest.CreatedAt, &dbPullRequest.MergedAt, &dbPullRequest.Version, &dbPullRequest.ReviewersLimit, &dbPullRequest.ForceMerged, &dbPullRequest.ClosedAt, &dbPullRequest.ClosedFromDraft); err != nil {
 			return nil, fmt.Errorf("failed to scan pull request: %v", err)
 		}
 
@@ -167,19 +186,24 @@ func (r *pullRequestRepository) GetByReviewer(ctx context.Context, reviewerID va
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("rows iteration error: %v", err)
 	}
+	rows.Close()
+
+	if err := r.loadReviewers(ctx, pullRequests); err != nil {
+		return nil, err
+	}
 
 	return pullRequests, nil
 }
 
 func (r *pullRequestRepository) GetAll(ctx context.Context) ([]entities.PullRequest, error) {
-	query, args, err := r.sb.Select("id", "pull_request_name", "author_id", "status", "created_at", "merged_at").
+	query, args, err := r.sb.Select("id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "version", "reviewers_limit", "force_merged", "closed_at", "closed_from_draft").
 		From("pull_requests").
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch pull requests: %v", err)
 	}
@@ -189,19 +213,145 @@ func (r *pullRequestRepository) GetAll(ctx context.Context) ([]entities.PullRequ
 
 	for rows.Next() {
 		var dbPullRequest db_models.PullRequest
-		if err := rows.Scan(&dbPullRequest.ID, &dbPullRequest.Name, &dbPullRequest.AuthorID, &dbPullRequest.Status, &dbPullRequest.CreatedAt, &dbPullRequest.MergedAt); err != nil {
+		if err := rows.Scan(&dbPullRequest.ID, &dbPullRequest.Name, &dbPullRequest.AuthorID, &dbPullRequest.Status, &dbPullRequest.CreatedAt, &dbPullRequest.MergedAt, &dbPullRequest.Version, &dbPullRequest.ReviewersLimit, &dbPullRequest.ForceMerged, &dbPullRequest.ClosedAt, &dbPullRequest.ClosedFromDraft); err != nil {
 			return nil, fmt.Errorf("failed to scan pull request: %v", err)
 		}
 
 		pullRequests = append(pullRequests, db_mappers.FromPullRequestDBModel(dbPullRequest))
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+	rows.Close()
+
+	if err := r.loadReviewers(ctx, pullRequests); err != nil {
+		return nil, err
+	}
+
 	return pullRequests, nil
 }
 
-func (r *pullRequestRepository) ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID, newReviewerID value_objects.UserID) error {
+func (r *pullRequestRepository) loadReviewers(ctx context.Context, pullRequests []entities.PullRequest) error {
+	if len(pullRequests) == 0 {
+		return nil
+	}
+
+	pullRequestIDs := make([]string, 0, len(pullRequests))
+	for _, pullRequest := range pullRequests {
+		pullRequestIDs = append(pullRequestIDs, string(pullRequest.ID))
+	}
+
+	query, args, err := r.sb.Select("prr.pull_request_id", "prr.user_id", "prr.decision", "prr.decided_at", "prr.assignment_level", "prr.assigned_at", "COALESCE(t.team_name, '') AS source_team_name").
+		From("pull_request_reviewers AS prr").
+		LeftJoin("teams AS t ON t.id = prr.source_team_id").
+		Where(squirrel.Eq{"prr.pull_request_id": pullRequestIDs}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build reviewers query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to fetch reviewers: %v", err)
+	}
+	defer rows.Close()
+
+	reviewers := make(map[value_objects.PullRequestID][]value_objects.UserID, len(pullRequests))
+	reviews := make(map[value_objects.PullRequestID][]entities.Review, len(pullRequests))
+	assignments := make(map[value_objects.PullRequestID][]entities.ReviewerAssignment, len(pullRequests))
+
+	for rows.Next() {
+		var dbReviewer db_models.PullRequestReviewer
+		if err := rows.Scan(&dbReviewer.PullRequestID, &dbReviewer.UserID, &dbReviewer.Decision, &dbReviewer.DecidedAt, &dbReviewer.AssignmentLevel, &dbReviewer.AssignedAt, &dbReviewer.SourceTeamName); err != nil {
+			return fmt.Errorf("failed to scan reviewer: %v", err)
+		}
+
+		pullRequestID := value_objects.PullRequestID(dbReviewer.PullRequestID)
+		review := db_mappers.FromPullRequestReviewerDBModel(dbReviewer)
+
+		reviewers[pullRequestID] = append(reviewers[pullRequestID], review.ReviewerID)
+		reviews[pullRequestID] = append(reviews[pullRequestID], review)
+		assignments[pullRequestID] = append(assignments[pullRequestID], db_mappers.FromReviewerAssignmentDBModel(dbReviewer))
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	for i := range pullRequests {
+		pullRequests[i].SetReviewers(reviewers[pullRequests[i].ID])
+		pullRequests[i].SetReviews(reviews[pullRequests[i].ID])
+		pullRequests[i].SetAssignments(assignments[pullRequests[i].ID])
+	}
+
+	return r.loadReviewerQuotas(ctx, pullRequestIDs, pullRequests)
+}
+
+func (r *pullRequestRepository) loadReviewerQuotas(ctx context.Context, pullRequestIDs []string, pullRequests []entities.PullRequest) error {
+	query, args, err := r.sb.Select("q.pull_request_id", "t.team_name", "q.reviewers_count").
+		From("pull_request_reviewer_quotas AS q").
+		Join("teams AS t ON t.id = q.source_team_id").
+		Where(squirrel.Eq{"q.pull_request_id": pullRequestIDs}).
+		OrderBy("t.team_name").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build reviewer quotas query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to fetch reviewer quotas: %v", err)
+	}
+	defer rows.Close()
+
+	quotas := make(map[value_objects.PullRequestID][]entities.ReviewerQuota)
+
+	for rows.Next() {
+		var dbQuota db_models.PullRequestReviewerQuota
+		if err := rows.Scan(&dbQuota.PullRequestID, &dbQuota.SourceTeamName, &dbQuota.Count); err != nil {
+			return fmt.Errorf("failed to scan reviewer quota: %v", err)
+		}
+
+		pullRequestID := value_objects.PullRequestID(dbQuota.PullRequestID)
+		quotas[pullRequestID] = append(quotas[pullRequestID], db_mappers.FromReviewerQuotaDBModel(dbQuota))
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	for i := range pullRequests {
+		pullRequests[i].BorrowedReviewers = quotas[pullRequests[i].ID]
+	}
+
+	return nil
+}
+
+func (r *pullRequestRepository) RemoveReviewerQuotas(ctx context.Context, pullRequestID value_objects.PullRequestID) error {
+	query, args, err := r.sb.Delete("pull_request_reviewer_quotas").
+		Where(squirrel.Eq{"pull_request_id": pullRequestID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to remove reviewer quotas: %v", err)
+	}
+
+	return nil
+}
+
+func (r *pullRequestRepository) ReassignReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, oldReviewerID value_objects.UserID, assignment entities.ReviewerAssignment) error {
 	query, args, err := r.sb.Update("pull_request_reviewers").
-		Set("user_id", newReviewerID).
+		Set("user_id", assignment.ReviewerID).
+		Set("assignment_level", assignment.Level).
+		Set("source_team_id", teamIDByName(assignment.SourceTeam)).
+		Set("assigned_at", assignedAtValue(assignment)).
+		Set("decision", string(entities.DecisionPending)).
+		Set("decided_at", nil).
 		Where(squirrel.Eq{"pull_request_id": pullRequestID}).
 		Where(squirrel.Eq{"user_id": oldReviewerID}).
 		ToSql()
@@ -209,10 +359,294 @@ func (r *pullRequestRepository) ReassignReviewer(ctx context.Context, pullReques
 		return fmt.Errorf("failed to build update query: %v", err)
 	}
 
-	_, err = r.db.ExecContext(ctx, query, args...)
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to reassign reviewer: %v", err)
 	}
 
 	return nil
 }
+
+func (r *pullRequestRepository) AddReviewers(ctx context.Context, pullRequestID value_objects.PullRequestID, assignments []entities.ReviewerAssignment) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	insert := r.sb.Insert("pull_request_reviewers").
+		Columns("pull_request_id", "user_id", "assignment_level", "source_team_id", "assigned_at")
+	for _, assignment := range assignments {
+		insert = insert.Values(string(pullRequestID), string(assignment.ReviewerID), assignment.Level, teamIDByName(assignment.SourceTeam), assignedAtValue(assignment))
+	}
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query for reviewers: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert reviewers: %v", err)
+	}
+
+	return nil
+}
+
+func (r *pullRequestRepository) RemoveReviewer(ctx context.Context, pullRequestID value_objects.PullRequestID, reviewerID value_objects.UserID) error {
+	query, args, err := r.sb.Delete("pull_request_reviewers").
+		Where(squirrel.Eq{"pull_request_id": pullRequestID}).
+		Where(squirrel.Eq{"user_id": reviewerID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to remove reviewer: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrNotAssigned
+	}
+
+	return nil
+}
+
+func (r *pullRequestRepository) SaveReview(ctx context.Context, pullRequestID value_objects.PullRequestID, review entities.Review) error {
+	dbReviewer := db_mappers.ToPullRequestReviewerDBModel(pullRequestID, review)
+
+	query, args, err := r.sb.Update("pull_request_reviewers").
+		Set("decision", dbReviewer.Decision).
+		Set("decided_at", dbReviewer.DecidedAt).
+		Where(squirrel.Eq{"pull_request_id": dbReviewer.PullRequestID}).
+		Where(squirrel.Eq{"user_id": dbReviewer.UserID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to save review: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrNotAssigned
+	}
+
+	return nil
+}
+
+func (r *pullRequestRepository) CountOpenReviews(ctx context.Context, reviewerIDs []value_objects.UserID) (map[value_objects.UserID]int, error) {
+	openReviews := make(map[value_objects.UserID]int, len(reviewerIDs))
+	if len(reviewerIDs) == 0 {
+		return openReviews, nil
+	}
+
+	query, args, err := r.sb.Select("prr.user_id", "COUNT(*)").
+		From("pull_request_reviewers AS prr").
+		Join("pull_requests AS pr ON pr.id = prr.pull_request_id").
+		Where(squirrel.Eq{"pr.status": string(entities.StatusOpen)}).
+		Where(squirrel.Eq{"prr.user_id": reviewerIDs}).
+		GroupBy("prr.user_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count open reviews: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewerID value_objects.UserID
+		var count int
+		if err := rows.Scan(&reviewerID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan open reviews count: %v", err)
+		}
+
+		openReviews[reviewerID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return openReviews, nil
+}
+
+func (r *pullRequestRepository) CountOpenByTeam(ctx context.Context, teamName value_objects.TeamName) (int, error) {
+	query, args, err := r.sb.Select("COUNT(*)").
+		From("pull_requests AS pr").
+		Where(squirrel.Or{
+			squirrel.And{
+				squirrel.Eq{"pr.status": []string{string(entities.StatusOpen), string(entities.StatusDraft)}},
+				squirrel.Or{
+					squirrel.Expr("EXISTS (SELECT 1 FROM users AS u JOIN teams AS t ON t.id = u.team_id WHERE u.id = pr.author_id AND t.team_name = ?)", string(teamName)),
+					squirrel.Expr("EXISTS (SELECT 1 FROM pull_request_reviewers AS prr JOIN teams AS t ON t.id = prr.source_team_id WHERE prr.pull_request_id = pr.id AND t.team_name = ?)", string(teamName)),
+				},
+			},
+			squirrel.Expr("EXISTS (SELECT 1 FROM pull_request_reviewer_quotas AS q JOIN teams AS t ON t.id = q.source_team_id WHERE q.pull_request_id = pr.id AND t.team_name = ?)", string(teamName)),
+		}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	var count int
+	if err := r.executor(ctx).QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count open pull requests: %v", err)
+	}
+
+	return count, nil
+}
+
+func (r *pullRequestRepository) LockUnderAssigned(ctx context.Context, afterID value_objects.PullRequestID, limit int) ([]value_objects.PullRequestID, error) {
+	query, args, err := r.sb.Select("pr.id").
+		From("pull_requests AS pr").
+		Where(squirrel.Eq{"pr.status": string(entities.StatusOpen)}).
+		Where(squirrel.Gt{"pr.id": string(afterID)}).
+		Where("(SELECT COUNT(*) FROM pull_request_reviewers AS prr WHERE prr.pull_request_id = pr.id) < pr.reviewers_limit").
+		OrderBy("pr.id").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE OF pr SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock under-assigned pull requests: %v", err)
+	}
+	defer rows.Close()
+
+	var pullRequestIDs []value_objects.PullRequestID
+
+	for rows.Next() {
+		var pullRequestID value_objects.PullRequestID
+		if err := rows.Scan(&pullRequestID); err != nil {
+			return nil, fmt.Errorf("failed to scan pull request id: %v", err)
+		}
+
+		pullRequestIDs = append(pullRequestIDs, pullRequestID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return pullRequestIDs, nil
+}
+
+func (r *pullRequestRepository) GetOpenCreatedBefore(ctx context.Context, createdBefore time.Time) ([]entities.PullRequest, error) {
+	query, args, err := r.sb.Select("id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "version", "reviewers_limit", "force_merged", "closed_at", "closed_from_draft").
+		From("pull_requests").
+		Where(squirrel.Eq{"status": string(entities.StatusOpen)}).
+		Where(squirrel.Lt{"created_at": createdBefore}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open pull requests: %v", err)
+	}
+	defer rows.Close()
+
+	var pullRequests []entities.PullRequest
+
+	for rows.Next() {
+		var dbPullRequest db_models.PullRequest
+		if err := rows.Scan(&dbPullRequest.ID, &dbPullRequest.Name, &dbPullRequest.AuthorID, &dbPullRequest.Status, &dbPullRequest.CreatedAt, &dbPullRequest.MergedAt, &dbPullRequest.Version, &dbPullRequest.ReviewersLimit, &dbPullRequest.ForceMerged, &dbPullRequest.ClosedAt, &dbPullRequest.ClosedFromDraft); err != nil {
+			return nil, fmt.Errorf("failed to scan pull request: %v", err)
+		}
+
+		pullRequests = append(pullRequests, db_mappers.FromPullRequestDBModel(dbPullRequest))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+	rows.Close()
+
+	if err := r.loadReviewers(ctx, pullRequests); err != nil {
+		return nil, err
+	}
+
+	return pullRequests, nil
+}
+
+func assignedAtValue(assignment entities.ReviewerAssignment) any {
+	if assignment.AssignedAt.IsZero() {
+		return squirrel.Expr("NOW()")
+	}
+
+	return assignment.AssignedAt.Format(time.RFC3339)
+}
+
+func (r *pullRequestRepository) AddReviewerChange(ctx context.Context, change entities.ReviewerChange) error {
+	dbChange := db_mappers.ToReviewerChangeDBModel(change)
+
+	query, args, err := r.sb.Insert("pull_request_reviewer_history").
+		Columns("pull_request_id", "old_reviewer_id", "new_reviewer_id", "assignment_level", "source_team_id", "assigned_at", "reason", "changed_at").
+		Values(dbChange.PullRequestID, dbChange.OldReviewerID, dbChange.NewReviewerID, dbChange.Level, teamIDByName(change.SourceTeam), dbChange.AssignedAt, dbChange.Reason, dbChange.ChangedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert reviewer change: %v", err)
+	}
+
+	return nil
+}
+
+func (r *pullRequestRepository) GetReviewerChanges(ctx context.Context, pullRequestID value_objects.PullRequestID) ([]entities.ReviewerChange, error) {
+	query, args, err := r.sb.Select("h.pull_request_id", "h.old_reviewer_id", "h.new_reviewer_id", "h.assignment_level", "COALESCE(t.team_name, '') AS source_team_name", "h.assigned_at", "h.reason", "h.changed_at").
+		From("pull_request_reviewer_history AS h").
+		LeftJoin("teams AS t ON t.id = h.source_team_id").
+		Where(squirrel.Eq{"h.pull_request_id": string(pullRequestID)}).
+		OrderBy("h.changed_at", "h.id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch reviewer history: %v", err)
+	}
+	defer rows.Close()
+
+	var changes []entities.ReviewerChange
+
+	for rows.Next() {
+		var dbChange db_models.ReviewerChange
+		if err := rows.Scan(&dbChange.PullRequestID, &dbChange.OldReviewerID, &dbChange.NewReviewerID, &dbChange.Level, &dbChange.SourceTeamName, &dbChange.AssignedAt, &dbChange.Reason, &dbChange.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer change: %v", err)
+		}
+
+		changes = append(changes, db_mappers.FromReviewerChangeDBModel(dbChange))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return changes, nil
+}
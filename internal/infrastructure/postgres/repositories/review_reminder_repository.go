@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/db"
+	"pr-service/internal/infrastructure/db_mappers"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+type reviewReminderRepository struct {
+	db *sql.DB
+	sb squirrel.StatementBuilderType
+}
+
+func NewReviewReminderRepository(db *sql.DB) app.ReviewReminderRepository {
+	return &reviewReminderRepository{
+		db: db,
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+func (r *reviewReminderRepository) executor(ctx context.Context) db.QueryExecutor {
+	return db.GetQueryExecutor(ctx, r.db)
+}
+
+func (r *reviewReminderRepository) Record(ctx context.Context, reminder entities.ReviewReminder) (bool, error) {
+	dbReminder := db_mappers.ToReviewReminderDBModel(reminder)
+
+	query, args, err := r.sb.Insert("review_reminders").
+		Columns("pull_request_id", "recipient_id", "kind", "sent_at").
+		Values(dbReminder.PullRequestID, dbReminder.RecipientID, dbReminder.Kind, dbReminder.SentAt).
+		Suffix("ON CONFLICT (pull_request_id, recipient_id, kind) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build insert query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to record reminder: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *reviewReminderRepository) GetUndelivered(ctx context.Context) ([]entities.ReviewReminder, error) {
+	query, args, err := r.sb.Select("pull_request_id", "recipient_id", "kind", "sent_at").
+		From("review_reminders").
+		Where(squirrel.Eq{"delivered_at": nil}).
+		OrderBy("sent_at", "pull_request_id", "recipient_id", "kind").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch undelivered reminders: %v", err)
+	}
+	defer rows.Close()
+
+	var reminders []entities.ReviewReminder
+
+	for rows.Next() {
+		var dbReminder db_models.ReviewReminder
+		if err := rows.Scan(&dbReminder.PullRequestID, &dbReminder.RecipientID, &dbReminder.Kind, &dbReminder.SentAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reminder: %v", err)
+		}
+
+		reminders = append(reminders, db_mappers.FromReviewReminderDBModel(dbReminder))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return reminders, nil
+}
+
+func (r *reviewReminderRepository) MarkDelivered(ctx context.Context, reminder entities.ReviewReminder, deliveredAt time.Time) error {
+	query, args, err := r.sb.Update("review_reminders").
+		Set("delivered_at", deliveredAt.Format(time.RFC3339)).
+		Where(squirrel.Eq{"pull_request_id": string(reminder.PullRequestID)}).
+		Where(squirrel.Eq{"recipient_id": string(reminder.RecipientID)}).
+		Where(squirrel.Eq{"kind": string(reminder.Kind)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark reminder delivered: %v", err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 
@@ -12,6 +13,7 @@ import (
 	"pr-service/internal/domain"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/db"
 	"pr-service/internal/infrastructure/db_mappers"
 	"pr-service/internal/infrastructure/db_models"
 )
@@ -28,28 +30,42 @@ func NewTeamRepository(db *sql.DB) app.TeamRepository {
 	}
 }
 
-func (r *teamRepository) Create(ctx context.Context, team entities.Team) error {
+func (r *teamRepository) executor(ctx context.Context) db.QueryExecutor {
+	return db.GetQueryExecutor(ctx, r.db)
+}
+
+func (r *teamRepository) selectTeams() squirrel.SelectBuilder {
+	return r.sb.Select("t.id", "t.team_name", "t.assignment_strategy", "t.round_robin_cursor", "t.reviewers_limit", "t.max_open_reviews", "t.remind_after_seconds", "t.escalate_after_seconds", "t.reassign_after_seconds", "t.lead_id", "t.min_approvals", "t.require_lead_approval", "t.archived_at", "COALESCE(parent.team_name, '') AS parent_team_name").
+		From("teams AS t").
+		LeftJoin("teams AS parent ON parent.id = t.parent_team_id")
+}
+
+func (r *teamRepository) Create(ctx context.Context, team entities.Team) (entities.Team, error) {
+	dbTeam := db_mappers.ToTeamDBModel(team)
+
 	query, args, err := r.sb.Insert("teams").
-		Columns("id", "team_name").
-		Values(string(team.Name), string(team.Name)).
+		Columns("team_name", "assignment_strategy", "round_robin_cursor", "reviewers_limit", "max_open_reviews", "remind_after_seconds", "escalate_after_seconds", "reassign_after_seconds", "lead_id", "min_approvals", "require_lead_approval", "parent_team_id").
+		Values(dbTeam.Name, dbTeam.AssignmentStrategy, dbTeam.RoundRobinCursor, dbTeam.ReviewersLimit, dbTeam.MaxOpenReviews, dbTeam.RemindAfterSeconds, dbTeam.EscalateAfterSeconds, dbTeam.ReassignAfterSeconds, dbTeam.LeadID, dbTeam.MinApprovals, dbTeam.RequireLeadApproval, teamIDByName(team.Parent)).
+		Suffix("RETURNING id").
 		ToSql()
 
 	if err != nil {
-		return fmt.Errorf("failed to build insert query: %v", err)
+		return entities.Team{}, fmt.Errorf("failed to build insert query: %v", err)
 	}
 
-	_, err = r.db.ExecContext(ctx, query, args...)
+	err = r.executor(ctx).QueryRowContext(ctx, query, args...).Scan(&dbTeam.ID)
 	if err != nil {
-		return fmt.Errorf("failed to create team: %v", err)
+		return entities.Team{}, fmt.Errorf("failed to create team: %v", err)
 	}
 
-	return nil
+	team.ID = value_objects.TeamID(dbTeam.ID)
+
+	return team, nil
 }
 
 func (r *teamRepository) GetByName(ctx context.Context, name value_objects.TeamName) (entities.Team, error) {
-	query, args, err := r.sb.Select("id", "team_name").
-		From("teams").
-		Where(squirrel.Eq{"team_name": string(name)}).
+	query, args, err := r.selectTeams().
+		Where(squirrel.Eq{"t.team_name": string(name)}).
 		ToSql()
 	if err != nil {
 		return entities.Team{}, fmt.Errorf("failed to build query: %v", err)
@@ -57,7 +73,7 @@ func (r *teamRepository) GetByName(ctx context.Context, name value_objects.TeamN
 
 	var dbTeam db_models.Team
 
-	err = r.db.QueryRowContext(ctx, query, args...).Scan(&dbTeam.ID, &dbTeam.Name)
+	err = r.executor(ctx).QueryRowContext(ctx, query, args...).Scan(&dbTeam.ID, &dbTeam.Name, &dbTeam.AssignmentStrategy, &dbTeam.RoundRobinCursor, &dbTeam.ReviewersLimit, &dbTeam.MaxOpenReviews, &dbTeam.RemindAfterSeconds, &dbTeam.EscalateAfterSeconds, &dbTeam.ReassignAfterSeconds, &dbTeam.LeadID, &dbTeam.MinApprovals, &dbTeam.RequireLeadApproval, &dbTeam.ArchivedAt, &dbTeam.ParentName)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return entities.Team{}, domain.ErrTeamNotFound
@@ -68,15 +84,18 @@ func (r *teamRepository) GetByName(ctx context.Context, name value_objects.TeamN
 	return db_mappers.FromTeamDBModel(dbTeam), nil
 }
 
-func (r *teamRepository) GetAll(ctx context.Context) ([]entities.Team, error) {
-	query, args, err := r.sb.Select("id", "team_name").
-		From("teams").
-		ToSql()
+func (r *teamRepository) GetAll(ctx context.Context, includeArchived bool) ([]entities.Team, error) {
+	selectQuery := r.selectTeams()
+	if !includeArchived {
+		selectQuery = selectQuery.Where(squirrel.Eq{"t.archived_at": nil})
+	}
+
+	query, args, err := selectQuery.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch teams: %v", err)
 	}
@@ -86,7 +105,7 @@ func (r *teamRepository) GetAll(ctx context.Context) ([]entities.Team, error) {
 
 	for rows.Next() {
 		var dbTeam db_models.Team
-		if err := rows.Scan(&dbTeam.ID, &dbTeam.Name); err != nil {
+		if err := rows.Scan(&dbTeam.ID, &dbTeam.Name, &dbTeam.AssignmentStrategy, &dbTeam.RoundRobinCursor, &dbTeam.ReviewersLimit, &dbTeam.MaxOpenReviews, &dbTeam.RemindAfterSeconds, &dbTeam.EscalateAfterSeconds, &dbTeam.ReassignAfterSeconds, &dbTeam.LeadID, &dbTeam.MinApprovals, &dbTeam.RequireLeadApproval, &dbTeam.ArchivedAt, &dbTeam.ParentName); err != nil {
 			return nil, fmt.Errorf("failed to scan team: %v", err)
 		}
 
@@ -95,3 +114,326 @@ func (r *teamRepository) GetAll(ctx context.Context) ([]entities.Team, error) {
 
 	return teams, nil
 }
+
+func (r *teamRepository) UpdateAssignmentStrategy(ctx context.Context, name value_objects.TeamName, strategy entities.AssignmentStrategy) error {
+	query, args, err := r.sb.Update("teams").
+		Set("assignment_strategy", string(strategy)).
+		Where(squirrel.Eq{"team_name": string(name)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update assignment strategy: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *teamRepository) LockRoundRobinCursor(ctx context.Context, name value_objects.TeamName) (value_objects.UserID, error) {
+	query, args, err := r.sb.Select("round_robin_cursor").
+		From("teams").
+		Where(squirrel.Eq{"team_name": string(name)}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("failed to build query: %v", err)
+	}
+
+	var cursor *string
+
+	err = r.executor(ctx).QueryRowContext(ctx, query, args...).Scan(&cursor)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrTeamNotFound
+		}
+		return "", fmt.Errorf("failed to lock round robin cursor: %v", err)
+	}
+
+	if cursor == nil {
+		return "", nil
+	}
+
+	return value_objects.UserID(*cursor), nil
+}
+
+func (r *teamRepository) UpdateRoundRobinCursor(ctx context.Context, name value_objects.TeamName, cursor value_objects.UserID) error {
+	query, args, err := r.sb.Update("teams").
+		Set("round_robin_cursor", string(cursor)).
+		Where(squirrel.Eq{"team_name": string(name)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update round robin cursor: %v", err)
+	}
+
+	return nil
+}
+
+func (r *teamRepository) UpdateMergePolicy(ctx context.Context, name value_objects.TeamName, leadID value_objects.UserID, policy entities.MergePolicy) error {
+	dbTeam := db_mappers.ToTeamDBModel(entities.Team{Name: name, LeadID: leadID, MergePolicy: policy})
+
+	query, args, err := r.sb.Update("teams").
+		Set("lead_id", dbTeam.LeadID).
+		Set("min_approvals", dbTeam.MinApprovals).
+		Set("require_lead_approval", dbTeam.RequireLeadApproval).
+		Where(squirrel.Eq{"team_name": dbTeam.Name}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update merge policy: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *teamRepository) AddMembershipChanges(ctx context.Context, changes []entities.MembershipChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	insert := r.sb.Insert("team_membership_history").
+		Columns("user_id", "action", "from_team_id", "to_team_id", "changed_at")
+	for _, change := range changes {
+		dbChange := db_mappers.ToMembershipChangeDBModel(change)
+		insert = insert.Values(dbChange.UserID, dbChange.Action, teamIDByName(change.FromTeam), teamIDByName(change.ToTeam), dbChange.ChangedAt)
+	}
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query for membership history: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert membership history: %v", err)
+	}
+
+	return nil
+}
+
+func (r *teamRepository) GetMembershipHistory(ctx context.Context, name value_objects.TeamName) ([]entities.MembershipChange, error) {
+	query, args, err := r.sb.Select("h.user_id", "h.action", "from_team.team_name AS from_team_name", "to_team.team_name AS to_team_name", "h.changed_at").
+		From("team_membership_history AS h").
+		LeftJoin("teams AS from_team ON from_team.id = h.from_team_id").
+		LeftJoin("teams AS to_team ON to_team.id = h.to_team_id").
+		Where(squirrel.Or{
+			squirrel.Eq{"from_team.team_name": string(name)},
+			squirrel.Eq{"to_team.team_name": string(name)},
+		}).
+		OrderBy("h.changed_at", "h.id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch membership history: %v", err)
+	}
+	defer rows.Close()
+
+	var history []entities.MembershipChange
+
+	for rows.Next() {
+		var dbChange db_models.MembershipChange
+		if err := rows.Scan(&dbChange.UserID, &dbChange.Action, &dbChange.FromTeam, &dbChange.ToTeam, &dbChange.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan membership change: %v", err)
+		}
+
+		history = append(history, db_mappers.FromMembershipChangeDBModel(dbChange))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return history, nil
+}
+
+func (r *teamRepository) Archive(ctx context.Context, name value_objects.TeamName, archivedAt time.Time) error {
+	query, args, err := r.sb.Update("teams").
+		Set("archived_at", archivedAt.Format(time.RFC3339)).
+		Where(squirrel.Eq{"team_name": string(name)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to archive team: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *teamRepository) Delete(ctx context.Context, name value_objects.TeamName) error {
+	query, args, err := r.sb.Delete("teams").
+		Where(squirrel.Eq{"team_name": string(name)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *teamRepository) Rename(ctx context.Context, name value_objects.TeamName, newName value_objects.TeamName) error {
+	query, args, err := r.sb.Update("teams").
+		Set("team_name", string(newName)).
+		Where(squirrel.Eq{"team_name": string(name)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to rename team: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *teamRepository) UpdateParent(ctx context.Context, name value_objects.TeamName, parentName value_objects.TeamName) error {
+	query, args, err := r.sb.Update("teams").
+		Set("parent_team_id", teamIDByName(parentName)).
+		Where(squirrel.Eq{"team_name": string(name)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update team parent: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *teamRepository) UpdateMaxOpenReviews(ctx context.Context, name value_objects.TeamName, maxOpenReviews int) error {
+	query, args, err := r.sb.Update("teams").
+		Set("max_open_reviews", maxOpenReviews).
+		Where(squirrel.Eq{"team_name": string(name)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update max open reviews: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func (r *teamRepository) UpdateReviewSLA(ctx context.Context, name value_objects.TeamName, sla entities.ReviewSLA) error {
+	dbTeam := db_mappers.ToTeamDBModel(entities.Team{Name: name, ReviewSLA: sla})
+
+	query, args, err := r.sb.Update("teams").
+		Set("remind_after_seconds", dbTeam.RemindAfterSeconds).
+		Set("escalate_after_seconds", dbTeam.EscalateAfterSeconds).
+		Set("reassign_after_seconds", dbTeam.ReassignAfterSeconds).
+		Where(squirrel.Eq{"team_name": string(name)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update review sla: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTeamNotFound
+	}
+
+	return nil
+}
+
+func teamIDByName(name value_objects.TeamName) any {
+	if name == "" {
+		return nil
+	}
+
+	return squirrel.Expr("(SELECT id FROM teams WHERE team_name = ?)", string(name))
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 
@@ -12,6 +14,7 @@ import (
 	"pr-service/internal/domain"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
+	"pr-service/internal/infrastructure/db"
 	"pr-service/internal/infrastructure/db_mappers"
 	"pr-service/internal/infrastructure/db_models"
 )
@@ -28,18 +31,27 @@ func NewUserRepository(db *sql.DB) app.UserRepository {
 	}
 }
 
+func (r *userRepository) executor(ctx context.Context) db.QueryExecutor {
+	return db.GetQueryExecutor(ctx, r.db)
+}
+
+func (r *userRepository) selectUsers() squirrel.SelectBuilder {
+	return r.sb.Select("u.id", "u.username", "COALESCE(t.team_name, '') AS team_name", "u.is_active", "u.max_open_reviews").
+		From("users AS u").
+		LeftJoin("teams AS t ON t.id = u.team_id")
+}
+
 func (r *userRepository) GetByID(ctx context.Context, id value_objects.UserID) (entities.User, error) {
 	var dbUser db_models.User
 
-	query, args, err := r.sb.Select("id", "username", "team_name", "is_active").
-		From("users").
-		Where(squirrel.Eq{"id": id}).
+	query, args, err := r.selectUsers().
+		Where(squirrel.Eq{"u.id": id}).
 		ToSql()
 	if err != nil {
 		return entities.User{}, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	err = r.db.QueryRowContext(ctx, query, args...).Scan(&dbUser.ID, &dbUser.Username, &dbUser.Team, &dbUser.IsActive)
+	err = r.executor(ctx).QueryRowContext(ctx, query, args...).Scan(&dbUser.ID, &dbUser.Username, &dbUser.Team, &dbUser.IsActive, &dbUser.MaxOpenReviews)
 	if errors.Is(err, sql.ErrNoRows) {
 		return entities.User{}, domain.ErrUserNotFound
 	}
@@ -53,15 +65,14 @@ func (r *userRepository) GetByID(ctx context.Context, id value_objects.UserID) (
 func (r *userRepository) GetUsersByTeam(ctx context.Context, teamName value_objects.TeamName) ([]entities.User, error) {
 	var dbUsers []db_models.User
 
-	query, args, err := r.sb.Select("id", "username", "team_name", "is_active").
-		From("users").
-		Where(squirrel.Eq{"team_name": string(teamName)}).
+	query, args, err := r.selectUsers().
+		Where(squirrel.Eq{"t.team_name": string(teamName)}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch users: %v", err)
 	}
@@ -69,7 +80,7 @@ func (r *userRepository) GetUsersByTeam(ctx context.Context, teamName value_obje
 
 	for rows.Next() {
 		var dbUser db_models.User
-		if err := rows.Scan(&dbUser.ID, &dbUser.Username, &dbUser.Team, &dbUser.IsActive); err != nil {
+		if err := rows.Scan(&dbUser.ID, &dbUser.Username, &dbUser.Team, &dbUser.IsActive, &dbUser.MaxOpenReviews); err != nil {
 			return nil, fmt.Errorf("failed to scan user: %v", err)
 		}
 		dbUsers = append(dbUsers, dbUser)
@@ -89,14 +100,13 @@ func (r *userRepository) GetUsersByTeam(ctx context.Context, teamName value_obje
 }
 
 func (r *userRepository) GetAll(ctx context.Context) ([]entities.User, error) {
-	query, args, err := r.sb.Select("id", "username", "team_name", "is_active").
-		From("users").
+	query, args, err := r.selectUsers().
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %v", err)
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch users: %v", err)
 	}
@@ -106,7 +116,7 @@ func (r *userRepository) GetAll(ctx context.Context) ([]entities.User, error) {
 
 	for rows.Next() {
 		var dbUser db_models.User
-		if err := rows.Scan(&dbUser.ID, &dbUser.Username, &dbUser.Team, &dbUser.IsActive); err != nil {
+		if err := rows.Scan(&dbUser.ID, &dbUser.Username, &dbUser.Team, &dbUser.IsActive, &dbUser.MaxOpenReviews); err != nil {
 			return nil, fmt.Errorf("failed to scan user: %v", err)
 		}
 
@@ -121,16 +131,16 @@ func (r *userRepository) UpsertMembers(ctx context.Context, teamName value_objec
 		dbUser := db_mappers.ToUserDBModel(member)
 
 		query, args, err := r.sb.Insert("users").
-			Columns("id", "username", "team_name", "is_active").
-			Values(dbUser.ID, dbUser.Username, string(teamName), dbUser.IsActive).
-			Suffix("ON CONFLICT (id) DO UPDATE SET username = EXCLUDED.username, team_name = EXCLUDED.team_name, is_active = EXCLUDED.is_active").
+			Columns("id", "username", "team_id", "is_active").
+			Values(dbUser.ID, dbUser.Username, teamIDByName(teamName), dbUser.IsActive).
+			Suffix("ON CONFLICT (id) DO UPDATE SET username = EXCLUDED.username, team_id = EXCLUDED.team_id, is_active = EXCLUDED.is_active").
 			ToSql()
 
 		if err != nil {
 			return fmt.Errorf("failed to build upsert query: %v", err)
 		}
 
-		_, err = r.db.ExecContext(ctx, query, args...)
+		_, err = r.executor(ctx).ExecContext(ctx, query, args...)
 		if err != nil {
 			return fmt.Errorf("failed to execute upsert query: %v", err)
 		}
@@ -148,7 +158,7 @@ func (r *userRepository) SetIsActive(ctx context.Context, id value_objects.UserI
 		return entities.User{}, fmt.Errorf("failed to build update query: %v", err)
 	}
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return entities.User{}, fmt.Errorf("failed to execute update: %v", err)
 	}
@@ -163,3 +173,221 @@ func (r *userRepository) SetIsActive(ctx context.Context, id value_objects.UserI
 
 	return r.GetByID(ctx, id)
 }
+
+func (r *userRepository) SetIsActiveByTeam(ctx context.Context, teamName value_objects.TeamName, userIDs []value_objects.UserID, isActive bool) ([]entities.User, error) {
+	update := r.sb.Update("users").
+		Set("is_active", isActive).
+		Where(squirrel.Expr("team_id = (SELECT id FROM teams WHERE team_name = ?)", string(teamName)))
+	if len(userIDs) > 0 {
+		update = update.Where(squirrel.Eq{"id": userIDs})
+	}
+
+	query, args, err := update.
+		Suffix("RETURNING id, username, is_active, max_open_reviews").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute update: %v", err)
+	}
+	defer rows.Close()
+
+	var users []entities.User
+
+	for rows.Next() {
+		dbUser := db_models.User{Team: string(teamName)}
+		if err := rows.Scan(&dbUser.ID, &dbUser.Username, &dbUser.IsActive, &dbUser.MaxOpenReviews); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %v", err)
+		}
+
+		users = append(users, db_mappers.FromUserDBModel(dbUser))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+
+	return users, nil
+}
+
+func (r *userRepository) SetTeam(ctx context.Context, userIDs []value_objects.UserID, teamName value_objects.TeamName) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := r.sb.Update("users").
+		Set("team_id", teamIDByName(teamName)).
+		Where(squirrel.Eq{"id": userIDs}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute update: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected != int64(len(userIDs)) {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *userRepository) SetMaxOpenReviews(ctx context.Context, id value_objects.UserID, maxOpenReviews int) (entities.User, error) {
+	query, args, err := r.sb.Update("users").
+		Set("max_open_reviews", maxOpenReviews).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return entities.User{}, fmt.Errorf("failed to build update query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return entities.User{}, fmt.Errorf("failed to update max open reviews: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return entities.User{}, fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return entities.User{}, domain.ErrUserNotFound
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *userRepository) AddAvailabilityWindow(ctx context.Context, window entities.AvailabilityWindow) (entities.AvailabilityWindow, error) {
+	dbWindow := db_mappers.ToAvailabilityWindowDBModel(window)
+
+	query, args, err := r.sb.Insert("user_availability_windows").
+		Columns("user_id", "starts_at", "ends_at", "reason").
+		Values(dbWindow.UserID, dbWindow.StartsAt, dbWindow.EndsAt, dbWindow.Reason).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return entities.AvailabilityWindow{}, fmt.Errorf("failed to build insert query: %v", err)
+	}
+
+	err = r.executor(ctx).QueryRowContext(ctx, query, args...).Scan(&window.ID)
+	if err != nil {
+		return entities.AvailabilityWindow{}, fmt.Errorf("failed to insert availability window: %v", err)
+	}
+
+	return window, nil
+}
+
+func (r *userRepository) GetAvailabilityWindows(ctx context.Context, id value_objects.UserID) ([]entities.AvailabilityWindow, error) {
+	query, args, err := r.sb.Select("id", "user_id", "starts_at", "ends_at", "reason").
+		From("user_availability_windows").
+		Where(squirrel.Eq{"user_id": string(id)}).
+		OrderBy("starts_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch availability windows: %v", err)
+	}
+	defer rows.Close()
+
+	var windows []entities.AvailabilityWindow
+
+	for rows.Next() {
+		var dbWindow db_models.AvailabilityWindow
+		if err := rows.Scan(&dbWindow.ID, &dbWindow.UserID, &dbWindow.StartsAt, &dbWindow.EndsAt, &dbWindow.Reason); err != nil {
+			return nil, fmt.Errorf("failed to scan availability window: %v", err)
+		}
+
+		windows = append(windows, db_mappers.FromAvailabilityWindowDBModel(dbWindow))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return windows, nil
+}
+
+func (r *userRepository) DeleteAvailabilityWindow(ctx context.Context, id value_objects.UserID, windowID int64) error {
+	query, args, err := r.sb.Delete("user_availability_windows").
+		Where(squirrel.Eq{"id": windowID, "user_id": string(id)}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete availability window: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrAvailabilityWindowNotFound
+	}
+
+	return nil
+}
+
+func (r *userRepository) GetUnavailableUserIDs(ctx context.Context, ids []value_objects.UserID, at time.Time) (map[value_objects.UserID]bool, error) {
+	unavailable := make(map[value_objects.UserID]bool)
+	if len(ids) == 0 {
+		return unavailable, nil
+	}
+
+	userIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		userIDs = append(userIDs, string(id))
+	}
+
+	query, args, err := r.sb.Select("DISTINCT user_id").
+		From("user_availability_windows").
+		Where(squirrel.Eq{"user_id": userIDs}).
+		Where(squirrel.LtOrEq{"starts_at": at.Format(time.RFC3339)}).
+		Where(squirrel.Gt{"ends_at": at.Format(time.RFC3339)}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch unavailable users: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan user id: %v", err)
+		}
+
+		unavailable[value_objects.UserID(userID)] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return unavailable, nil
+}
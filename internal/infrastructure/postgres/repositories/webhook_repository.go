@@ -0,0 +1,230 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/db"
+	"pr-service/internal/infrastructure/db_mappers"
+	"pr-service/internal/infrastructure/db_models"
+)
+
+type webhookRepository struct {
+	db *sql.DB
+	sb squirrel.StatementBuilderType
+}
+
+func NewWebhookRepository(db *sql.DB) app.WebhookRepository {
+	return &webhookRepository{
+		db: db,
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+func (r *webhookRepository) executor(ctx context.Context) db.QueryExecutor {
+	return db.GetQueryExecutor(ctx, r.db)
+}
+
+func (r *webhookRepository) Create(ctx context.Context, webhook entities.Webhook) (entities.Webhook, error) {
+	dbWebhook := db_mappers.ToWebhookDBModel(webhook)
+
+	query, args, err := r.sb.Insert("webhooks").
+		Columns("url", "secret", "event_types", "created_at").
+		Values(dbWebhook.URL, dbWebhook.Secret, pq.Array(dbWebhook.EventTypes), dbWebhook.CreatedAt).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return entities.Webhook{}, fmt.Errorf("failed to build insert query: %v", err)
+	}
+
+	err = r.executor(ctx).QueryRowContext(ctx, query, args...).Scan(&webhook.ID)
+	if err != nil {
+		return entities.Webhook{}, fmt.Errorf("failed to insert webhook: %v", err)
+	}
+
+	return webhook, nil
+}
+
+func (r *webhookRepository) GetAll(ctx context.Context) ([]entities.Webhook, error) {
+	query, args, err := r.sb.Select("id", "url", "secret", "event_types", "created_at").
+		From("webhooks").
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhooks: %v", err)
+	}
+	defer rows.Close()
+
+	var webhooks []entities.Webhook
+
+	for rows.Next() {
+		var dbWebhook db_models.Webhook
+		if err := rows.Scan(&dbWebhook.ID, &dbWebhook.URL, &dbWebhook.Secret, pq.Array(&dbWebhook.EventTypes), &dbWebhook.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %v", err)
+		}
+
+		webhooks = append(webhooks, db_mappers.FromWebhookDBModel(dbWebhook))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return webhooks, nil
+}
+
+func (r *webhookRepository) Delete(ctx context.Context, id int64) error {
+	query, args, err := r.sb.Delete("webhooks").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build delete query: %v", err)
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) AddDeadLetter(ctx context.Context, deadLetter entities.WebhookDeadLetter) error {
+	dbDeadLetter, err := db_mappers.ToWebhookDeadLetterDBModel(deadLetter)
+	if err != nil {
+		return fmt.Errorf("failed to encode event payload: %v", err)
+	}
+
+	query, args, err := r.sb.Insert("webhook_dead_letters").
+		Columns("webhook_id", "event_type", "payload", "occurred_at", "attempts", "last_error", "failed_at").
+		Values(dbDeadLetter.WebhookID, dbDeadLetter.EventType, dbDeadLetter.Payload, dbDeadLetter.OccurredAt, dbDeadLetter.Attempts, dbDeadLetter.LastError, dbDeadLetter.FailedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook dead letter: %v", err)
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) GetDeadLetters(ctx context.Context, webhookID int64) ([]entities.WebhookDeadLetter, error) {
+	var exists bool
+
+	err := r.executor(ctx).QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM webhooks WHERE id = $1)", webhookID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check webhook: %v", err)
+	}
+	if !exists {
+		return nil, domain.ErrWebhookNotFound
+	}
+
+	query, args, err := r.sb.Select("id", "webhook_id", "event_type", "payload", "occurred_at", "attempts", "last_error", "failed_at").
+		From("webhook_dead_letters").
+		Where(squirrel.Eq{"webhook_id": webhookID}).
+		OrderBy("failed_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhook dead letters: %v", err)
+	}
+	defer rows.Close()
+
+	var deadLetters []entities.WebhookDeadLetter
+
+	for rows.Next() {
+		var dbDeadLetter db_models.WebhookDeadLetter
+		if err := rows.Scan(&dbDeadLetter.ID, &dbDeadLetter.WebhookID, &dbDeadLetter.EventType, &dbDeadLetter.Payload, &dbDeadLetter.OccurredAt, &dbDeadLetter.Attempts, &dbDeadLetter.LastError, &dbDeadLetter.FailedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook dead letter: %v", err)
+		}
+
+		deadLetters = append(deadLetters, db_mappers.FromWebhookDeadLetterDBModel(dbDeadLetter))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return deadLetters, nil
+}
+
+func (r *webhookRepository) GetDeliveries(ctx context.Context, outboxEntryID int64) ([]entities.WebhookDelivery, error) {
+	query, args, err := r.sb.Select("webhook_id", "outbox_id", "attempts", "next_attempt_at", "done").
+		From("webhook_deliveries").
+		Where(squirrel.Eq{"outbox_id": outboxEntryID}).
+		OrderBy("webhook_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %v", err)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhook deliveries: %v", err)
+	}
+	defer rows.Close()
+
+	var deliveries []entities.WebhookDelivery
+
+	for rows.Next() {
+		var dbDelivery db_models.WebhookDelivery
+		if err := rows.Scan(&dbDelivery.WebhookID, &dbDelivery.OutboxID, &dbDelivery.Attempts, &dbDelivery.NextAttemptAt, &dbDelivery.Done); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %v", err)
+		}
+
+		deliveries = append(deliveries, db_mappers.FromWebhookDeliveryDBModel(dbDelivery))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %v", err)
+	}
+
+	return deliveries, nil
+}
+
+func (r *webhookRepository) SaveDelivery(ctx context.Context, delivery entities.WebhookDelivery) error {
+	dbDelivery := db_mappers.ToWebhookDeliveryDBModel(delivery)
+
+	query, args, err := r.sb.Insert("webhook_deliveries").
+		Columns("webhook_id", "outbox_id", "attempts", "next_attempt_at", "done").
+		Values(dbDelivery.WebhookID, dbDelivery.OutboxID, dbDelivery.Attempts, dbDelivery.NextAttemptAt, dbDelivery.Done).
+		Suffix("ON CONFLICT (webhook_id, outbox_id) DO UPDATE SET attempts = EXCLUDED.attempts, next_attempt_at = EXCLUDED.next_attempt_at, done = EXCLUDED.done").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %v", err)
+	}
+
+	_, err = r.executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to save webhook delivery: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package sinks
+
+import (
+	"context"
+	"log"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+type logSink struct{}
+
+func NewLog() app.EventSink {
+	return &logSink{}
+}
+
+func (s *logSink) Deliver(_ context.Context, entry entities.OutboxEntry) error {
+	log.Printf("Event %d %s for %s: %v", entry.ID, entry.Event.Type, entry.Event.AggregateID, entry.Event.Payload)
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+type payload struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	OccurredAt string         `json:"occurred_at"`
+	Data       map[string]any `json:"data"`
+}
+
+// Dispatcher makes one attempt per webhook on each Deliver call, a failed entry
+// stays pending in the outbox and is retried by a later relay run after backoff.
+// Attempts and the next attempt time are stored per webhook and outbox entry,
+// so retries survive restarts and leader changes.
+type Dispatcher struct {
+	repository   app.WebhookRepository
+	client       *http.Client
+	timeProvider app.TimeProvider
+	maxAttempts  int
+	backoff      time.Duration
+}
+
+func NewDispatcher(repository app.WebhookRepository, client *http.Client, timeProvider app.TimeProvider, maxAttempts int, backoff time.Duration) *Dispatcher {
+	return &Dispatcher{
+		repository:   repository,
+		client:       client,
+		timeProvider: timeProvider,
+		maxAttempts:  max(maxAttempts, 1),
+		backoff:      backoff,
+	}
+}
+
+func (d *Dispatcher) Deliver(ctx context.Context, entry entities.OutboxEntry) error {
+	webhooks, err := d.repository.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored, err := d.repository.GetDeliveries(ctx, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	deliveries := make(map[int64]entities.WebhookDelivery, len(stored))
+	for _, delivery := range stored {
+		deliveries[delivery.WebhookID] = delivery
+	}
+
+	event := entry.Event
+	eventID := strconv.FormatInt(entry.ID, 10)
+
+	body, err := json.Marshal(payload{
+		ID:         eventID,
+		Type:       string(event.Type),
+		OccurredAt: event.OccurredAt.UTC().Format(time.RFC3339),
+		Data:       event.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %v", err)
+	}
+
+	var errs []error
+	var retryAt time.Time
+
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(event.Type) {
+			continue
+		}
+
+		delivery, ok := deliveries[webhook.ID]
+		if !ok {
+			delivery = entities.WebhookDelivery{WebhookID: webhook.ID, OutboxEntryID: entry.ID}
+		}
+
+		delivery, err := d.deliverTo(ctx, webhook, delivery, event, eventID, body)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		if !delivery.Done && !delivery.NextAttemptAt.IsZero() && (retryAt.IsZero() || delivery.NextAttemptAt.Before(retryAt)) {
+			retryAt = delivery.NextAttemptAt
+		}
+	}
+
+	if retryAt.IsZero() {
+		return errors.Join(errs...)
+	}
+
+	return &app.RetryError{RetryAt: retryAt, Err: errors.Join(errs...)}
+}
+
+// deliverTo returns the delivery state after the attempt. A webhook whose
+// retry is not due yet is left untouched and reports no error.
+func (d *Dispatcher) deliverTo(ctx context.Context, webhook entities.Webhook, delivery entities.WebhookDelivery, event entities.Event, eventID string, body []byte) (entities.WebhookDelivery, error) {
+	if delivery.Done {
+		return delivery, nil
+	}
+
+	now := d.timeProvider.Now()
+	if now.Before(delivery.NextAttemptAt) {
+		return delivery, nil
+	}
+
+	err := d.send(ctx, webhook, event, eventID, body)
+	if err == nil {
+		delivery.Done = true
+		return delivery, d.repository.SaveDelivery(ctx, delivery)
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return delivery, ctxErr
+	}
+
+	delivery.Attempts++
+	if delivery.Attempts < d.maxAttempts {
+		delivery.NextAttemptAt = now.Add(d.backoff << (delivery.Attempts - 1))
+		if saveErr := d.repository.SaveDelivery(ctx, delivery); saveErr != nil {
+			return delivery, saveErr
+		}
+
+		return delivery, fmt.Errorf("webhook %d: %w", webhook.ID, err)
+	}
+
+	log.Printf("Webhook %d gave up on %s event %s: %v", webhook.ID, event.Type, eventID, err)
+
+	err = d.repository.AddDeadLetter(ctx, entities.WebhookDeadLetter{
+		WebhookID: webhook.ID,
+		Event:     event,
+		Attempts:  delivery.Attempts,
+		LastError: err.Error(),
+		FailedAt:  now,
+	})
+	if err != nil {
+		return delivery, err
+	}
+
+	delivery.Done = true
+
+	return delivery, d.repository.SaveDelivery(ctx, delivery)
+}
+
+func (d *Dispatcher) send(ctx context.Context, webhook entities.Webhook, event entities.Event, eventID string, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %v", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+	request.Header.Set(EventHeader, string(event.Type))
+	request.Header.Set(DeliveryHeader, eventID)
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer response.Body.Close()
+
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+
+	return nil
+}
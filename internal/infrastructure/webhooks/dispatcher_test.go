@@ -0,0 +1,256 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/app"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/memory"
+)
+
+const testSecret = "s3cret"
+
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+type receiver struct {
+	mu       sync.Mutex
+	requests []receivedRequest
+	statuses []int
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, request *http.Request) {
+	body, _ := io.ReadAll(request.Body)
+
+	r.mu.Lock()
+	r.requests = append(r.requests, receivedRequest{header: request.Header.Clone(), body: body})
+	status := http.StatusOK
+	if len(r.requests) <= len(r.statuses) {
+		status = r.statuses[len(r.requests)-1]
+	}
+	r.mu.Unlock()
+
+	w.WriteHeader(status)
+}
+
+func (r *receiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+func newDispatcher(t *testing.T, target *receiver, eventTypes ...entities.EventType) (*Dispatcher, app.WebhookRepository, entities.Webhook) {
+	t.Helper()
+
+	dispatcher, repository, webhook, _ := newDispatcherWithClock(t, target, eventTypes...)
+
+	return dispatcher, repository, webhook
+}
+
+func newDispatcherWithClock(t *testing.T, target *receiver, eventTypes ...entities.EventType) (*Dispatcher, app.WebhookRepository, entities.Webhook, *clock) {
+	t.Helper()
+
+	server := httptest.NewServer(target)
+	t.Cleanup(server.Close)
+
+	repository := memory.NewWebhookRepository(memory.NewStore())
+	webhook, err := repository.Create(context.Background(), entities.Webhook{URL: server.URL, Secret: testSecret, EventTypes: eventTypes})
+	require.NoError(t, err)
+
+	now := &clock{now: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)}
+
+	return NewDispatcher(repository, server.Client(), now, 3, time.Minute), repository, webhook, now
+}
+
+func mergedEvent() entities.Event {
+	return entities.Event{
+		Type:       entities.EventPullRequestMerged,
+		OccurredAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+		Payload:    map[string]any{"pull_request_id": "pr-1"},
+	}
+}
+
+func mergedEntry() entities.OutboxEntry {
+	return entities.OutboxEntry{ID: 7, Event: mergedEvent()}
+}
+
+func TestDispatcher_Deliver_SignsPayload(t *testing.T) {
+	target := &receiver{}
+	dispatcher, _, _ := newDispatcher(t, target)
+
+	require.NoError(t, dispatcher.Deliver(context.Background(), mergedEntry()))
+
+	requests := target.received()
+	require.Len(t, requests, 1)
+	assert.True(t, VerifySignature(testSecret, requests[0].body, requests[0].header.Get(SignatureHeader)))
+	assert.False(t, VerifySignature("other", requests[0].body, requests[0].header.Get(SignatureHeader)))
+	assert.Equal(t, string(entities.EventPullRequestMerged), requests[0].header.Get(EventHeader))
+	assert.Equal(t, "7", requests[0].header.Get(DeliveryHeader))
+
+	var body payload
+	require.NoError(t, json.Unmarshal(requests[0].body, &body))
+	assert.Equal(t, "7", body.ID)
+	assert.Equal(t, "PullRequestMerged", body.Type)
+	assert.Equal(t, "2024-01-01T09:00:00Z", body.OccurredAt)
+	assert.Equal(t, map[string]any{"pull_request_id": "pr-1"}, body.Data)
+}
+
+func TestDispatcher_Deliver_SkipsUnsubscribedWebhooks(t *testing.T) {
+	target := &receiver{}
+	dispatcher, _, _ := newDispatcher(t, target, entities.EventTeamCreated)
+
+	require.NoError(t, dispatcher.Deliver(context.Background(), mergedEntry()))
+
+	assert.Empty(t, target.received())
+}
+
+func TestDispatcher_Deliver_RetriesFailedRequestsAfterBackoff(t *testing.T) {
+	target := &receiver{statuses: []int{http.StatusInternalServerError, http.StatusBadGateway}}
+	dispatcher, repository, webhook, now := newDispatcherWithClock(t, target)
+	ctx := context.Background()
+
+	err := dispatcher.Deliver(ctx, mergedEntry())
+	var retryErr *app.RetryError
+	require.ErrorAs(t, err, &retryErr)
+	assert.Equal(t, now.Now().Add(time.Minute), retryErr.RetryAt)
+	assert.EqualError(t, retryErr.Err, "webhook 1: unexpected status 500")
+
+	err = dispatcher.Deliver(ctx, mergedEntry())
+	require.ErrorAs(t, err, &retryErr)
+	assert.NoError(t, retryErr.Err)
+	assert.Len(t, target.received(), 1)
+
+	now.advance(time.Minute)
+	assert.Error(t, dispatcher.Deliver(ctx, mergedEntry()))
+
+	now.advance(time.Minute)
+	assert.Error(t, dispatcher.Deliver(ctx, mergedEntry()))
+	assert.Len(t, target.received(), 2)
+
+	now.advance(time.Minute)
+	require.NoError(t, dispatcher.Deliver(ctx, mergedEntry()))
+
+	requests := target.received()
+	require.Len(t, requests, 3)
+	assert.Equal(t, requests[0].header.Get(DeliveryHeader), requests[2].header.Get(DeliveryHeader))
+
+	deadLetters, err := repository.GetDeadLetters(ctx, webhook.ID)
+	require.NoError(t, err)
+	assert.Empty(t, deadLetters)
+}
+
+func TestDispatcher_Deliver_SkipsWebhooksThatAcceptedEntry(t *testing.T) {
+	target := &receiver{}
+	failing := &receiver{statuses: []int{http.StatusInternalServerError}}
+	dispatcher, repository, _, now := newDispatcherWithClock(t, target)
+	ctx := context.Background()
+
+	server := httptest.NewServer(failing)
+	t.Cleanup(server.Close)
+	_, err := repository.Create(ctx, entities.Webhook{URL: server.URL, Secret: testSecret})
+	require.NoError(t, err)
+
+	assert.Error(t, dispatcher.Deliver(ctx, mergedEntry()))
+
+	now.advance(time.Minute)
+	require.NoError(t, dispatcher.Deliver(ctx, mergedEntry()))
+
+	assert.Len(t, target.received(), 1)
+	assert.Len(t, failing.received(), 2)
+}
+
+func TestDispatcher_Deliver_DeadLettersAfterMaxAttempts(t *testing.T) {
+	target := &receiver{statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}}
+	dispatcher, repository, webhook, now := newDispatcherWithClock(t, target)
+	ctx := context.Background()
+
+	assert.Error(t, dispatcher.Deliver(ctx, mergedEntry()))
+	now.advance(time.Minute)
+	assert.Error(t, dispatcher.Deliver(ctx, mergedEntry()))
+	now.advance(2 * time.Minute)
+	require.NoError(t, dispatcher.Deliver(ctx, mergedEntry()))
+
+	assert.Len(t, target.received(), 3)
+
+	deadLetters, err := repository.GetDeadLetters(ctx, webhook.ID)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, 3, deadLetters[0].Attempts)
+	assert.Equal(t, "unexpected status 500", deadLetters[0].LastError)
+	assert.Equal(t, mergedEvent(), deadLetters[0].Event)
+}
+
+func TestDispatcher_Deliver_KeepsEventPendingWhenCancelled(t *testing.T) {
+	target := &receiver{}
+	dispatcher, repository, webhook := newDispatcher(t, target)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := dispatcher.Deliver(ctx, mergedEntry())
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, target.received())
+
+	deadLetters, err := repository.GetDeadLetters(context.Background(), webhook.ID)
+	require.NoError(t, err)
+	assert.Empty(t, deadLetters)
+
+	require.NoError(t, dispatcher.Deliver(context.Background(), mergedEntry()))
+	assert.Len(t, target.received(), 1)
+}
+
+func TestDispatcher_Deliver_KeepsRetryStateAcrossRestarts(t *testing.T) {
+	target := &receiver{statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError}}
+	dispatcher, repository, webhook, now := newDispatcherWithClock(t, target)
+	ctx := context.Background()
+
+	assert.Error(t, dispatcher.Deliver(ctx, mergedEntry()))
+
+	restarted := NewDispatcher(repository, dispatcher.client, now, 3, time.Minute)
+	assert.Error(t, restarted.Deliver(ctx, mergedEntry()))
+	assert.Len(t, target.received(), 1)
+
+	now.advance(time.Minute)
+	assert.Error(t, restarted.Deliver(ctx, mergedEntry()))
+	assert.Len(t, target.received(), 2)
+
+	deliveries, err := repository.GetDeliveries(ctx, mergedEntry().ID)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, webhook.ID, deliveries[0].WebhookID)
+	assert.Equal(t, 2, deliveries[0].Attempts)
+	assert.Equal(t, now.Now().Add(2*time.Minute), deliveries[0].NextAttemptAt)
+	assert.False(t, deliveries[0].Done)
+}
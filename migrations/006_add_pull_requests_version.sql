@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE pull_requests
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS version;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN assignment_strategy VARCHAR(50) NOT NULL DEFAULT 'RANDOM',
+    ADD COLUMN round_robin_cursor  TEXT;
+
+-- +goose Down
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS round_robin_cursor,
+    DROP COLUMN IF EXISTS assignment_strategy;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN reviewers_limit INTEGER NOT NULL DEFAULT 2;
+
+ALTER TABLE pull_requests
+    ADD COLUMN reviewers_limit INTEGER NOT NULL DEFAULT 2;
+
+-- +goose Down
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS reviewers_limit;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS reviewers_limit;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN decision   VARCHAR(50) NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN decided_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS decided_at,
+    DROP COLUMN IF EXISTS decision;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN lead_id               TEXT,
+    ADD COLUMN min_approvals         INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN require_lead_approval BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE pull_requests
+    ADD COLUMN force_merged BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS force_merged;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS require_lead_approval,
+    DROP COLUMN IF EXISTS min_approvals,
+    DROP COLUMN IF EXISTS lead_id;
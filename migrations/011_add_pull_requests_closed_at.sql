@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE pull_requests
+    ADD COLUMN closed_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS closed_at;
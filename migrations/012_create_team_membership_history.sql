@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE team_membership_history
+(
+    id             BIGSERIAL PRIMARY KEY,
+    user_id        TEXT         NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    action         VARCHAR(50)  NOT NULL,
+    from_team_name VARCHAR(255),
+    to_team_name   VARCHAR(255),
+    changed_at     TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_team_membership_history_from_team ON team_membership_history (from_team_name);
+CREATE INDEX idx_team_membership_history_to_team ON team_membership_history (to_team_name);
+
+-- +goose Down
+DROP TABLE IF EXISTS team_membership_history;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN archived_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,94 @@
+-- +goose Up
+INSERT INTO teams (id, team_name)
+SELECT DISTINCT u.team_name, u.team_name
+FROM users u
+WHERE u.team_name <> ''
+  AND NOT EXISTS (SELECT 1 FROM teams t WHERE t.team_name = u.team_name);
+
+UPDATE teams
+SET id = gen_random_uuid()::TEXT;
+
+ALTER TABLE teams
+    ALTER COLUMN id SET DEFAULT gen_random_uuid()::TEXT;
+
+ALTER TABLE users
+    ADD COLUMN team_id TEXT REFERENCES teams (id);
+
+UPDATE users u
+SET team_id = t.id
+FROM teams t
+WHERE t.team_name = u.team_name;
+
+ALTER TABLE users
+    DROP COLUMN team_name;
+
+CREATE INDEX idx_users_team_id ON users (team_id);
+
+ALTER TABLE team_membership_history
+    ADD COLUMN from_team_id TEXT REFERENCES teams (id) ON DELETE SET NULL,
+    ADD COLUMN to_team_id   TEXT REFERENCES teams (id) ON DELETE SET NULL;
+
+UPDATE team_membership_history h
+SET from_team_id = t.id
+FROM teams t
+WHERE t.team_name = h.from_team_name;
+
+UPDATE team_membership_history h
+SET to_team_id = t.id
+FROM teams t
+WHERE t.team_name = h.to_team_name;
+
+DROP INDEX IF EXISTS idx_team_membership_history_from_team;
+DROP INDEX IF EXISTS idx_team_membership_history_to_team;
+
+ALTER TABLE team_membership_history
+    DROP COLUMN from_team_name,
+    DROP COLUMN to_team_name;
+
+CREATE INDEX idx_team_membership_history_from_team ON team_membership_history (from_team_id);
+CREATE INDEX idx_team_membership_history_to_team ON team_membership_history (to_team_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_team_membership_history_from_team;
+DROP INDEX IF EXISTS idx_team_membership_history_to_team;
+
+ALTER TABLE team_membership_history
+    ADD COLUMN from_team_name VARCHAR(255),
+    ADD COLUMN to_team_name   VARCHAR(255);
+
+UPDATE team_membership_history h
+SET from_team_name = t.team_name
+FROM teams t
+WHERE t.id = h.from_team_id;
+
+UPDATE team_membership_history h
+SET to_team_name = t.team_name
+FROM teams t
+WHERE t.id = h.to_team_id;
+
+ALTER TABLE team_membership_history
+    DROP COLUMN from_team_id,
+    DROP COLUMN to_team_id;
+
+CREATE INDEX idx_team_membership_history_from_team ON team_membership_history (from_team_name);
+CREATE INDEX idx_team_membership_history_to_team ON team_membership_history (to_team_name);
+
+DROP INDEX IF EXISTS idx_users_team_id;
+
+ALTER TABLE users
+    ADD COLUMN team_name VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE users u
+SET team_name = t.team_name
+FROM teams t
+WHERE t.id = u.team_id;
+
+ALTER TABLE users
+    ALTER COLUMN team_name DROP DEFAULT,
+    DROP COLUMN team_id;
+
+ALTER TABLE teams
+    ALTER COLUMN id DROP DEFAULT;
+
+UPDATE teams
+SET id = team_name;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN parent_team_id TEXT REFERENCES teams (id) ON DELETE SET NULL;
+
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN assignment_level INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS assignment_level;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS parent_team_id;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN source_team_id TEXT REFERENCES teams (id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS source_team_id;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE user_availability_windows
+(
+    id        BIGSERIAL PRIMARY KEY,
+    user_id   TEXT        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at   TIMESTAMPTZ NOT NULL,
+    reason    TEXT        NOT NULL DEFAULT '',
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_user_availability_windows_user_period ON user_availability_windows (user_id, starts_at, ends_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_availability_windows;
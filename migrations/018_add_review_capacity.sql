@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN max_open_reviews INTEGER NOT NULL DEFAULT 0 CHECK (max_open_reviews >= 0);
+
+ALTER TABLE teams
+    ADD COLUMN max_open_reviews INTEGER NOT NULL DEFAULT 0 CHECK (max_open_reviews >= 0);
+
+-- +goose Down
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS max_open_reviews;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS max_open_reviews;
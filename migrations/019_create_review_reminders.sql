@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN remind_after_seconds   BIGINT NOT NULL DEFAULT 0 CHECK (remind_after_seconds >= 0),
+    ADD COLUMN escalate_after_seconds BIGINT NOT NULL DEFAULT 0 CHECK (escalate_after_seconds >= 0);
+
+CREATE TABLE review_reminders
+(
+    pull_request_id TEXT        NOT NULL REFERENCES pull_requests (id) ON DELETE CASCADE,
+    recipient_id    TEXT        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    kind            VARCHAR(50) NOT NULL,
+    sent_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pull_request_id, recipient_id, kind)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS review_reminders;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS escalate_after_seconds,
+    DROP COLUMN IF EXISTS remind_after_seconds;
@@ -0,0 +1,30 @@
+-- +goose Up
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+ALTER TABLE teams
+    ADD COLUMN reassign_after_seconds BIGINT NOT NULL DEFAULT 0 CHECK (reassign_after_seconds >= 0);
+
+CREATE TABLE pull_request_reviewer_history
+(
+    id               BIGSERIAL PRIMARY KEY,
+    pull_request_id  TEXT        NOT NULL REFERENCES pull_requests (id) ON DELETE CASCADE,
+    old_reviewer_id  TEXT        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    new_reviewer_id  TEXT        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    assignment_level INTEGER     NOT NULL DEFAULT 0,
+    source_team_id   TEXT REFERENCES teams (id) ON DELETE SET NULL,
+    assigned_at      TIMESTAMPTZ NOT NULL,
+    reason           VARCHAR(50) NOT NULL,
+    changed_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_pull_request_reviewer_history_pull_request ON pull_request_reviewer_history (pull_request_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS pull_request_reviewer_history;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS reassign_after_seconds;
+
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS assigned_at;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE webhooks
+(
+    id          BIGSERIAL PRIMARY KEY,
+    url         TEXT        NOT NULL,
+    secret      TEXT        NOT NULL,
+    event_types TEXT[]      NOT NULL DEFAULT '{}',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE webhook_dead_letters
+(
+    id          BIGSERIAL PRIMARY KEY,
+    webhook_id  BIGINT      NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_type  VARCHAR(50) NOT NULL,
+    payload     JSONB       NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    attempts    INTEGER     NOT NULL,
+    last_error  TEXT        NOT NULL DEFAULT '',
+    failed_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_dead_letters_webhook ON webhook_dead_letters (webhook_id, failed_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS webhook_dead_letters;
+DROP TABLE IF EXISTS webhooks;
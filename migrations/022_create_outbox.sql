@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE outbox
+(
+    id           BIGSERIAL PRIMARY KEY,
+    aggregate_id VARCHAR(255) NOT NULL,
+    event_type   VARCHAR(50)  NOT NULL,
+    payload      JSONB        NOT NULL,
+    occurred_at  TIMESTAMPTZ  NOT NULL,
+    delivered_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_outbox_pending ON outbox (id) WHERE delivered_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE audit_log
+(
+    id           BIGSERIAL PRIMARY KEY,
+    actor        VARCHAR(255) NOT NULL,
+    action       VARCHAR(50)  NOT NULL,
+    entity_type  VARCHAR(50)  NOT NULL,
+    entity_id    VARCHAR(255) NOT NULL,
+    before_state JSONB,
+    after_state  JSONB,
+    request_id   VARCHAR(255) NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX idx_audit_log_entity ON audit_log (entity_id, id);
+CREATE INDEX idx_audit_log_created_at ON audit_log (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_log;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE pull_request_reviewer_quotas
+(
+    pull_request_id TEXT    NOT NULL REFERENCES pull_requests (id) ON DELETE CASCADE,
+    source_team_id  TEXT    NOT NULL REFERENCES teams (id),
+    reviewers_count INTEGER NOT NULL CHECK (reviewers_count > 0),
+    PRIMARY KEY (pull_request_id, source_team_id)
+);
+
+CREATE INDEX idx_pull_request_reviewer_quotas_source_team ON pull_request_reviewer_quotas (source_team_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS pull_request_reviewer_quotas;
@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE pull_request_reviewer_history
+    ALTER COLUMN new_reviewer_id DROP NOT NULL;
+
+-- +goose Down
+DELETE FROM pull_request_reviewer_history
+WHERE new_reviewer_id IS NULL;
+
+ALTER TABLE pull_request_reviewer_history
+    ALTER COLUMN new_reviewer_id SET NOT NULL;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE pull_requests
+    ADD COLUMN closed_from_draft BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS closed_from_draft;
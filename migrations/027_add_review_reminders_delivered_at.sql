@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE review_reminders
+    ADD COLUMN delivered_at TIMESTAMPTZ;
+
+UPDATE review_reminders
+SET delivered_at = sent_at;
+
+CREATE INDEX idx_review_reminders_undelivered ON review_reminders (sent_at) WHERE delivered_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_review_reminders_undelivered;
+
+ALTER TABLE review_reminders
+    DROP COLUMN IF EXISTS delivered_at;
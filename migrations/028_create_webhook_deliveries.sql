@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE webhook_deliveries
+(
+    webhook_id      BIGINT      NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    outbox_id       BIGINT      NOT NULL REFERENCES outbox (id) ON DELETE CASCADE,
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ,
+    done            BOOLEAN     NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (webhook_id, outbox_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_outbox ON webhook_deliveries (outbox_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS webhook_deliveries;
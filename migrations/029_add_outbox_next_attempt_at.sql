@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE outbox
+    ADD COLUMN next_attempt_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS next_attempt_at;
@@ -0,0 +1,104 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/postgres/repositories"
+	"pr-service/tests/integration/helpers"
+)
+
+func TestAuditRepository_AddAndFind(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewAuditRepository(db)
+	ctx := context.Background()
+	createdAt := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, repository.Add(ctx,
+		entities.AuditEntry{
+			Actor:      "admin",
+			Action:     entities.AuditPullRequestCreated,
+			EntityType: entities.AuditEntityPullRequest,
+			EntityID:   "pull-request-1",
+			After:      map[string]any{"status": "OPEN"},
+			RequestID:  "req-1",
+			CreatedAt:  createdAt,
+		},
+		entities.AuditEntry{
+			Actor:      "admin",
+			Action:     entities.AuditTeamCreated,
+			EntityType: entities.AuditEntityTeam,
+			EntityID:   "backend",
+			CreatedAt:  createdAt,
+		},
+		entities.AuditEntry{
+			Actor:      entities.SystemActor,
+			Action:     entities.AuditPullRequestMerged,
+			EntityType: entities.AuditEntityPullRequest,
+			EntityID:   "pull-request-1",
+			Before:     map[string]any{"status": "OPEN"},
+			After:      map[string]any{"status": "MERGED"},
+			CreatedAt:  createdAt.Add(time.Hour),
+		},
+	))
+	require.NoError(t, repository.Add(ctx))
+
+	entries, err := repository.Find(ctx, entities.AuditQuery{EntityID: "pull-request-1"})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, entities.AuditPullRequestCreated, entries[0].Action)
+	assert.Equal(t, "req-1", entries[0].RequestID)
+	assert.Nil(t, entries[0].Before)
+	assert.Equal(t, "OPEN", entries[0].After["status"])
+	assert.True(t, createdAt.Equal(entries[0].CreatedAt))
+	assert.Equal(t, "MERGED", entries[1].After["status"])
+
+	entries, err = repository.Find(ctx, entities.AuditQuery{EntityID: "pull-request-1", AfterID: entries[0].ID, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, entities.AuditPullRequestMerged, entries[0].Action)
+
+	entries, err = repository.Find(ctx, entities.AuditQuery{Since: createdAt.Add(time.Minute)})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, entities.AuditPullRequestMerged, entries[0].Action)
+
+	entries, err = repository.Find(ctx, entities.AuditQuery{EntityType: entities.AuditEntityTeam})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "backend", entries[0].EntityID)
+}
+
+func TestAuditRepository_RejectsUpdatesAndDeletes(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewAuditRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, repository.Add(ctx, entities.AuditEntry{
+		Actor:      "admin",
+		Action:     entities.AuditTeamCreated,
+		EntityType: entities.AuditEntityTeam,
+		EntityID:   "team-1",
+		CreatedAt:  time.Now().UTC(),
+	}))
+
+	_, err := db.ExecContext(ctx, "UPDATE audit_log SET actor = 'intruder'")
+	assert.ErrorContains(t, err, "append-only")
+
+	_, err = db.ExecContext(ctx, "DELETE FROM audit_log")
+	assert.ErrorContains(t, err, "append-only")
+
+	entries, err := repository.Find(ctx, entities.AuditQuery{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "admin", entries[0].Actor)
+}
@@ -28,9 +28,23 @@ func CleanupTestDB(t *testing.T, db *sql.DB) {
 	t.Helper()
 
 	if db != nil {
+		// audit_log rejects row deletes, TRUNCATE does not fire row-level triggers.
+		if _, err := db.Exec("TRUNCATE audit_log"); err != nil {
+			t.Logf("Failed to clean table audit_log: %v", err)
+		}
+
 		tables := []string{
+			"webhook_deliveries",
+			"outbox",
+			"webhook_dead_letters",
+			"webhooks",
+			"review_reminders",
+			"team_membership_history",
+			"pull_request_reviewer_history",
+			"pull_request_reviewer_quotas",
 			"pull_request_reviewers",
 			"pull_requests",
+			"user_availability_windows",
 			"users",
 			"teams",
 		}
@@ -45,8 +59,14 @@ func CleanupTestDB(t *testing.T, db *sql.DB) {
 }
 
 func InsertTestUser(db *sql.DB, id, username, teamName string, isActive bool) error {
+	if teamName != "" {
+		if err := InsertTestTeam(db, teamName, teamName); err != nil {
+			return err
+		}
+	}
+
 	_, err := db.Exec(
-		"INSERT INTO users (id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
+		"INSERT INTO users (id, username, team_id, is_active) VALUES ($1, $2, (SELECT id FROM teams WHERE team_name = $3), $4)",
 		id, username, teamName, isActive,
 	)
 
@@ -63,7 +83,7 @@ func GetUserActivity(db *sql.DB, userID string) (bool, error) {
 
 func InsertTestTeam(db *sql.DB, id, teamName string) error {
 	_, err := db.Exec(
-		"INSERT INTO teams (id, team_name) VALUES ($1, $2)",
+		"INSERT INTO teams (id, team_name) VALUES ($1, $2) ON CONFLICT DO NOTHING",
 		id, teamName,
 	)
 
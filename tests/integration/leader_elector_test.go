@@ -0,0 +1,44 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/infrastructure/db"
+	"pr-service/tests/integration/helpers"
+)
+
+func TestLeaderElector_RunIfLeader(t *testing.T) {
+	testDB := helpers.SetupTestDB(t)
+	defer testDB.Close()
+
+	const lockID = 990_001
+	ctx := context.Background()
+	first := db.NewLeaderElector(testDB, lockID)
+	second := db.NewLeaderElector(testDB, lockID)
+
+	leader, err := first.RunIfLeader(ctx, func(ctx context.Context) error {
+		secondLeader, err := second.RunIfLeader(ctx, func(ctx context.Context) error {
+			t.Fatal("second elector must not run while the first holds the lock")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.False(t, secondLeader)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, leader)
+
+	ran := false
+	leader, err = second.RunIfLeader(ctx, func(ctx context.Context) error {
+		ran = true
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, leader)
+	assert.True(t, ran)
+}
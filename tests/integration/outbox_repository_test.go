@@ -0,0 +1,72 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/domain/entities"
+	txdb "pr-service/internal/infrastructure/db"
+	"pr-service/internal/infrastructure/postgres/repositories"
+	"pr-service/tests/integration/helpers"
+)
+
+func TestOutboxRepository_AddAndMarkDelivered(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewOutboxRepository(db)
+	ctx := context.Background()
+	occurredAt := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, repository.Add(ctx,
+		entities.Event{Type: entities.EventPullRequestCreated, AggregateID: "pull-request-1", OccurredAt: occurredAt, Payload: map[string]any{"pull_request_id": "pull-request-1"}},
+		entities.Event{Type: entities.EventReviewerAssigned, AggregateID: "pull-request-1", OccurredAt: occurredAt, Payload: map[string]any{"reviewer_id": "user-1"}},
+	))
+	require.NoError(t, repository.Add(ctx))
+
+	entries, err := repository.GetPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, entities.EventPullRequestCreated, entries[0].Event.Type)
+	assert.Equal(t, "pull-request-1", entries[0].Event.AggregateID)
+	assert.True(t, occurredAt.Equal(entries[0].Event.OccurredAt))
+	assert.Equal(t, "user-1", entries[1].Event.Payload["reviewer_id"])
+	assert.Less(t, entries[0].ID, entries[1].ID)
+
+	nextAttemptAt := occurredAt.Add(time.Minute)
+	require.NoError(t, repository.ScheduleRetry(ctx, entries[1].ID, nextAttemptAt))
+	require.NoError(t, repository.MarkDelivered(ctx, entries[0].ID, time.Now()))
+
+	entries, err = repository.GetPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, entities.EventReviewerAssigned, entries[0].Event.Type)
+	require.NotNil(t, entries[0].NextAttemptAt)
+	assert.True(t, nextAttemptAt.Equal(*entries[0].NextAttemptAt))
+}
+
+func TestOutboxRepository_RollsBackWithTransaction(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	txManager := txdb.NewTxManager(db)
+	repository := repositories.NewOutboxRepository(db)
+	ctx := context.Background()
+
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		if err := repository.Add(ctx, entities.Event{Type: entities.EventPullRequestMerged, AggregateID: "pull-request-1", OccurredAt: time.Now()}); err != nil {
+			return err
+		}
+
+		return errOperationFailed
+	})
+	assert.ErrorIs(t, err, errOperationFailed)
+
+	entries, err := repository.GetPending(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
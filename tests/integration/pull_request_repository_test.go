@@ -12,6 +12,7 @@ import (
 	"pr-service/internal/domain"
 	"pr-service/internal/domain/entities"
 	"pr-service/internal/domain/value_objects"
+	txdb "pr-service/internal/infrastructure/db"
 	"pr-service/internal/infrastructure/postgres/repositories"
 	"pr-service/tests/integration/helpers"
 )
@@ -209,6 +210,7 @@ func TestPullRequestRepository_Save_UpdateReviewers(t *testing.T) {
 		AuthorID: pullRequest.AuthorID,
 		Status:   entities.StatusMerged,
 		MergedAt: pullRequest.MergedAt,
+		Version:  pullRequest.Version,
 	}
 
 	err = repository.Save(ctx, updatedPullRequest)
@@ -227,6 +229,66 @@ func TestPullRequestRepository_Save_UpdateReviewers(t *testing.T) {
 	assert.Equal(t, entities.StatusMerged, updatedPullRequestFromDB.Status)
 }
 
+func TestPullRequestRepository_Save_IncrementsVersion(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestPullRequest(db, "pull-request-1", "Test PR", "author-1", "OPEN")
+	require.NoError(t, err)
+
+	pullRequest, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, pullRequest.Version)
+
+	err = repository.Save(ctx, pullRequest)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, pullRequest.Version)
+
+	reloadedPullRequest, err := repository.GetByID(ctx, "pull-request-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, reloadedPullRequest.Version)
+}
+
+func TestPullRequestRepository_Save_StaleVersion(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestPullRequest(db, "pull-request-1", "Test PR", "author-1", "OPEN")
+	require.NoError(t, err)
+
+	firstCopy, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+
+	secondCopy, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+
+	firstCopy.Merge(time.Now())
+	err = repository.Save(ctx, firstCopy)
+	require.NoError(t, err)
+
+	err = repository.Save(ctx, secondCopy)
+
+	assert.Error(t, err)
+	assert.Equal(t, domain.ErrConcurrentModification, err)
+
+	status, err := helpers.GetPullRequestStatus(db, "pull-request-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "MERGED", status)
+}
+
 func TestPullRequestRepository_ReassignReviewer(t *testing.T) {
 	db := helpers.SetupTestDB(t)
 	defer helpers.CleanupTestDB(t, db)
@@ -249,7 +311,7 @@ func TestPullRequestRepository_ReassignReviewer(t *testing.T) {
 	err = helpers.AddReviewerToPullRequest(db, "pull-request-1", "old-reviewer")
 	require.NoError(t, err)
 
-	err = repository.ReassignReviewer(ctx, "pull-request-1", "old-reviewer", "new-reviewer")
+	err = repository.ReassignReviewer(ctx, "pull-request-1", "old-reviewer", entities.ReviewerAssignment{ReviewerID: "new-reviewer", Level: 1})
 	assert.NoError(t, err)
 
 	reviewers, err := helpers.GetPullRequestReviewers(db, "pull-request-1")
@@ -257,6 +319,10 @@ func TestPullRequestRepository_ReassignReviewer(t *testing.T) {
 	assert.Len(t, reviewers, 1)
 	assert.Contains(t, reviewers, "new-reviewer")
 	assert.NotContains(t, reviewers, "old-reviewer")
+
+	pullRequest, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, pullRequest.Assignment("new-reviewer").Level)
 }
 
 func TestPullRequestRepository_GetByReviewer(t *testing.T) {
@@ -465,3 +531,547 @@ func TestPullRequestRepository_GetAll_Order(t *testing.T) {
 	assert.True(t, pullRequestIDs["pull-request-2"])
 	assert.True(t, pullRequestIDs["pull-request-3"])
 }
+
+func TestPullRequestRepository_CountOpenReviews(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	for _, userID := range []string{"author-1", "reviewer-1", "reviewer-2", "reviewer-3"} {
+		err := helpers.InsertTestUser(db, userID, userID, "team1", true)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, helpers.InsertTestPullRequest(db, "pull-request-1", "Open PR", "author-1", "OPEN"))
+	require.NoError(t, helpers.InsertTestPullRequest(db, "pull-request-2", "Another open PR", "author-1", "OPEN"))
+	require.NoError(t, helpers.InsertTestPullRequest(db, "pull-request-3", "Merged PR", "author-1", "MERGED"))
+
+	require.NoError(t, helpers.AddReviewerToPullRequest(db, "pull-request-1", "reviewer-1"))
+	require.NoError(t, helpers.AddReviewerToPullRequest(db, "pull-request-2", "reviewer-1"))
+	require.NoError(t, helpers.AddReviewerToPullRequest(db, "pull-request-2", "reviewer-2"))
+	require.NoError(t, helpers.AddReviewerToPullRequest(db, "pull-request-3", "reviewer-3"))
+
+	openReviews, err := repository.CountOpenReviews(ctx, []value_objects.UserID{"reviewer-1", "reviewer-2", "reviewer-3"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, openReviews["reviewer-1"])
+	assert.Equal(t, 1, openReviews["reviewer-2"])
+	assert.Equal(t, 0, openReviews["reviewer-3"])
+}
+
+func TestPullRequestRepository_Create_PersistsReviewersLimit(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	pullRequest := entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now().UTC().Truncate(time.Second))
+	pullRequest.ReviewersLimit = 3
+
+	err = repository.Create(ctx, pullRequest)
+	require.NoError(t, err)
+
+	result, err := repository.GetByID(ctx, "pull-request-1")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result.ReviewersLimit)
+}
+
+func TestPullRequestRepository_ReviewerQuotas(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	teamRepository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestTeam(db, "platform", "platform")
+	require.NoError(t, err)
+
+	pullRequest := entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now().UTC().Truncate(time.Second))
+	pullRequest.Status = entities.StatusDraft
+	pullRequest.BorrowedReviewers = []entities.ReviewerQuota{{TeamName: "platform", Count: 2}}
+
+	err = repository.Create(ctx, pullRequest)
+	require.NoError(t, err)
+
+	result, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Equal(t, []entities.ReviewerQuota{{TeamName: "platform", Count: 2}}, result.BorrowedReviewers)
+
+	err = teamRepository.Rename(ctx, "platform", "infra")
+	require.NoError(t, err)
+
+	result, err = repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Equal(t, []entities.ReviewerQuota{{TeamName: "infra", Count: 2}}, result.BorrowedReviewers)
+
+	err = repository.RemoveReviewerQuotas(ctx, "pull-request-1")
+	require.NoError(t, err)
+
+	result, err = repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Empty(t, result.BorrowedReviewers)
+}
+
+func TestPullRequestRepository_GetAll_LoadsReviewers(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestUser(db, "reviewer-1", "Reviewer 1", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestPullRequest(db, "pull-request-1", "PR 1", "author-1", "OPEN")
+	require.NoError(t, err)
+
+	err = helpers.InsertTestPullRequest(db, "pull-request-2", "PR 2", "author-1", "OPEN")
+	require.NoError(t, err)
+
+	err = helpers.AddReviewerToPullRequest(db, "pull-request-1", "reviewer-1")
+	require.NoError(t, err)
+
+	pullRequests, err := repository.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, pullRequests, 2)
+
+	for _, pullRequest := range pullRequests {
+		switch pullRequest.ID {
+		case "pull-request-1":
+			assert.Equal(t, []value_objects.UserID{"reviewer-1"}, pullRequest.Reviewers())
+		case "pull-request-2":
+			assert.Empty(t, pullRequest.Reviewers())
+		}
+	}
+
+	reviewed, err := repository.GetByReviewer(ctx, "reviewer-1")
+	require.NoError(t, err)
+	require.Len(t, reviewed, 1)
+	assert.Equal(t, []value_objects.UserID{"reviewer-1"}, reviewed[0].Reviewers())
+}
+
+func TestPullRequestRepository_SaveReview(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestUser(db, "reviewer-1", "Reviewer 1", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestUser(db, "reviewer-2", "Reviewer 2", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestPullRequest(db, "pull-request-1", "PR 1", "author-1", "OPEN")
+	require.NoError(t, err)
+
+	err = helpers.AddReviewerToPullRequest(db, "pull-request-1", "reviewer-1")
+	require.NoError(t, err)
+
+	decidedAt := time.Now().UTC().Truncate(time.Second)
+	err = repository.SaveReview(ctx, "pull-request-1", entities.Review{
+		ReviewerID: "reviewer-1",
+		Decision:   entities.DecisionApproved,
+		DecidedAt:  &decidedAt,
+	})
+	require.NoError(t, err)
+
+	pullRequest, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+
+	review := pullRequest.Review("reviewer-1")
+	assert.Equal(t, entities.DecisionApproved, review.Decision)
+	require.NotNil(t, review.DecidedAt)
+	assert.True(t, decidedAt.Equal(*review.DecidedAt))
+
+	err = repository.ReassignReviewer(ctx, "pull-request-1", "reviewer-1", entities.ReviewerAssignment{ReviewerID: "reviewer-2"})
+	require.NoError(t, err)
+
+	pullRequest, err = repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.True(t, pullRequest.Review("reviewer-2").IsPending())
+
+	err = repository.SaveReview(ctx, "pull-request-1", entities.Review{ReviewerID: "reviewer-1", Decision: entities.DecisionApproved})
+	assert.Equal(t, domain.ErrNotAssigned, err)
+}
+
+func TestPullRequestRepository_Save_ForceMerged(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestPullRequest(db, "pull-request-1", "Test PR", "author-1", "OPEN")
+	require.NoError(t, err)
+
+	pullRequest, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.False(t, pullRequest.ForceMerged)
+
+	pullRequest.ForceMerge(time.Now())
+
+	err = repository.Save(ctx, pullRequest)
+	require.NoError(t, err)
+
+	result, err := repository.GetByID(ctx, "pull-request-1")
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StatusMerged, result.Status)
+	assert.True(t, result.ForceMerged)
+}
+
+func TestPullRequestRepository_Save_ClosedAt(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestPullRequest(db, "pull-request-1", "Test PR", "author-1", "OPEN")
+	require.NoError(t, err)
+
+	pullRequest, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+
+	closedAt := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, pullRequest.Close(closedAt))
+
+	err = repository.Save(ctx, pullRequest)
+	require.NoError(t, err)
+
+	result, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusClosed, result.Status)
+	require.NotNil(t, result.ClosedAt)
+	assert.True(t, closedAt.Equal(*result.ClosedAt))
+}
+
+func TestPullRequestRepository_AddReviewers(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+	err = helpers.InsertTestUser(db, "reviewer-1", "Reviewer 1", "team1", true)
+	require.NoError(t, err)
+	err = helpers.InsertTestUser(db, "reviewer-2", "Reviewer 2", "team1", true)
+	require.NoError(t, err)
+
+	err = helpers.InsertTestPullRequest(db, "pull-request-1", "Test PR", "author-1", "DRAFT")
+	require.NoError(t, err)
+
+	err = repository.AddReviewers(ctx, "pull-request-1", []entities.ReviewerAssignment{
+		{ReviewerID: "reviewer-1"},
+		{ReviewerID: "reviewer-2", Level: 1},
+	})
+	require.NoError(t, err)
+
+	pullRequest, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []value_objects.UserID{"reviewer-1", "reviewer-2"}, pullRequest.Reviewers())
+	assert.Equal(t, 0, pullRequest.Assignment("reviewer-1").Level)
+	assert.Equal(t, 1, pullRequest.Assignment("reviewer-2").Level)
+
+	err = repository.AddReviewers(ctx, "pull-request-1", nil)
+	assert.NoError(t, err)
+}
+
+func TestPullRequestRepository_CountOpenByTeam(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "author-1", "Alice", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "author-2", "Bob", "frontend", true))
+
+	require.NoError(t, helpers.InsertTestPullRequest(db, "pull-request-1", "Open PR", "author-1", "OPEN"))
+	require.NoError(t, helpers.InsertTestPullRequest(db, "pull-request-2", "Draft PR", "author-1", "DRAFT"))
+	require.NoError(t, helpers.InsertTestPullRequest(db, "pull-request-3", "Merged PR", "author-1", "MERGED"))
+	require.NoError(t, helpers.InsertTestPullRequest(db, "pull-request-4", "Other team PR", "author-2", "OPEN"))
+
+	count, err := repository.CountOpenByTeam(ctx, "backend")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestPullRequestRepository_CountOpenByTeam_BorrowedReviewers(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "author-1", "Alice", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "platform-1", "Paul", "platform", true))
+	require.NoError(t, helpers.InsertTestTeam(db, "infra", "infra"))
+
+	borrowed := entities.NewPullRequest("pull-request-1", "Borrowed PR", "author-1", time.Now().UTC())
+	borrowed.AddReviewers([]value_objects.UserID{"platform-1"})
+	borrowed.SetAssignment(entities.ReviewerAssignment{ReviewerID: "platform-1", SourceTeam: "platform", AssignedAt: time.Now().UTC()})
+	require.NoError(t, repository.Create(ctx, borrowed))
+
+	draft := entities.NewPullRequest("pull-request-2", "Draft PR", "author-1", time.Now().UTC())
+	draft.Status = entities.StatusDraft
+	draft.BorrowedReviewers = []entities.ReviewerQuota{{TeamName: "infra", Count: 1}}
+	require.NoError(t, repository.Create(ctx, draft))
+
+	_, err := db.Exec("UPDATE users SET team_id = (SELECT id FROM teams WHERE team_name = 'backend') WHERE id = 'platform-1'")
+	require.NoError(t, err)
+
+	count, err := repository.CountOpenByTeam(ctx, "platform")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = repository.CountOpenByTeam(ctx, "infra")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestPullRequestRepository_ReviewerSourceTeam(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	teamRepository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "author-1", "Author", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "Teammate", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "platform-1", "Platform 1", "platform", true))
+	require.NoError(t, helpers.InsertTestUser(db, "platform-2", "Platform 2", "platform", true))
+
+	pullRequest := entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now().UTC())
+	pullRequest.ReviewersLimit = 3
+	pullRequest.AddReviewers([]value_objects.UserID{"platform-1", "user-1"})
+	pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: "platform-1", SourceTeam: "platform"})
+	require.NoError(t, repository.Create(ctx, pullRequest))
+
+	result, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Equal(t, value_objects.TeamName("platform"), result.Assignment("platform-1").SourceTeam)
+	assert.False(t, result.Assignment("user-1").IsBorrowed())
+
+	err = repository.ReassignReviewer(ctx, "pull-request-1", "platform-1", entities.ReviewerAssignment{ReviewerID: "platform-2", SourceTeam: "platform"})
+	require.NoError(t, err)
+
+	require.NoError(t, teamRepository.Rename(ctx, "platform", "infra"))
+
+	result, err = repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Equal(t, value_objects.TeamName("infra"), result.Assignment("platform-2").SourceTeam)
+}
+
+func TestPullRequestRepository_RemoveReviewer(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "author-1", "Author", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "User 1", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-2", "User 2", "backend", true))
+
+	pullRequest := entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now().UTC())
+	pullRequest.AddReviewers([]value_objects.UserID{"user-1", "user-2"})
+	require.NoError(t, repository.Create(ctx, pullRequest))
+
+	require.NoError(t, repository.RemoveReviewer(ctx, "pull-request-1", "user-1"))
+
+	result, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.Equal(t, []value_objects.UserID{"user-2"}, result.Reviewers())
+
+	err = repository.RemoveReviewer(ctx, "pull-request-1", "user-1")
+	assert.Equal(t, domain.ErrNotAssigned, err)
+}
+
+func TestPullRequestRepository_GetOpenCreatedBefore(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "author-1", "Author", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "User 1", "backend", true))
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	stale := entities.NewPullRequest("pull-request-1", "Stale PR", "author-1", now.Add(-48*time.Hour))
+	stale.AddReviewers([]value_objects.UserID{"user-1"})
+	require.NoError(t, repository.Create(ctx, stale))
+	require.NoError(t, repository.Create(ctx, entities.NewPullRequest("pull-request-2", "Fresh PR", "author-1", now)))
+
+	merged := entities.NewPullRequest("pull-request-3", "Merged PR", "author-1", now.Add(-48*time.Hour))
+	merged.Merge(now)
+	require.NoError(t, repository.Create(ctx, merged))
+
+	result, err := repository.GetOpenCreatedBefore(ctx, now.Add(-24*time.Hour))
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, value_objects.PullRequestID("pull-request-1"), result[0].ID)
+	assert.Equal(t, []value_objects.UserID{"user-1"}, result[0].Reviewers())
+}
+
+func TestPullRequestRepository_ReviewerChanges(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "author-1", "Author", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "User 1", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "platform-1", "Platform 1", "platform", true))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	assignedAt := now.Add(-72 * time.Hour)
+
+	pullRequest := entities.NewPullRequest("pull-request-1", "Test PR", "author-1", assignedAt)
+	pullRequest.AddReviewers([]value_objects.UserID{"user-1"})
+	pullRequest.SetAssignment(entities.ReviewerAssignment{ReviewerID: "user-1", AssignedAt: assignedAt})
+	require.NoError(t, repository.Create(ctx, pullRequest))
+
+	result, err := repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.True(t, assignedAt.Equal(result.Assignment("user-1").AssignedAt))
+
+	err = repository.ReassignReviewer(ctx, "pull-request-1", "user-1", entities.ReviewerAssignment{ReviewerID: "platform-1", AssignedAt: now})
+	require.NoError(t, err)
+
+	change := entities.ReviewerChange{
+		PullRequestID: "pull-request-1",
+		OldReviewerID: "user-1",
+		NewReviewerID: "platform-1",
+		SourceTeam:    "platform",
+		AssignedAt:    assignedAt,
+		Reason:        entities.ReviewerChangeSLAExceeded,
+		ChangedAt:     now,
+	}
+	require.NoError(t, repository.AddReviewerChange(ctx, change))
+
+	changes, err := repository.GetReviewerChanges(ctx, "pull-request-1")
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, change.OldReviewerID, changes[0].OldReviewerID)
+	assert.Equal(t, change.NewReviewerID, changes[0].NewReviewerID)
+	assert.Equal(t, change.SourceTeam, changes[0].SourceTeam)
+	assert.Equal(t, change.Reason, changes[0].Reason)
+	assert.True(t, assignedAt.Equal(changes[0].AssignedAt))
+	assert.True(t, now.Equal(changes[0].ChangedAt))
+
+	result, err = repository.GetByID(ctx, "pull-request-1")
+	require.NoError(t, err)
+	assert.True(t, now.Equal(result.Assignment("platform-1").AssignedAt))
+
+	err = repository.AddReviewerChange(ctx, entities.ReviewerChange{
+		PullRequestID: "pull-request-1",
+		OldReviewerID: "platform-1",
+		AssignedAt:    now,
+		Reason:        entities.ReviewerChangeUnassigned,
+		ChangedAt:     now.Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	changes, err = repository.GetReviewerChanges(ctx, "pull-request-1")
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, value_objects.UserID(""), changes[1].NewReviewerID)
+	assert.Equal(t, entities.ReviewerChangeUnassigned, changes[1].Reason)
+}
+
+func TestPullRequestRepository_LockUnderAssigned_SkipsLockedRows(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	txManager := txdb.NewTxManager(db)
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "author-1", "Author", "team1", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "User 1", "team1", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-2", "User 2", "team1", true))
+
+	full := entities.NewPullRequest("pull-request-1", "Full PR", "author-1", time.Now())
+	full.AddReviewers([]value_objects.UserID{"user-1", "user-2"})
+	require.NoError(t, repository.Create(ctx, full))
+
+	for _, id := range []value_objects.PullRequestID{"pull-request-2", "pull-request-3"} {
+		pullRequest := entities.NewPullRequest(id, "Under-assigned PR", "author-1", time.Now())
+		pullRequest.AddReviewers([]value_objects.UserID{"user-1"})
+		require.NoError(t, repository.Create(ctx, pullRequest))
+	}
+
+	locked := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+
+	go func() {
+		done <- txManager.Do(ctx, func(ctx context.Context) error {
+			ids, err := repository.LockUnderAssigned(ctx, "", 1)
+			if err != nil {
+				return err
+			}
+			if len(ids) != 1 || ids[0] != "pull-request-2" {
+				return errOperationFailed
+			}
+
+			close(locked)
+			<-release
+
+			return nil
+		})
+	}()
+
+	select {
+	case <-locked:
+	case err := <-done:
+		t.Fatalf("failed to lock first pull request: %v", err)
+	}
+
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		ids, err := repository.LockUnderAssigned(ctx, "", 10)
+		if err != nil {
+			return err
+		}
+
+		assert.Equal(t, []value_objects.PullRequestID{"pull-request-3"}, ids)
+
+		return nil
+	})
+	assert.NoError(t, err)
+
+	close(release)
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,82 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/postgres/repositories"
+	"pr-service/tests/integration/helpers"
+)
+
+func TestReviewReminderRepository_Record(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewReviewReminderRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "author-1", "Author", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "User 1", "backend", true))
+	require.NoError(t, helpers.InsertTestPullRequest(db, "pull-request-1", "Test PR", "author-1", "OPEN"))
+
+	reminder := entities.ReviewReminder{
+		PullRequestID: "pull-request-1",
+		RecipientID:   "user-1",
+		Kind:          entities.ReminderKindReminder,
+		SentAt:        time.Now().UTC(),
+	}
+
+	recorded, err := repository.Record(ctx, reminder)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	recorded, err = repository.Record(ctx, reminder)
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	reminder.Kind = entities.ReminderKindEscalation
+	recorded, err = repository.Record(ctx, reminder)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+}
+
+func TestReviewReminderRepository_MarkDelivered(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewReviewReminderRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "author-1", "Author", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "User 1", "backend", true))
+	require.NoError(t, helpers.InsertTestPullRequest(db, "pull-request-1", "Test PR", "author-1", "OPEN"))
+
+	reminder := entities.ReviewReminder{
+		PullRequestID: "pull-request-1",
+		RecipientID:   "user-1",
+		Kind:          entities.ReminderKindReminder,
+		SentAt:        time.Now().UTC().Truncate(time.Second),
+	}
+
+	recorded, err := repository.Record(ctx, reminder)
+	require.NoError(t, err)
+	require.True(t, recorded)
+
+	undelivered, err := repository.GetUndelivered(ctx)
+	require.NoError(t, err)
+	require.Len(t, undelivered, 1)
+	assert.Equal(t, reminder.PullRequestID, undelivered[0].PullRequestID)
+	assert.True(t, reminder.SentAt.Equal(undelivered[0].SentAt))
+
+	err = repository.MarkDelivered(ctx, reminder, time.Now().UTC())
+	require.NoError(t, err)
+
+	undelivered, err = repository.GetUndelivered(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, undelivered)
+}
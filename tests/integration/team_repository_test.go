@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,9 +26,10 @@ func TestTeamRepository_Create_Success(t *testing.T) {
 		Name: value_objects.TeamName("backend"),
 	}
 
-	err := repository.Create(ctx, team)
+	created, err := repository.Create(ctx, team)
 
 	assert.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
 
 	exists, err := helpers.TeamExists(db, "backend")
 	assert.NoError(t, err)
@@ -45,10 +47,10 @@ func TestTeamRepository_Create_DuplicateTeam(t *testing.T) {
 		Name: value_objects.TeamName("backend"),
 	}
 
-	err := repository.Create(ctx, team)
+	_, err := repository.Create(ctx, team)
 	assert.NoError(t, err)
 
-	err = repository.Create(ctx, team)
+	_, err = repository.Create(ctx, team)
 	assert.Error(t, err)
 }
 
@@ -99,7 +101,7 @@ func TestTeamRepository_GetAll_Success(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	allTeams, err := repository.GetAll(ctx)
+	allTeams, err := repository.GetAll(ctx, false)
 
 	assert.NoError(t, err)
 	assert.Len(t, allTeams, 4)
@@ -122,8 +124,315 @@ func TestTeamRepository_GetAll_Empty(t *testing.T) {
 	repository := repositories.NewTeamRepository(db)
 	ctx := context.Background()
 
-	allTeams, err := repository.GetAll(ctx)
+	allTeams, err := repository.GetAll(ctx, false)
 
 	assert.NoError(t, err)
 	assert.Empty(t, allTeams)
 }
+
+func TestTeamRepository_Create_WithAssignmentStrategy(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	_, err := repository.Create(ctx, entities.Team{Name: "backend", AssignmentStrategy: entities.StrategyRoundRobin})
+	require.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "backend")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StrategyRoundRobin, team.AssignmentStrategy)
+	assert.Equal(t, value_objects.UserID(""), team.RoundRobinCursor)
+}
+
+func TestTeamRepository_UpdateAssignmentStrategy(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestTeam(db, "backend", "backend")
+	require.NoError(t, err)
+
+	err = repository.UpdateAssignmentStrategy(ctx, "backend", entities.StrategyLeastLoaded)
+	assert.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "backend")
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StrategyLeastLoaded, team.AssignmentStrategy)
+
+	err = repository.UpdateAssignmentStrategy(ctx, "non-existent-team", entities.StrategyLeastLoaded)
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+}
+
+func TestTeamRepository_UpdateRoundRobinCursor(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestTeam(db, "backend", "backend")
+	require.NoError(t, err)
+
+	err = repository.UpdateRoundRobinCursor(ctx, "backend", "user-2")
+	assert.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "backend")
+	assert.NoError(t, err)
+	assert.Equal(t, value_objects.UserID("user-2"), team.RoundRobinCursor)
+	assert.Equal(t, entities.StrategyRandom, team.AssignmentStrategy)
+
+	cursor, err := repository.LockRoundRobinCursor(ctx, "backend")
+	assert.NoError(t, err)
+	assert.Equal(t, value_objects.UserID("user-2"), cursor)
+
+	_, err = repository.LockRoundRobinCursor(ctx, "non-existent-team")
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+}
+
+func TestTeamRepository_Create_WithReviewersLimit(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	_, err := repository.Create(ctx, entities.Team{Name: "security", AssignmentStrategy: entities.StrategyRandom, ReviewersLimit: 3})
+	require.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "security")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, team.ReviewersLimit)
+
+	err = helpers.InsertTestTeam(db, "backend", "backend")
+	require.NoError(t, err)
+
+	team, err = repository.GetByName(ctx, "backend")
+	assert.NoError(t, err)
+	assert.Equal(t, entities.DefaultReviewersLimit, team.ReviewersLimit)
+}
+
+func TestTeamRepository_UpdateMergePolicy(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestTeam(db, "backend", "backend")
+	require.NoError(t, err)
+
+	policy := entities.MergePolicy{MinApprovals: 2, RequireLeadApproval: true}
+	err = repository.UpdateMergePolicy(ctx, "backend", "lead-1", policy)
+	assert.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "backend")
+	assert.NoError(t, err)
+	assert.Equal(t, value_objects.UserID("lead-1"), team.LeadID)
+	assert.Equal(t, policy, team.MergePolicy)
+
+	err = repository.UpdateMergePolicy(ctx, "non-existent-team", "", entities.MergePolicy{})
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+}
+
+func TestTeamRepository_MembershipHistory(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "Alice", "platform", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-2", "Bob", "frontend", true))
+	require.NoError(t, helpers.InsertTestTeam(db, "backend", "backend"))
+
+	changedAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	err := repository.AddMembershipChanges(ctx, []entities.MembershipChange{
+		{UserID: "user-1", Action: entities.MembershipAdded, ToTeam: "backend", ChangedAt: changedAt},
+		{UserID: "user-1", Action: entities.MembershipMoved, FromTeam: "backend", ToTeam: "platform", ChangedAt: changedAt.Add(time.Hour)},
+		{UserID: "user-2", Action: entities.MembershipAdded, ToTeam: "frontend", ChangedAt: changedAt},
+	})
+	require.NoError(t, err)
+
+	history, err := repository.GetMembershipHistory(ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, entities.MembershipAdded, history[0].Action)
+	assert.Equal(t, value_objects.TeamName(""), history[0].FromTeam)
+	assert.Equal(t, entities.MembershipMoved, history[1].Action)
+	assert.Equal(t, value_objects.TeamName("platform"), history[1].ToTeam)
+	assert.True(t, changedAt.Add(time.Hour).Equal(history[1].ChangedAt))
+}
+
+func TestTeamRepository_ArchiveAndDelete(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestTeam(db, "backend", "backend"))
+	require.NoError(t, helpers.InsertTestTeam(db, "platform", "platform"))
+
+	archivedAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	err := repository.Archive(ctx, "platform", archivedAt)
+	require.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "platform")
+	require.NoError(t, err)
+	require.NotNil(t, team.ArchivedAt)
+	assert.True(t, archivedAt.Equal(*team.ArchivedAt))
+
+	activeTeams, err := repository.GetAll(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, activeTeams, 1)
+	assert.Equal(t, value_objects.TeamName("backend"), activeTeams[0].Name)
+
+	allTeams, err := repository.GetAll(ctx, true)
+	require.NoError(t, err)
+	assert.Len(t, allTeams, 2)
+
+	err = repository.Delete(ctx, "platform")
+	require.NoError(t, err)
+
+	_, err = repository.GetByName(ctx, "platform")
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+
+	err = repository.Delete(ctx, "platform")
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+
+	err = repository.Archive(ctx, "non-existent-team", archivedAt)
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+}
+
+func TestTeamRepository_Rename(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	userRepository := repositories.NewUserRepository(db)
+	ctx := context.Background()
+
+	created, err := repository.Create(ctx, entities.Team{Name: "backend"})
+	require.NoError(t, err)
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "Alice", "backend", true))
+
+	changedAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	err = repository.AddMembershipChanges(ctx, []entities.MembershipChange{
+		{UserID: "user-1", Action: entities.MembershipAdded, ToTeam: "backend", ChangedAt: changedAt},
+	})
+	require.NoError(t, err)
+
+	err = repository.Rename(ctx, "backend", "core")
+	require.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "core")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, team.ID)
+
+	user, err := userRepository.GetByID(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, value_objects.TeamName("core"), user.Team)
+
+	history, err := repository.GetMembershipHistory(ctx, "core")
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, value_objects.TeamName("core"), history[0].ToTeam)
+
+	_, err = repository.GetByName(ctx, "backend")
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+
+	err = repository.Rename(ctx, "backend", "platform")
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+}
+
+func TestTeamRepository_Parent(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	_, err := repository.Create(ctx, entities.Team{Name: "platform"})
+	require.NoError(t, err)
+	_, err = repository.Create(ctx, entities.Team{Name: "backend", Parent: "platform"})
+	require.NoError(t, err)
+	_, err = repository.Create(ctx, entities.Team{Name: "infra"})
+	require.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, value_objects.TeamName("platform"), team.Parent)
+
+	err = repository.UpdateParent(ctx, "backend", "infra")
+	require.NoError(t, err)
+
+	err = repository.Rename(ctx, "infra", "core")
+	require.NoError(t, err)
+
+	teams, err := repository.GetAll(ctx, false)
+	require.NoError(t, err)
+	for _, team := range teams {
+		if team.Name == "backend" {
+			assert.Equal(t, value_objects.TeamName("core"), team.Parent)
+		}
+	}
+
+	err = repository.UpdateParent(ctx, "backend", "")
+	require.NoError(t, err)
+
+	team, err = repository.GetByName(ctx, "backend")
+	require.NoError(t, err)
+	assert.Empty(t, team.Parent)
+
+	err = repository.UpdateParent(ctx, "unknown", "platform")
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+}
+
+func TestTeamRepository_UpdateMaxOpenReviews(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestTeam(db, "backend", "backend")
+	require.NoError(t, err)
+
+	err = repository.UpdateMaxOpenReviews(ctx, "backend", 4)
+	assert.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "backend")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, team.MaxOpenReviews)
+
+	err = repository.UpdateMaxOpenReviews(ctx, "non-existent-team", 4)
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+}
+
+func TestTeamRepository_UpdateReviewSLA(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestTeam(db, "backend", "backend")
+	require.NoError(t, err)
+
+	sla := entities.ReviewSLA{RemindAfter: 24 * time.Hour, EscalateAfter: 72 * time.Hour}
+
+	err = repository.UpdateReviewSLA(ctx, "backend", sla)
+	assert.NoError(t, err)
+
+	team, err := repository.GetByName(ctx, "backend")
+	assert.NoError(t, err)
+	assert.Equal(t, sla, team.ReviewSLA)
+
+	err = repository.UpdateReviewSLA(ctx, "non-existent-team", sla)
+	assert.Equal(t, domain.ErrTeamNotFound, err)
+}
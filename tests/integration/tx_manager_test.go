@@ -0,0 +1,129 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/domain/value_objects"
+	txdb "pr-service/internal/infrastructure/db"
+	"pr-service/internal/infrastructure/postgres/repositories"
+	"pr-service/tests/integration/helpers"
+)
+
+var errOperationFailed = errors.New("operation failed")
+
+func TestTxManager_Do_CommitsOnSuccess(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	txManager := txdb.NewTxManager(db)
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	err = txManager.Do(ctx, func(ctx context.Context) error {
+		return repository.Create(ctx, entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now()))
+	})
+
+	assert.NoError(t, err)
+
+	exists, err := helpers.PullRequestExists(db, "pull-request-1")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestTxManager_Do_RollsBackOnError(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	txManager := txdb.NewTxManager(db)
+	userRepository := repositories.NewUserRepository(db)
+	pullRequestRepository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	err = txManager.Do(ctx, func(ctx context.Context) error {
+		if createErr := pullRequestRepository.Create(ctx, entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now())); createErr != nil {
+			return createErr
+		}
+
+		if _, setErr := userRepository.SetIsActive(ctx, "author-1", false); setErr != nil {
+			return setErr
+		}
+
+		return errOperationFailed
+	})
+
+	assert.ErrorIs(t, err, errOperationFailed)
+
+	exists, err := helpers.PullRequestExists(db, "pull-request-1")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	isActive, err := helpers.GetUserActivity(db, "author-1")
+	assert.NoError(t, err)
+	assert.True(t, isActive)
+}
+
+func TestTxManager_Do_RollsBackPullRequestWhenReviewerInsertFails(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	txManager := txdb.NewTxManager(db)
+	repository := repositories.NewPullRequestRepository(db)
+	ctx := context.Background()
+
+	err := helpers.InsertTestUser(db, "author-1", "Author", "team1", true)
+	require.NoError(t, err)
+
+	pullRequest := entities.NewPullRequest("pull-request-1", "Test PR", "author-1", time.Now())
+	pullRequest.AddReviewers([]value_objects.UserID{"non-existent-user"})
+
+	err = txManager.Do(ctx, func(ctx context.Context) error {
+		return repository.Create(ctx, pullRequest)
+	})
+
+	assert.Error(t, err)
+
+	exists, err := helpers.PullRequestExists(db, "pull-request-1")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestTxManager_Do_NestedCallJoinsOuterTransaction(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	txManager := txdb.NewTxManager(db)
+	teamRepository := repositories.NewTeamRepository(db)
+	ctx := context.Background()
+
+	err := txManager.Do(ctx, func(ctx context.Context) error {
+		innerErr := txManager.Do(ctx, func(ctx context.Context) error {
+			_, err := teamRepository.Create(ctx, entities.Team{Name: "backend"})
+
+			return err
+		})
+		if innerErr != nil {
+			return innerErr
+		}
+
+		return errOperationFailed
+	})
+
+	assert.ErrorIs(t, err, errOperationFailed)
+
+	exists, err := helpers.TeamExists(db, "backend")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -94,6 +95,8 @@ func TestUserRepository_UpsertMembers_Success(t *testing.T) {
 	ctx := context.Background()
 
 	teamName := value_objects.TeamName("payments")
+	require.NoError(t, helpers.InsertTestTeam(db, string(teamName), string(teamName)))
+
 	members := []entities.User{
 		{
 			ID:       value_objects.UserID("u1"),
@@ -132,6 +135,7 @@ func TestUserRepository_UpsertMembers_UpdateExisting(t *testing.T) {
 	ctx := context.Background()
 
 	teamName := value_objects.TeamName("backend")
+	require.NoError(t, helpers.InsertTestTeam(db, string(teamName), string(teamName)))
 
 	err := helpers.InsertTestUser(db, "u1", "Old Name", "old-team", true)
 	require.NoError(t, err)
@@ -265,3 +269,123 @@ func TestUserRepository_GetAll_Empty(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, allUsers)
 }
+
+func TestUserRepository_SetIsActiveByTeam(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewUserRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "Alice", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-2", "Bob", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-3", "Carol", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-4", "Dave", "frontend", true))
+
+	users, err := repository.SetIsActiveByTeam(ctx, "backend", []value_objects.UserID{"user-2", "user-4"}, false)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, value_objects.UserID("user-2"), users[0].ID)
+	assert.False(t, users[0].IsActive)
+
+	isActive, err := helpers.GetUserActivity(db, "user-4")
+	require.NoError(t, err)
+	assert.True(t, isActive)
+
+	users, err = repository.SetIsActiveByTeam(ctx, "backend", nil, false)
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	assert.Equal(t, value_objects.UserID("user-1"), users[0].ID)
+	for _, user := range users {
+		assert.False(t, user.IsActive)
+	}
+}
+
+func TestUserRepository_SetTeam(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewUserRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "Alice", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-2", "Bob", "backend", true))
+	require.NoError(t, helpers.InsertTestTeam(db, "platform", "platform"))
+
+	err := repository.SetTeam(ctx, []value_objects.UserID{"user-1", "user-2"}, "platform")
+	require.NoError(t, err)
+
+	users, err := repository.GetUsersByTeam(ctx, "platform")
+	require.NoError(t, err)
+	assert.Len(t, users, 2)
+
+	err = repository.SetTeam(ctx, []value_objects.UserID{"user-1", "missing"}, "backend")
+	assert.Equal(t, domain.ErrUserNotFound, err)
+}
+
+func TestUserRepository_AvailabilityWindows(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewUserRepository(db)
+	ctx := context.Background()
+
+	require.NoError(t, helpers.InsertTestUser(db, "user-1", "User 1", "backend", true))
+	require.NoError(t, helpers.InsertTestUser(db, "user-2", "User 2", "backend", true))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	window, err := repository.AddAvailabilityWindow(ctx, entities.AvailabilityWindow{
+		UserID:   "user-1",
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(time.Hour),
+		Reason:   "vacation",
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, window.ID)
+
+	windows, err := repository.GetAvailabilityWindows(ctx, "user-1")
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+	assert.Equal(t, "vacation", windows[0].Reason)
+	assert.True(t, windows[0].StartsAt.Equal(now.Add(-time.Hour)))
+
+	unavailable, err := repository.GetUnavailableUserIDs(ctx, []value_objects.UserID{"user-1", "user-2"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, map[value_objects.UserID]bool{"user-1": true}, unavailable)
+
+	unavailable, err = repository.GetUnavailableUserIDs(ctx, []value_objects.UserID{"user-1", "user-2"}, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, unavailable)
+
+	assert.ErrorIs(t, repository.DeleteAvailabilityWindow(ctx, "user-2", window.ID), domain.ErrAvailabilityWindowNotFound)
+	require.NoError(t, repository.DeleteAvailabilityWindow(ctx, "user-1", window.ID))
+
+	windows, err = repository.GetAvailabilityWindows(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Empty(t, windows)
+}
+
+func TestUserRepository_SetMaxOpenReviews(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewUserRepository(db)
+	ctx := context.Background()
+
+	userID := value_objects.UserID("user-1")
+	require.NoError(t, helpers.InsertTestUser(db, string(userID), "User 1", "backend", true))
+
+	updatedUser, err := repository.SetMaxOpenReviews(ctx, userID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, updatedUser.MaxOpenReviews)
+
+	err = repository.UpsertMembers(ctx, "backend", []entities.User{{ID: userID, Username: "Renamed", IsActive: true}})
+	require.NoError(t, err)
+
+	user, err := repository.GetByID(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, user.MaxOpenReviews)
+
+	_, err = repository.SetMaxOpenReviews(ctx, "non-existent-user", 3)
+	assert.Equal(t, domain.ErrUserNotFound, err)
+}
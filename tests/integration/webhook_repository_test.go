@@ -0,0 +1,123 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"pr-service/internal/domain"
+	"pr-service/internal/domain/entities"
+	"pr-service/internal/infrastructure/postgres/repositories"
+	"pr-service/tests/integration/helpers"
+)
+
+func TestWebhookRepository_CreateAndDelete(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewWebhookRepository(db)
+	ctx := context.Background()
+
+	created, err := repository.Create(ctx, entities.Webhook{
+		URL:        "https://chat.example.com/hooks",
+		Secret:     "secret",
+		EventTypes: []entities.EventType{entities.EventPullRequestMerged},
+		CreatedAt:  time.Now().UTC(),
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, created.ID)
+
+	webhooks, err := repository.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, "secret", webhooks[0].Secret)
+	assert.Equal(t, []entities.EventType{entities.EventPullRequestMerged}, webhooks[0].EventTypes)
+
+	require.NoError(t, repository.Delete(ctx, created.ID))
+	assert.ErrorIs(t, repository.Delete(ctx, created.ID), domain.ErrWebhookNotFound)
+}
+
+func TestWebhookRepository_DeadLetters(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewWebhookRepository(db)
+	ctx := context.Background()
+
+	created, err := repository.Create(ctx, entities.Webhook{
+		URL:       "https://chat.example.com/hooks",
+		Secret:    "secret",
+		CreatedAt: time.Now().UTC(),
+	})
+	require.NoError(t, err)
+
+	deadLetter := entities.WebhookDeadLetter{
+		WebhookID: created.ID,
+		Event: entities.Event{
+			Type:       entities.EventPullRequestCreated,
+			OccurredAt: time.Now().UTC().Truncate(time.Second),
+			Payload:    map[string]any{"pull_request_id": "pr-1"},
+		},
+		Attempts:  5,
+		LastError: "unexpected status 500",
+		FailedAt:  time.Now().UTC(),
+	}
+	require.NoError(t, repository.AddDeadLetter(ctx, deadLetter))
+
+	deadLetters, err := repository.GetDeadLetters(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, entities.EventPullRequestCreated, deadLetters[0].Event.Type)
+	assert.Equal(t, "pr-1", deadLetters[0].Event.Payload["pull_request_id"])
+	assert.Equal(t, 5, deadLetters[0].Attempts)
+
+	_, err = repository.GetDeadLetters(ctx, created.ID+1)
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+}
+
+func TestWebhookRepository_Deliveries(t *testing.T) {
+	db := helpers.SetupTestDB(t)
+	defer helpers.CleanupTestDB(t, db)
+
+	repository := repositories.NewWebhookRepository(db)
+	outboxRepository := repositories.NewOutboxRepository(db)
+	ctx := context.Background()
+
+	created, err := repository.Create(ctx, entities.Webhook{
+		URL:       "https://chat.example.com/hooks",
+		Secret:    "secret",
+		CreatedAt: time.Now().UTC(),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, outboxRepository.Add(ctx, entities.Event{Type: entities.EventPullRequestMerged, AggregateID: "pr-1", OccurredAt: time.Now().UTC()}))
+	entries, err := outboxRepository.GetPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	deliveries, err := repository.GetDeliveries(ctx, entries[0].ID)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+
+	nextAttemptAt := time.Now().UTC().Add(time.Minute).Truncate(time.Second)
+	require.NoError(t, repository.SaveDelivery(ctx, entities.WebhookDelivery{WebhookID: created.ID, OutboxEntryID: entries[0].ID, Attempts: 1, NextAttemptAt: nextAttemptAt}))
+
+	deliveries, err = repository.GetDeliveries(ctx, entries[0].ID)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.True(t, nextAttemptAt.Equal(deliveries[0].NextAttemptAt))
+	assert.False(t, deliveries[0].Done)
+
+	require.NoError(t, repository.SaveDelivery(ctx, entities.WebhookDelivery{WebhookID: created.ID, OutboxEntryID: entries[0].ID, Attempts: 2, Done: true}))
+
+	deliveries, err = repository.GetDeliveries(ctx, entries[0].ID)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 2, deliveries[0].Attempts)
+	assert.True(t, deliveries[0].NextAttemptAt.IsZero())
+	assert.True(t, deliveries[0].Done)
+}